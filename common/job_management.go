@@ -21,14 +21,18 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/stmcginnis/gofish"
+	gofishcommon "github.com/stmcginnis/gofish/common"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
@@ -239,46 +243,95 @@ type DellJob struct {
 
 // WaitForDellJobToFinish waits for a redfish job to finish and returns the job details.
 func WaitForDellJobToFinish(service *gofish.Service, jobURI string, timeBetweenAttempts int64, timeout int64) error {
-	var oemJob DellJob
-	// Create tickers
-	attemptTick := time.NewTicker(time.Duration(timeBetweenAttempts) * time.Second)
-	timeoutTick := time.NewTicker(time.Duration(timeout) * time.Second)
+	_, err := WaitForDellTask(context.Background(), service, jobURI, timeBetweenAttempts, timeout, redfish.GetTask,
+		func(job *redfish.Task) (bool, error) {
+			// Check if job has finished
+			switch status := job.TaskState; status {
+			case redfish.CompletedTaskState:
+				if job.Oem != nil {
+					var oemJob DellJob
+					if err := json.Unmarshal(job.Oem, &oemJob); err != nil {
+						return false, err
+					}
+					if oemJob.Dell.JobState == "Failed" {
+						return false, fmt.Errorf("job failed with message: %s", oemJob.Dell.Message)
+					}
+				}
+				return true, nil
+			case redfish.KilledTaskState, redfish.ExceptionTaskState:
+				return false, fmt.Errorf(JobErrorWithState, job.TaskState)
+			}
+			return false, nil
+		}, nil)
+	return err
+}
+
+// WaitForDellTask polls the task of a Dell job until it has finished and returns it.
+// Parameters:
+//   - jobURI -> URI for the task to check. Task monitor URIs are polled through the task itself.
+//   - timeBetweenAttempts -> time to wait between attempts. I.e. 30 means 30 seconds.
+//   - timeout -> maximun time to wait until job is considered failed.
+//   - get -> reads the task. Its errors are retried on the next attempt when retryable reports so.
+//   - finished -> reports whether the task has finished. Its errors stop the wait.
+//   - retryable -> reports whether an error of get is retried, such as IsRetryableTaskError. Nil retries every error.
+//
+// The wait stops as well when the context is done.
+func WaitForDellTask[T any](ctx context.Context, service *gofish.Service, jobURI string, timeBetweenAttempts int64, timeout int64,
+	get func(gofishcommon.Client, string) (*T, error), finished func(*T) (bool, error), retryable func(error) bool,
+) (*T, error) {
 	// Below 17G device returns location as /redfish/v1/TaskService/Tasks/JOB_ID for same GET call return status as 200 with all the job status.
 	// where as 17G device returns location as /redfish/v1/TaskService/TaskMonitors/JOB_ID for same GET call return no content hence
 	// we are replacing TaskMonitors to Tasks.
 	jobURI = strings.Replace(jobURI, "TaskMonitors", "Tasks", 1)
+	// Create tickers
+	attemptTick := time.NewTicker(time.Duration(timeBetweenAttempts) * time.Second)
+	defer attemptTick.Stop()
+	timeoutTick := time.NewTimer(time.Duration(timeout) * time.Second)
+	defer timeoutTick.Stop()
 	for {
 		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeoutTick.C:
+			log.Printf("[DEBUG] - Error. Timeout reached\n")
+			return nil, fmt.Errorf("timeout waiting for the job %s to finish", jobURI)
 		case <-attemptTick.C:
 			// For some reason iDRAC 4.40.00.0 from time to time gives the following error:
 			// iDRAC is not ready. The configuration values cannot be accessed. Please retry after a few minutes.
-			job, err := redfish.GetTask(service.GetClient(), jobURI)
-			if err == nil {
-				// Check if job has finished
-				switch status := job.TaskState; status {
-				case redfish.CompletedTaskState:
-					if job.Oem != nil {
-						err = json.Unmarshal(job.Oem, &oemJob)
-						if err != nil {
-							return err
-						}
-						if oemJob.Dell.JobState == "Failed" {
-							return fmt.Errorf("job failed with message: %s", oemJob.Dell.Message)
-						}
-					}
-					return nil
-				case redfish.KilledTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
-				case redfish.ExceptionTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
+			task, err := get(service.GetClient(), jobURI)
+			if err != nil {
+				if retryable != nil && !retryable(err) {
+					return nil, err
 				}
+				log.Printf("[DEBUG] - Attempting one more time after error: %s\n", err)
+				continue
+			}
+			done, err := finished(task)
+			if err != nil {
+				return task, err
+			}
+			if done {
+				return task, nil
 			}
-		case <-timeoutTick.C:
-			return fmt.Errorf("timeout waiting for the job to finish")
 		}
 	}
 }
 
+// IsRetryableTaskError reports whether reading a task may succeed on a later attempt. Client errors, such as a
+// missing task or a failed authentication, are not retried; server and connection errors are.
+func IsRetryableTaskError(err error) bool {
+	var redfishErr *gofishcommon.Error
+	if !errors.As(err, &redfishErr) {
+		return true
+	}
+	switch code := redfishErr.HTTPReturnedStatusCode; code {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return code < http.StatusBadRequest || code >= http.StatusInternalServerError
+	}
+}
+
 // DeleteDellJob is intended to delete a task schedules in a Dell system.
 // This function is only a workaround until HTTP DELETE is supported under each task o taskmonitor
 //
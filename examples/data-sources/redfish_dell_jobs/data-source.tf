@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_dell_jobs" "pending" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // jobs waiting to run, they block new BIOS and RAID configuration jobs
+  job_filter {
+    job_states = ["Scheduled", "Scheduling", "New", "RebootPending"]
+  }
+}
+
+output "pending_jobs" {
+  value = {
+    for key, data in data.redfish_dell_jobs.pending : key => [
+      for job in data.jobs : "${job.id} ${job.job_type} ${job.job_state} ${job.percent_complete}%: ${job.message}"
+    ]
+  }
+}
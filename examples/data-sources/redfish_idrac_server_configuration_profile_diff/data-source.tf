@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_idrac_server_configuration_profile_export" "export" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  share_parameters = {
+    filename   = "export.xml"
+    target     = ["BIOS", "IDRAC"]
+    share_type = "LOCAL"
+  }
+}
+
+# compare the profile of every server with the golden profile of the fleet
+data "redfish_idrac_server_configuration_profile_diff" "drift" {
+  for_each = var.rack1
+
+  baseline = file("${path.module}/golden.xml")
+  profile  = base64decode(redfish_idrac_server_configuration_profile_export.export[each.key].file_content)
+
+  // the filter is optional, all the components and attributes are compared without it
+  filter = {
+    components = ["^BIOS\\.", "^iDRAC\\."]
+    attributes = ["^(?:BootMode|SysProfile|NTPConfigGroup\\.)"]
+  }
+}
+
+# the servers which drifted from the golden profile
+output "drifted_servers" {
+  value = [for k, v in data.redfish_idrac_server_configuration_profile_diff.drift : k if v.has_differences]
+}
+
+output "changed_attributes" {
+  value = {
+    for k, v in data.redfish_idrac_server_configuration_profile_diff.drift : k => [
+      for c in v.changed : "${c.fqdd} ${c.name}: ${c.baseline_value} -> ${c.value}"
+    ]
+  }
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_idrac_server_configuration_profile_preview" "preview" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // the profile is previewed from a local file or, with `share_parameters`, from a network share
+  import_buffer = file("${path.module}/server_configuration_profile.xml")
+
+  // the components of the profile to preview, all of them by default
+  target = ["BIOS", "IDRAC"]
+
+  // fail the plan when the profile would not import on the server
+  fail_on_error = true
+}
+
+# the attributes of the profile which would fail to import on each server
+output "preview_failures" {
+  value = {
+    for k, v in data.redfish_idrac_server_configuration_profile_preview.preview : k => [
+      for r in v.results : "${r.fqdd} ${r.name}: ${r.message}" if r.status == "Failure"
+    ]
+  }
+}
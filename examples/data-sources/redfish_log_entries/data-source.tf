@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_log_entries" "critical" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // critical System Event Log entries of the last hour
+  log_entry_filter {
+    log_service_ids = ["Sel"]
+    severities      = ["Critical"]
+    within          = "1h"
+    max_count       = 10
+  }
+}
+
+output "critical_log_entries" {
+  value = {
+    for key, logs in data.redfish_log_entries.critical : key => [
+      for entry in logs.log_entries : "${entry.created} ${entry.message_id}: ${entry.message}"
+    ]
+  }
+}
+
+// gate a rollout on the absence of critical entries
+check "no_critical_sel_entries" {
+  assert {
+    condition     = alltrue([for logs in data.redfish_log_entries.critical : length(logs.log_entries) == 0])
+    error_message = "Critical System Event Log entries were found in the last hour."
+  }
+}
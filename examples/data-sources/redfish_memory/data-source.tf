@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_memory" "memory_example" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // the filter is optional, all the memory of all the systems is read without it
+  memory_filter {
+    systems = [
+      {
+        system_id  = "System.Embedded.1"
+        memory_ids = ["DIMM.Socket.A1", "DIMM.Socket.B1"]
+      }
+    ]
+  }
+}
+
+output "memory_example" {
+  value     = data.redfish_memory.memory_example
+  sensitive = true
+}
+
+# check that every server has the expected DIMMs of its build sheet
+output "memory_part_numbers" {
+  value = { for k, v in data.redfish_memory.memory_example : k => { for m in v.memory : m.device_locator => m.part_number } }
+}
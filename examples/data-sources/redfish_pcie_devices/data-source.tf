@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_pcie_devices" "pcie_devices_example" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // the filter is optional, all the PCIe devices of all the systems are read without it
+  pcie_device_filter {
+    systems = [
+      {
+        system_id       = "System.Embedded.1"
+        pcie_device_ids = ["0-31"]
+      }
+    ]
+  }
+}
+
+output "pcie_devices_example" {
+  value     = data.redfish_pcie_devices.pcie_devices_example
+  sensitive = true
+}
+
+# check that every server has the expected PCIe cards of its build sheet
+output "pcie_device_names" {
+  value = { for k, v in data.redfish_pcie_devices.pcie_devices_example : k => [for d in v.pcie_devices : d.name] }
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_sensors" "sensors_example" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // the chassis is optional, the first chassis is read without it
+  chassis_id = "System.Embedded.1"
+}
+
+output "sensors_example" {
+  value     = data.redfish_sensors.sensors_example
+  sensitive = true
+}
+
+# power supply capacity and current draw of every server, for capacity planning
+output "power_capacity" {
+  value = { for k, v in data.redfish_sensors.sensors_example : k => {
+    psu_capacity_watts = sum(concat([0], [for psu in v.power_supplies : psu.power_capacity_watts]))
+    consumed_watts     = try(v.power_controls[0].power_consumed_watts, null)
+  } }
+}
+
+# temperatures above their non-critical threshold
+output "warm_temperatures" {
+  value = { for k, v in data.redfish_sensors.sensors_example : k => [
+    for t in v.temperatures : t.name if t.upper_threshold_non_critical > 0 && t.reading_celsius >= t.upper_threshold_non_critical
+  ] }
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_trusted_modules" "tpm_example" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // the system ID is optional, the first system is read without it
+  system_id = "System.Embedded.1"
+}
+
+output "tpm_example" {
+  value     = data.redfish_trusted_modules.tpm_example
+  sensitive = true
+}
+
+# check that every server has an enabled TPM 2.0
+output "tpm2_enabled" {
+  value = {
+    for k, v in data.redfish_trusted_modules.tpm_example : k => anytrue([
+      for m in v.trusted_modules : m.interface_type == "TPM2_0" && m.state == "Enabled"
+    ])
+  }
+}
@@ -44,6 +44,20 @@ resource "redfish_bios" "bios" {
   // The maximum amount of time to wait for the bios job to be completed
   bios_job_timeout = "1200"
 
+  /* Time when the bios settings are applied
+     list of possible value:
+      [ Immediate, OnReset, AtMaintenanceWindowStart, InMaintenanceWindowOnReset]
+  */
+  settings_apply_time = "OnReset"
+  // stage the settings without rebooting the server, they are applied on its next reboot
+  # stage_only = true
+
+  // maintenance window required by the AtMaintenanceWindowStart and InMaintenanceWindowOnReset apply times
+  # maintenance_window = {
+  #   start_time = "2030-01-01T02:00:00-06:00"
+  #   duration   = 3600
+  # }
+
   // by default, the resource uses the first system
   # system_id = "System.Embedded.1"
 }
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_bios_password" "setup" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // the BIOS setup password, AdminPassword, or the system password, SystemPassword
+  password_name = "AdminPassword"
+
+  // the passwords are write-only, they are not stored in the state
+  new_password_wo = var.bios_setup_password
+  // the current password, required when a password is already set
+  old_password_wo = var.bios_setup_password_old
+  // increment the version to apply a new password
+  password_wo_version = 1
+
+  // the server is rebooted to apply the password when a BIOS configuration job is required
+  reset_type       = "GracefulRestart"
+  reset_timeout    = 120
+  bios_job_timeout = 1200
+}
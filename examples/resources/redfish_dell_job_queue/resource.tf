@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_dell_job_queue" "cleanup" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // delete specific jobs
+  job_ids = ["JID_878682850779"]
+
+  // delete the Completed, CompletedWithErrors and Failed jobs
+  clear_finished_jobs = true
+
+  // delete every job of the queue with JID_CLEARALL_FORCE, this restarts the Lifecycle Controller
+  // and cannot be combined with job_ids or clear_finished_jobs
+  # clear_all_force = true
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_event_subscription" "subscription" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // URL of the event listener
+  destination = "https://192.168.1.100:8188/events"
+  protocol    = "Redfish"
+
+  // filters of the events sent to the listener
+  event_types       = ["Alert"]
+  registry_prefixes = ["iDRAC"]
+
+  context               = "terraform"
+  delivery_retry_policy = "RetryForever"
+
+  // headers sent with every event, they are not returned by the BMC
+  http_headers = {
+    "Authorization" = "Bearer <token>"
+  }
+
+  // send a test event once the subscription is created or updated
+  submit_test_event = true
+}
@@ -38,6 +38,14 @@ resource "redfish_idrac_server_configuration_profile_export" "share_type_local"
     target     = ["NIC"]
     share_type = "LOCAL"
   }
+
+  // the filter is optional, the exported profile is parsed into `components`
+  // with all its attributes without it
+  filter = {
+    components = ["^NIC\\."]
+    attributes = ["^VLan", "^WakeOnLan$"]
+  }
+
   lifecycle {
     replace_triggered_by = [terraform_data.trigger_by_timestamp]
   }
@@ -158,4 +166,9 @@ resource "redfish_idrac_server_configuration_profile_export" "share_type_http" {
   lifecycle {
     replace_triggered_by = [terraform_data.trigger_by_timestamp]
   }
-}
\ No newline at end of file
+}
+
+# the attribute values of the components of the exported profile, by FQDD and attribute name
+output "nic_components" {
+  value = { for k, v in redfish_idrac_server_configuration_profile_export.share_type_local : k => v.components }
+}
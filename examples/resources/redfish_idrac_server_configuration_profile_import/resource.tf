@@ -45,6 +45,62 @@ resource "redfish_idrac_server_configuration_profile_import" "share_type_local"
   }
 }
 
+# the variables of each server substituted in the profile template
+locals {
+  server_vars = {
+    "my-server-1" = { hostname = "my-server-1", ntp_server = "10.0.0.10" }
+    "my-server-2" = { hostname = "my-server-2", ntp_server = "10.0.0.10" }
+  }
+}
+
+# the profile is imported again when the template changes
+resource "terraform_data" "profile_template" {
+  input = filesha256("${path.module}/profile.xml.tmpl")
+}
+
+resource "redfish_idrac_server_configuration_profile_import" "local_file" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  share_parameters = {
+    filename   = "profile.xml"
+    target     = ["IDRAC"]
+    share_type = "LOCAL"
+  }
+
+  // the profile is a Go template, such as
+  // <Attribute Name="NIC.1#DNSRacName">{{ .hostname }}</Attribute>
+  import_file   = "${path.module}/profile.xml.tmpl"
+  template_vars = local.server_vars[each.key]
+
+  // only these components of the profile are imported
+  fqdds = ["iDRAC.Embedded.1"]
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.profile_template]
+  }
+}
+
+# the attributes which failed to import on each server
+output "import_failures" {
+  value = {
+    for k, v in redfish_idrac_server_configuration_profile_import.local_file : k => [
+      for r in v.results : "${r.fqdd} ${r.name}: ${r.message}" if r.status == "Failure"
+    ]
+  }
+}
+
 resource "redfish_idrac_server_configuration_profile_import" "share_type_nfs" {
   for_each = var.rack1
 
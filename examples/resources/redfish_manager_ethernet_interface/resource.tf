@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_manager_ethernet_interface" "bmc" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // the first interface of the manager is used when interface_id is not given
+  interface_id = "NIC.1"
+  hostname     = "idrac-${each.key}"
+
+  // static IPv4 addressing, the settings which are not configured are left unchanged
+  dhcpv4_enabled = false
+  ipv4_static_addresses = [
+    {
+      address     = "192.168.0.120"
+      subnet_mask = "255.255.255.0"
+      gateway     = "192.168.0.1"
+    }
+  ]
+  static_name_servers = ["192.168.0.2", "192.168.0.3"]
+
+  vlan = {
+    enabled = false
+  }
+
+  // time to wait for the manager on its new address when the endpoint address changes
+  reconnect_timeout = 300
+}
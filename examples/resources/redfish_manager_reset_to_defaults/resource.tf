@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_manager_reset_to_defaults" "decommission" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // reset all the settings of the iDRAC except the users and the network settings
+  reset_type = "Default"
+  // the reset erases the settings of the server and must be confirmed
+  confirm = true
+
+  // wait for the iDRAC to be ready after the reset, the default for the reset types which keep the network settings
+  wait_for_ready = true
+}
+
+resource "redfish_manager_reset_to_defaults" "bios" {
+  for_each = var.rack1
+
+  redfish_server {
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // reset the BIOS attributes, they are applied on the next reboot of the system
+  reset_type = "ResetBios"
+  confirm    = true
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_manager_time" "time" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // synchronize the clock with up to 3 NTP servers
+  ntp_enabled = true
+  ntp_servers = ["0.pool.ntp.org", "1.pool.ntp.org"]
+
+  // time zone allowed by the iDRAC, for example UTC or CST6CDT
+  time_zone = "UTC"
+
+  // set the date and time manually, this requires ntp_enabled to be false
+  # date_time = "2026-01-02T03:04:05+00:00"
+}
+
+output "clock_skew_seconds" {
+  value = { for key, time in redfish_manager_time.time : key => time.clock_skew_seconds }
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_secure_boot" "secure_boot" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+    user          = each.value.user
+    password      = each.value.password
+    endpoint      = each.value.endpoint
+    ssl_insecure  = true
+  }
+
+  // enable Secure Boot, the server is rebooted to apply the change
+  secure_boot_enable = true
+  secure_boot_mode   = "DeployedMode"
+
+  // reset the keys to their defaults when the resource is created or when the value changes
+  reset_keys = "ResetAllKeysToDefault"
+
+  // enroll the kernel signing key in the db database, the other certificates of the database are left as is
+  certificates = [
+    {
+      database_id        = "db"
+      certificate_string = file("${path.module}/kernel-signing-key.pem")
+    }
+  ]
+
+  reset_type       = "GracefulRestart"
+  reset_timeout    = 120
+  bios_job_timeout = 1200
+}
@@ -209,3 +209,19 @@ func checkValueDisplayNameArray(value string, possibleValues []AttributeEnumValu
 	}
 	return fmt.Errorf("enumeration value given is not permitted. Allowed values: %s", helpErrMsg)
 }
+
+// AllowableValues returns the values accepted by an Enumeration attribute, or nil when the attribute is not an enumeration
+func (m *ManagerAttributeRegistry) AllowableValues(attributeName string) ([]string, error) {
+	attr, err := m.getAttribute(attributeName)
+	if err != nil {
+		return nil, err
+	}
+	if attr.Type != "Enumeration" {
+		return nil, nil
+	}
+	values := make([]string, 0, len(attr.Value))
+	for _, v := range attr.Value {
+		values = append(values, v.ValueDisplayName)
+	}
+	return values, nil
+}
@@ -273,6 +273,24 @@ func TestDellAttributesRegistry(t *testing.T) {
 		assertGetAttributeType(t, &registry, "LCD.1.ChassisIdentifyDuration", "int")
 		assertGetAttributeType(t, &registry, "PCIeSlotLFM.3.MaxLFM", "int")
 	})
+
+	t.Run("Test AllowableValues method", func(t *testing.T) {
+		values, err := registry.AllowableValues("LCAttributes.1.AutoBackup")
+		if err != nil || len(values) != 2 {
+			t.Fatalf("expected the two values of the enumeration, got %v, %v", values, err)
+		}
+		assertField(t, values[0], "Disabled")
+		assertField(t, values[1], "Enabled")
+
+		values, err = registry.AllowableValues("OpenIDConnectServer.12.Name")
+		if err != nil || values != nil {
+			t.Errorf("expected no values for a String attribute, got %v, %v", values, err)
+		}
+
+		if _, err := registry.AllowableValues("non.existent.property"); err == nil {
+			t.Errorf("expected an error for a property which doesn't exist")
+		}
+	})
 }
 
 func assertCheckAttribute(t testing.TB, hasError bool, err error) {
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	// ClearAllJobsID deletes every job of the job queue which is not running
+	ClearAllJobsID = "JID_CLEARALL"
+	// ClearAllJobsForceID deletes every job of the job queue, including the running ones, and restarts the Lifecycle Controller
+	ClearAllJobsForceID = "JID_CLEARALL_FORCE"
+)
+
+// Job is used to represent a Dell Lifecycle Controller job of the iDRAC job queue
+type Job struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// ActualRunningStartTime shall contain the time the job started running.
+	ActualRunningStartTime string
+	// ActualRunningStopTime shall contain the time the job stopped running.
+	ActualRunningStopTime string
+	// CompletionTime shall contain the time the job completed.
+	CompletionTime string
+	// EndTime shall contain the time after which the job is not started anymore.
+	EndTime string
+	// JobState shall contain the state of the job, such as Scheduled, Running, Completed or Failed.
+	JobState string
+	// JobType shall contain the type of the job, such as BIOSConfiguration or RAIDConfiguration.
+	JobType string
+	// Message shall contain the status message of the job.
+	Message string
+	// MessageArgs shall contain the arguments of the status message.
+	MessageArgs []string
+	// MessageID shall contain the identifier of the status message.
+	MessageID string `json:"MessageId"`
+	// PercentComplete shall contain the progress of the job.
+	PercentComplete int
+	// StartTime shall contain the scheduled start time of the job.
+	StartTime string
+	// TargetSettingsURI shall contain the URI of the settings the job applies.
+	TargetSettingsURI string
+}
+
+// JobService is used to represent the Dell job service of the iDRAC
+type JobService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+
+	deleteJobQueueTarget string
+}
+
+// UnmarshalJSON unmarshals the Dell job service object from the raw JSON
+func (j *JobService) UnmarshalJSON(data []byte) error {
+	type temp JobService
+	type action struct {
+		Target string
+	}
+	var t struct {
+		temp
+		Actions struct {
+			DeleteJobQueue action `json:"#DellJobService.DeleteJobQueue"`
+		}
+	}
+
+	err := json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+
+	*j = JobService(t.temp)
+	j.deleteJobQueueTarget = t.Actions.DeleteJobQueue.Target
+
+	return nil
+}
+
+// DeleteJobQueue deletes the job with the given ID from the job queue. ClearAllJobsID and
+// ClearAllJobsForceID delete every job of the queue.
+func (j *JobService) DeleteJobQueue(jobID string) error {
+	if j.deleteJobQueueTarget == "" {
+		return errors.New("DeleteJobQueue is not supported by this job service")
+	}
+	payload := map[string]string{
+		"JobID": jobID,
+	}
+	resp, err := j.PostWithResponse(j.deleteJobQueueTarget, payload)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetJobService returns a JobService pointer given a client and a uri to query
+func GetJobService(c common.Client, uri string) (*JobService, error) {
+	return common.GetObject[JobService](c, uri)
+}
+
+// ListReferenceJobs returns a slice of Job pointers given a client and the link of the job collection
+func ListReferenceJobs(c common.Client, link common.Link) ([]*Job, error) {
+	return common.GetCollectionObjects[Job](c, link.String())
+}
+
+// HasJobQueue reports whether the manager provides a job queue
+func (m *ManagerExtended) HasJobQueue() bool {
+	return m.links.Jobs != ""
+}
+
+// Jobs returns the jobs of the job queue of the manager
+func (m *ManagerExtended) Jobs() ([]*Job, error) {
+	if m.links.Jobs == "" {
+		return nil, errors.New("the manager does not provide a job queue")
+	}
+	return ListReferenceJobs(m.GetClient(), m.links.Jobs)
+}
+
+// CreateJob creates a configuration job in the job queue of the manager, which applies the pending settings
+// of the target settings URI on the next reboot of the server. It returns the URI of the created job.
+func (m *ManagerExtended) CreateJob(targetSettingsURI string) (string, error) {
+	if m.links.Jobs == "" {
+		return "", errors.New("the manager does not provide a job queue")
+	}
+	payload := map[string]string{
+		"TargetSettingsURI": targetSettingsURI,
+	}
+	resp, err := m.PostWithResponse(m.links.Jobs.String(), payload)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", errors.New("the job queue did not return the location of the created job")
+	}
+	return location, nil
+}
+
+// JobService returns the job service of the manager
+func (m *ManagerExtended) JobService() (*JobService, error) {
+	if m.links.DellJobService == "" {
+		return nil, errors.New("the manager does not provide a job service")
+	}
+	return GetJobService(m.GetClient(), m.links.DellJobService.String())
+}
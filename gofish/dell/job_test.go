@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var jobServiceBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#DellJobService.DellJobService",
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService",
+	"@odata.type": "#DellJobService.v1_6_0.DellJobService",
+	"Actions": {
+		"#DellJobService.DeleteJobQueue": {
+			"JobID@Redfish.AllowableValues": [
+				"JID_CLEARALL",
+				"JID_CLEARALL_FORCE"
+			],
+			"target": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService/Actions/DellJobService.DeleteJobQueue"
+		},
+		"#DellJobService.SetupJobQueue": {
+			"target": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService/Actions/DellJobService.SetupJobQueue"
+		}
+	},
+	"Description": "The DellJobService resource provides some actions to support Job management functionality.",
+	"Id": "Job Service",
+	"Name": "DellJobService"
+}
+`
+
+var jobBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#DellJob.DellJob",
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs/JID_878682850779",
+	"@odata.type": "#DellJob.v1_5_0.DellJob",
+	"ActualRunningStartTime": "2026-01-02T03:04:05",
+	"ActualRunningStopTime": "2026-01-02T03:09:05",
+	"CompletionTime": "2026-01-02T03:09:05",
+	"Description": "Job Instance",
+	"EndTime": "TIME_NA",
+	"Id": "JID_878682850779",
+	"JobState": "Completed",
+	"JobType": "BIOSConfiguration",
+	"Message": "Job completed successfully.",
+	"MessageArgs": [],
+	"MessageId": "PR19",
+	"Name": "Configure: BIOS.Setup.1-1",
+	"PercentComplete": 100,
+	"StartTime": "TIME_NOW",
+	"TargetSettingsURI": null
+}
+`
+
+func TestDellJobService(t *testing.T) {
+	var result JobService
+	err := json.NewDecoder(strings.NewReader(jobServiceBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.JobService mocked json")
+	}
+
+	assertField(t, result.ID, "Job Service")
+	assertField(t, result.deleteJobQueueTarget, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService/Actions/DellJobService.DeleteJobQueue")
+}
+
+func TestDellJob(t *testing.T) {
+	var result Job
+	err := json.NewDecoder(strings.NewReader(jobBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.Job mocked json")
+	}
+
+	assertField(t, result.ID, "JID_878682850779")
+	assertField(t, result.JobState, "Completed")
+	assertField(t, result.JobType, "BIOSConfiguration")
+	assertField(t, result.MessageID, "PR19")
+	if result.PercentComplete != 100 {
+		t.Errorf("PercentComplete: got %d, want 100", result.PercentComplete)
+	}
+}
+
+func TestDellManagerWithoutJobQueue(t *testing.T) {
+	var result redfish.Manager
+	if err := json.NewDecoder(strings.NewReader(`{"Id": "iDRAC.Embedded.1", "Actions": {"Oem": {}}, "Links": {"Oem": {}}, "Oem": {}}`)).Decode(&result); err != nil {
+		t.Fatalf("couldn't decode redfish.Manager mocked json")
+	}
+	dellManager, err := Manager(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.Manager mocked json")
+	}
+	if dellManager.HasJobQueue() {
+		t.Errorf("expected no job queue without a Jobs link")
+	}
+	if _, err := dellManager.Jobs(); err == nil {
+		t.Errorf("expected an error reading the jobs without a job queue")
+	}
+}
+
+func TestDellJobServiceActionsNotSupported(t *testing.T) {
+	var result JobService
+	if err := result.DeleteJobQueue(ClearAllJobsForceID); err == nil {
+		t.Errorf("expected an error when DeleteJobQueue is not supported")
+	}
+}
+
+func TestDellManagerCreateJob(t *testing.T) {
+	var result redfish.Manager
+	if err := json.NewDecoder(strings.NewReader(managerBody)).Decode(&result); err != nil {
+		t.Fatalf("couldn't decode redfish.Manager mocked json")
+	}
+	dellManager, err := Manager(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.Manager mocked json")
+	}
+	if !dellManager.HasJobQueue() {
+		t.Fatalf("expected the manager to provide a job queue")
+	}
+
+	header := http.Header{}
+	header.Set("Location", "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs/JID_878682850779")
+	testClient := &common.TestClient{
+		CustomReturnForActions: map[string][]interface{}{
+			http.MethodPost: {&http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(""))}},
+		},
+	}
+	dellManager.SetClient(testClient)
+
+	location, err := dellManager.CreateJob("/redfish/v1/Systems/System.Embedded.1/Bios/Settings")
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	assertField(t, location, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs/JID_878682850779")
+
+	calls := testClient.CapturedCalls()
+	if len(calls) != 1 || calls[0].URL != "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs" ||
+		!strings.Contains(calls[0].Payload, "TargetSettingsURI:/redfish/v1/Systems/System.Embedded.1/Bios/Settings") {
+		t.Errorf("unexpected calls: %+v", calls)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/stmcginnis/gofish/common"
+)
+
+// License is used to represent a Dell license installed on the iDRAC
+type License struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// AutoRenew shall indicate whether the license is renewed automatically.
+	AutoRenew bool
+	// EntitlementID shall contain the unique identifier of the license.
+	EntitlementID string
+	// EvalLicenseTimeRemainingDays shall contain the number of days before an evaluation license expires.
+	EvalLicenseTimeRemainingDays int
+	// LicenseDescription shall contain the description of the features of the license.
+	LicenseDescription []string
+	// LicenseInstallDate shall contain the date the license was installed.
+	LicenseInstallDate string
+	// LicenseOrigin shall contain how the license was obtained.
+	LicenseOrigin string
+	// LicensePrimaryStatus shall contain the status of the license.
+	LicensePrimaryStatus string
+	// LicenseSoldDate shall contain the date the license was sold.
+	LicenseSoldDate string
+	// LicenseType shall contain the type of the license, such as Perpetual or Evaluation.
+	LicenseType string
+}
+
+// LicenseManagementService is used to represent the Dell license management service of the iDRAC
+type LicenseManagementService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+
+	importLicenseTarget string
+	deleteLicenseTarget string
+}
+
+// UnmarshalJSON unmarshals the Dell license management service object from the raw JSON
+func (l *LicenseManagementService) UnmarshalJSON(data []byte) error {
+	type temp LicenseManagementService
+	type action struct {
+		Target string
+	}
+	var t struct {
+		temp
+		Actions struct {
+			ImportLicense action `json:"#DellLicenseManagementService.ImportLicense"`
+			DeleteLicense action `json:"#DellLicenseManagementService.DeleteLicense"`
+		}
+	}
+
+	err := json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+
+	*l = LicenseManagementService(t.temp)
+	l.importLicenseTarget = t.Actions.ImportLicense.Target
+	l.deleteLicenseTarget = t.Actions.DeleteLicense.Target
+
+	return nil
+}
+
+// ImportLicense installs a license on the device given by fqdd. licenseFile is the base64 encoded license file.
+func (l *LicenseManagementService) ImportLicense(fqdd, licenseFile, importOptions string) error {
+	if l.importLicenseTarget == "" {
+		return errors.New("ImportLicense is not supported by this license management service")
+	}
+	payload := map[string]string{
+		"FQDD":          fqdd,
+		"LicenseFile":   licenseFile,
+		"ImportOptions": importOptions,
+	}
+	resp, err := l.PostWithResponse(l.importLicenseTarget, payload)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// DeleteLicense removes the license with the given entitlement ID
+func (l *LicenseManagementService) DeleteLicense(entitlementID, deleteOptions string) error {
+	if l.deleteLicenseTarget == "" {
+		return errors.New("DeleteLicense is not supported by this license management service")
+	}
+	payload := map[string]string{
+		"EntitlementID": entitlementID,
+		"DeleteOptions": deleteOptions,
+	}
+	resp, err := l.PostWithResponse(l.deleteLicenseTarget, payload)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetLicenseManagementService returns a LicenseManagementService pointer given a client and a uri to query
+func GetLicenseManagementService(c common.Client, uri string) (*LicenseManagementService, error) {
+	return common.GetObject[LicenseManagementService](c, uri)
+}
+
+// ListReferenceLicenses returns a slice of License pointers given a client and the link of the license collection
+func ListReferenceLicenses(c common.Client, link common.Link) ([]*License, error) {
+	return common.GetCollectionObjects[License](c, link.String())
+}
+
+// Licenses returns the licenses installed on the manager
+func (m *ManagerExtended) Licenses() ([]*License, error) {
+	return ListReferenceLicenses(m.GetClient(), m.links.DellLicenseCollection)
+}
+
+// LicenseManagementService returns the license management service of the manager
+func (m *ManagerExtended) LicenseManagementService() (*LicenseManagementService, error) {
+	if m.links.DellLicenseManagementService == "" {
+		return nil, errors.New("the manager does not provide a license management service")
+	}
+	return GetLicenseManagementService(m.GetClient(), m.links.DellLicenseManagementService.String())
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var licenseManagementServiceBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#DellLicenseManagementService.DellLicenseManagementService",
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService",
+	"@odata.type": "#DellLicenseManagementService.v1_1_0.DellLicenseManagementService",
+	"Actions": {
+		"#DellLicenseManagementService.DeleteLicense": {
+			"target": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService/Actions/DellLicenseManagementService.DeleteLicense"
+		},
+		"#DellLicenseManagementService.ImportLicense": {
+			"target": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService/Actions/DellLicenseManagementService.ImportLicense"
+		}
+	},
+	"Description": "The DellLicenseManagementService resource provides some actions to support License Management functionality.",
+	"Id": "DellLicenseManagementService",
+	"Name": "DellLicenseManagementService"
+}
+`
+
+var licenseBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#DellLicense.DellLicense",
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenses/FD00000011685520",
+	"@odata.type": "#DellLicense.v1_1_0.DellLicense",
+	"AutoRenew": false,
+	"Description": "This resource represents the license installed on the iDRAC.",
+	"EntitlementID": "FD00000011685520",
+	"EvalLicenseTimeRemainingDays": 0,
+	"Id": "FD00000011685520",
+	"LicenseDescription": [
+		"iDRAC9 Enterprise License"
+	],
+	"LicenseInstallDate": "2026-01-02T03:04:05-06:00",
+	"LicenseOrigin": "Installed",
+	"LicensePrimaryStatus": "OK",
+	"LicenseSoldDate": "2025-12-01T00:00:00-06:00",
+	"LicenseType": "Perpetual",
+	"Name": "DellLicense"
+}
+`
+
+func TestDellLicenseManagementService(t *testing.T) {
+	var result LicenseManagementService
+	err := json.NewDecoder(strings.NewReader(licenseManagementServiceBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.LicenseManagementService mocked json")
+	}
+
+	assertField(t, result.ID, "DellLicenseManagementService")
+	assertField(t, result.importLicenseTarget, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService/Actions/DellLicenseManagementService.ImportLicense")
+	assertField(t, result.deleteLicenseTarget, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService/Actions/DellLicenseManagementService.DeleteLicense")
+}
+
+func TestDellLicense(t *testing.T) {
+	var result License
+	err := json.NewDecoder(strings.NewReader(licenseBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.License mocked json")
+	}
+
+	assertField(t, result.EntitlementID, "FD00000011685520")
+	assertField(t, result.LicenseType, "Perpetual")
+	assertField(t, result.LicensePrimaryStatus, "OK")
+	assertField(t, result.LicenseDescription[0], "iDRAC9 Enterprise License")
+	assertBool(t, result.AutoRenew, false)
+}
+
+func TestDellLicenseActionsNotSupported(t *testing.T) {
+	var result LicenseManagementService
+	if err := result.ImportLicense("iDRAC.Embedded.1", "", "Force"); err == nil {
+		t.Errorf("expected an error when ImportLicense is not supported")
+	}
+	if err := result.DeleteLicense("FD00000011685520", "Force"); err == nil {
+		t.Errorf("expected an error when DeleteLicense is not supported")
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// MemoryExtended contains gofish memory as well as its OEM data.
+type MemoryExtended struct {
+	*redfish.Memory
+	Oem MemoryOEM
+}
+
+// MemoryOEM contains the OEM data.
+type MemoryOEM struct {
+	Dell MemoryOEMDell
+}
+
+// MemoryOEMDell contains the Dell data.
+type MemoryOEMDell struct {
+	DellMemory DellMemory
+}
+
+// DellMemory contains the Dell memory data.
+// nolint: revive
+type DellMemory struct {
+	BankLabel                           string
+	LastSystemInventoryTime             string
+	LastUpdateTime                      string
+	ManufactureDate                     string
+	MemoryTechnology                    string
+	Model                               string
+	RemainingRatedWriteEndurancePercent int64
+	SystemEraseCapability               string
+}
+
+// Memory given redfish.Memory, returns dell.MemoryExtended.
+// This is a wrapper that extracts and parses the OEM data.
+func Memory(memory *redfish.Memory) (*MemoryExtended, error) {
+	memoryExtended := &MemoryExtended{
+		Memory: memory,
+		Oem:    MemoryOEM{},
+	}
+
+	rawDataBytes, err := GetRawDataBytes(memory)
+	if err != nil {
+		return memoryExtended, err
+	}
+
+	if oemRawData, found := GetNodeFromRawDataBytes(rawDataBytes, "Oem"); found == nil {
+		var oemData MemoryOEM
+		if err = json.Unmarshal(oemRawData, &oemData); err == nil {
+			memoryExtended.Oem = oemData
+		}
+	}
+
+	return memoryExtended, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var memoryBody = `
+{
+	"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Memory/DIMM.Socket.A1",
+	"Id": "DIMM.Socket.A1",
+	"Name": "DIMM A1",
+	"CapacityMiB": 32768,
+	"DeviceLocator": "DIMM A1",
+	"Manufacturer": "Hynix Semiconductor",
+	"PartNumber": "HMA84GR7CJR4N-XN",
+	"RankCount": 2,
+	"MemoryLocation": {
+		"Slot": 1,
+		"Socket": 1
+	},
+	"Oem": {
+		"Dell": {
+			"DellMemory": {
+				"BankLabel": "A",
+				"ManufactureDate": "Mon Jun 15 07:00:00 2020 UTC",
+				"MemoryTechnology": "DRAM",
+				"Model": "DDR4 DIMM",
+				"RemainingRatedWriteEndurancePercent": 100,
+				"SystemEraseCapability": "NotSupported"
+			}
+		}
+	}
+}
+`
+
+func TestDellMemory(t *testing.T) {
+	var memory redfish.Memory
+	if err := json.NewDecoder(strings.NewReader(memoryBody)).Decode(&memory); err != nil {
+		t.Fatalf("couldn't decode redfish.Memory mocked json")
+	}
+
+	result, err := Memory(&memory)
+	if err != nil {
+		t.Fatalf("couldn't extend redfish.Memory: %v", err)
+	}
+	assertField(t, result.ID, "DIMM.Socket.A1")
+	assertField(t, result.PartNumber, "HMA84GR7CJR4N-XN")
+	assertInt(t, result.RankCount, 2)
+	assertField(t, result.Oem.Dell.DellMemory.BankLabel, "A")
+	assertField(t, result.Oem.Dell.DellMemory.Model, "DDR4 DIMM")
+	assertInt(t, int(result.Oem.Dell.DellMemory.RemainingRatedWriteEndurancePercent), 100)
+}
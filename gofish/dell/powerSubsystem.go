@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// PowerSubsystemExtended contains gofish PowerSubsystem as well as the links gofish does not expose.
+type PowerSubsystemExtended struct {
+	*redfish.PowerSubsystem
+	powerSupplies string
+}
+
+// PowerSubsystem given redfish.PowerSubsystem, returns dell.PowerSubsystemExtended.
+// The resource is read again since gofish does not keep the link to its power supplies.
+func PowerSubsystem(powerSubsystem *redfish.PowerSubsystem) (*PowerSubsystemExtended, error) {
+	powerSubsystemExtended := &PowerSubsystemExtended{
+		PowerSubsystem: powerSubsystem,
+	}
+
+	resp, err := powerSubsystem.GetClient().Get(powerSubsystem.ODataID)
+	if err != nil {
+		return powerSubsystemExtended, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var links struct {
+		PowerSupplies common.Link
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&links); err != nil {
+		return powerSubsystemExtended, err
+	}
+	powerSubsystemExtended.powerSupplies = links.PowerSupplies.String()
+
+	return powerSubsystemExtended, nil
+}
+
+// PowerSupplyUnits returns the power supplies of the PowerSupplies link of the power subsystem, none when it has no link.
+func (powerSubsystem *PowerSubsystemExtended) PowerSupplyUnits() ([]*redfish.PowerSupplyUnit, error) {
+	if powerSubsystem.powerSupplies == "" {
+		return nil, nil
+	}
+	return redfish.ListReferencedPowerSupplyUnits(powerSubsystem.GetClient(), powerSubsystem.powerSupplies)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var powerSubsystemBody = `
+{
+	"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem",
+	"@odata.type": "#PowerSubsystem.v1_1_0.PowerSubsystem",
+	"Id": "PowerSubsystem",
+	"Name": "Power Subsystem",
+	"PowerSupplies": {
+		"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem/PowerSupplyUnits"
+	}
+}
+`
+
+func TestPowerSubsystemPowerSupplyUnits(t *testing.T) {
+	response := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}
+	testClient := &common.TestClient{
+		CustomReturnForActions: map[string][]interface{}{
+			http.MethodGet: {
+				response(powerSubsystemBody),
+				response(`{"Members": [{"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem/PowerSupplyUnits/PSU.Slot.1"}]}`),
+				response(`{"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem/PowerSupplyUnits/PSU.Slot.1", "Id": "PSU.Slot.1"}`),
+			},
+		},
+	}
+	powerSubsystem := &redfish.PowerSubsystem{}
+	powerSubsystem.ODataID = "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem"
+	powerSubsystem.SetClient(testClient)
+
+	powerSubsystemExtended, err := PowerSubsystem(powerSubsystem)
+	if err != nil {
+		t.Fatalf("PowerSubsystem: %v", err)
+	}
+	units, err := powerSubsystemExtended.PowerSupplyUnits()
+	if err != nil {
+		t.Fatalf("PowerSupplyUnits: %v", err)
+	}
+	if len(units) != 1 {
+		t.Fatalf("expected one power supply, got %d", len(units))
+	}
+	assertField(t, units[0].ID, "PSU.Slot.1")
+
+	// the power supplies are read from the link of the resource
+	calls := testClient.CapturedCalls()
+	if len(calls) < 2 {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	assertField(t, calls[1].URL, "/redfish/v1/Chassis/System.Embedded.1/PowerSubsystem/PowerSupplyUnits")
+}
+
+func TestPowerSubsystemWithoutPowerSupplies(t *testing.T) {
+	testClient := &common.TestClient{
+		CustomReturnForActions: map[string][]interface{}{
+			http.MethodGet: {&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"Id": "PowerSubsystem"}`))}},
+		},
+	}
+	powerSubsystem := &redfish.PowerSubsystem{}
+	powerSubsystem.SetClient(testClient)
+
+	powerSubsystemExtended, err := PowerSubsystem(powerSubsystem)
+	if err != nil {
+		t.Fatalf("PowerSubsystem: %v", err)
+	}
+	units, err := powerSubsystemExtended.PowerSupplyUnits()
+	if err != nil || len(units) != 0 {
+		t.Fatalf("expected no power supplies without a link, got %v and %v", units, err)
+	}
+	if calls := testClient.CapturedCalls(); len(calls) != 1 {
+		t.Errorf("unexpected calls: %+v", calls)
+	}
+}
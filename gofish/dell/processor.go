@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// ProcessorExtended contains gofish processor as well as its OEM data.
+type ProcessorExtended struct {
+	*redfish.Processor
+	Oem ProcessorOEM
+}
+
+// ProcessorOEM contains the OEM data.
+type ProcessorOEM struct {
+	Dell ProcessorOEMDell
+}
+
+// ProcessorOEMDell contains the Dell data.
+type ProcessorOEMDell struct {
+	DellProcessor DellProcessor
+}
+
+// DellProcessor contains the Dell processor data.
+// nolint: revive
+type DellProcessor struct {
+	CPUFamily                       string
+	CPUStatus                       string
+	Cache1InstalledSizeKB           int64
+	Cache2InstalledSizeKB           int64
+	Cache3InstalledSizeKB           int64
+	CurrentClockSpeedMhz            int64
+	ExternalBusClockSpeedMhz        int64
+	HyperThreadingCapable           string
+	HyperThreadingEnabled           string
+	LastSystemInventoryTime         string
+	LastUpdateTime                  string
+	TurboModeCapable                string
+	TurboModeEnabled                string
+	VirtualizationTechnologyCapable string
+	VirtualizationTechnologyEnabled string
+	Volts                           string
+}
+
+// Processor given redfish.Processor, returns dell.ProcessorExtended.
+// This is a wrapper that extracts and parses the OEM data.
+func Processor(processor *redfish.Processor) (*ProcessorExtended, error) {
+	processorExtended := &ProcessorExtended{
+		Processor: processor,
+		Oem:       ProcessorOEM{},
+	}
+
+	rawDataBytes, err := GetRawDataBytes(processor)
+	if err != nil {
+		return processorExtended, err
+	}
+
+	if oemRawData, found := GetNodeFromRawDataBytes(rawDataBytes, "Oem"); found == nil {
+		var oemData ProcessorOEM
+		if err = json.Unmarshal(oemRawData, &oemData); err == nil {
+			processorExtended.Oem = oemData
+		}
+	}
+
+	return processorExtended, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var processorBody = `
+{
+	"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Processors/CPU.Socket.1",
+	"Id": "CPU.Socket.1",
+	"Name": "CPU 1",
+	"Manufacturer": "Intel",
+	"TotalCores": 20,
+	"Oem": {
+		"Dell": {
+			"DellProcessor": {
+				"CPUFamily": "Intel(R) Xeon(TM)",
+				"Cache3InstalledSizeKB": 28160,
+				"CurrentClockSpeedMhz": 2200,
+				"HyperThreadingEnabled": "Yes",
+				"Volts": "1.8"
+			}
+		}
+	}
+}
+`
+
+func TestDellProcessor(t *testing.T) {
+	var processor redfish.Processor
+	if err := json.NewDecoder(strings.NewReader(processorBody)).Decode(&processor); err != nil {
+		t.Fatalf("couldn't decode redfish.Processor mocked json")
+	}
+
+	result, err := Processor(&processor)
+	if err != nil {
+		t.Fatalf("couldn't extend redfish.Processor: %v", err)
+	}
+	assertField(t, result.ID, "CPU.Socket.1")
+	assertInt(t, result.TotalCores, 20)
+	assertField(t, result.Oem.Dell.DellProcessor.CPUFamily, "Intel(R) Xeon(TM)")
+	assertInt(t, int(result.Oem.Dell.DellProcessor.Cache3InstalledSizeKB), 28160)
+	assertField(t, result.Oem.Dell.DellProcessor.HyperThreadingEnabled, "Yes")
+}
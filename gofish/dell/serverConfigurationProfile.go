@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	// ServerConfigurationProfileFailure is the status of an attribute which could not be imported or previewed
+	ServerConfigurationProfileFailure = "Failure"
+)
+
+// ServerConfigurationProfileResult is the result of a component attribute of a Server Configuration Profile import
+// or preview, reported in the messages of its task
+type ServerConfigurationProfileResult struct {
+	// Message shall contain the message of the result.
+	Message string
+	// MessageID shall contain the identifier of the message.
+	MessageID string
+	// Severity shall contain the severity of the message.
+	Severity string
+	// FQDD shall contain the fully qualified device descriptor of the component of the attribute.
+	FQDD string
+	// Name shall contain the name of the attribute.
+	Name string
+	// OldValue shall contain the value of the attribute before the import.
+	OldValue string
+	// NewValue shall contain the value of the attribute in the profile.
+	NewValue string
+	// Status shall contain the status of the attribute, Success or Failure.
+	Status string
+	// ErrCode shall contain the error code of the attribute.
+	ErrCode int
+}
+
+// UnmarshalJSON unmarshals a message of a Server Configuration Profile task, with the result in its Dell OEM object
+func (r *ServerConfigurationProfileResult) UnmarshalJSON(data []byte) error {
+	var t struct {
+		Message   string
+		MessageID string `json:"MessageId"`
+		Severity  string
+		Oem       struct {
+			Dell struct {
+				FQDD     string
+				Name     string
+				OldValue string
+				NewValue string
+				Status   string
+				ErrCode  int
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*r = ServerConfigurationProfileResult{
+		Message:   t.Message,
+		MessageID: t.MessageID,
+		Severity:  t.Severity,
+		FQDD:      t.Oem.Dell.FQDD,
+		Name:      t.Oem.Dell.Name,
+		OldValue:  t.Oem.Dell.OldValue,
+		NewValue:  t.Oem.Dell.NewValue,
+		Status:    t.Oem.Dell.Status,
+		ErrCode:   t.Oem.Dell.ErrCode,
+	}
+	return nil
+}
+
+// ServerConfigurationProfileTask is the task of a Server Configuration Profile import or preview job
+type ServerConfigurationProfileTask struct {
+	common.Entity
+
+	// TaskState shall contain the state of the task, such as Running, Completed or Exception.
+	TaskState string
+	// JobState shall contain the state of the Dell job of the task, such as Completed or Failed.
+	JobState string
+	// Message shall contain the status message of the Dell job of the task.
+	Message string
+	// Results shall contain the messages of the task, with the result of each attribute of the profile.
+	Results []ServerConfigurationProfileResult
+}
+
+// UnmarshalJSON unmarshals a Server Configuration Profile task from the raw JSON
+func (t *ServerConfigurationProfileTask) UnmarshalJSON(data []byte) error {
+	type temp ServerConfigurationProfileTask
+	var task struct {
+		temp
+		Messages []ServerConfigurationProfileResult
+		Oem      struct {
+			Dell struct {
+				JobState string
+				Message  string
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &task); err != nil {
+		return err
+	}
+
+	*t = ServerConfigurationProfileTask(task.temp)
+	t.JobState = task.Oem.Dell.JobState
+	t.Message = task.Oem.Dell.Message
+	t.Results = task.Messages
+	return nil
+}
+
+// Failures returns the results of the attributes which could not be imported or previewed
+func (t *ServerConfigurationProfileTask) Failures() []ServerConfigurationProfileResult {
+	var failures []ServerConfigurationProfileResult
+	for _, result := range t.Results {
+		if result.Status == ServerConfigurationProfileFailure {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// GetServerConfigurationProfileTask returns the task of a Server Configuration Profile import or preview job
+func GetServerConfigurationProfileTask(c common.Client, uri string) (*ServerConfigurationProfileTask, error) {
+	return common.GetObject[ServerConfigurationProfileTask](c, uri)
+}
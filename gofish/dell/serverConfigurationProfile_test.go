@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var serverConfigurationProfileTaskBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#Task.Task",
+	"@odata.id": "/redfish/v1/TaskService/Tasks/JID_878699900111",
+	"@odata.type": "#Task.v1_5_1.Task",
+	"Id": "JID_878699900111",
+	"Name": "Preview Configuration",
+	"TaskState": "Completed",
+	"TaskStatus": "Warning",
+	"Messages": [
+		{
+			"Message": "The Server Configuration Profile preview found 1 error(s).",
+			"MessageArgs": [],
+			"MessageId": "SYS082",
+			"Severity": "Warning"
+		},
+		{
+			"Message": "The attribute value is not valid.",
+			"MessageId": "SYS428",
+			"Severity": "Warning",
+			"Oem": {
+				"Dell": {
+					"@odata.type": "#DellManager.v1_0_0.ServerConfigurationProfileResults",
+					"ErrCode": 2,
+					"FQDD": "BIOS.Setup.1-1",
+					"Name": "BootMode",
+					"NewValue": "Legacy",
+					"OldValue": "Uefi",
+					"Status": "Failure"
+				}
+			}
+		},
+		{
+			"Message": "The attribute can be applied.",
+			"MessageId": "SYS427",
+			"Severity": "Informational",
+			"Oem": {
+				"Dell": {
+					"ErrCode": 0,
+					"FQDD": "iDRAC.Embedded.1",
+					"Name": "Time.1#Timezone",
+					"NewValue": "UTC",
+					"OldValue": "CST6CDT",
+					"Status": "Success"
+				}
+			}
+		}
+	],
+	"Oem": {
+		"Dell": {
+			"JobState": "Completed",
+			"JobType": "ImportConfiguration",
+			"Message": "The Server Configuration Profile preview found 1 error(s).",
+			"MessageId": "SYS082",
+			"PercentComplete": 100
+		}
+	}
+}
+`
+
+func TestServerConfigurationProfileTask(t *testing.T) {
+	var result ServerConfigurationProfileTask
+	err := json.NewDecoder(strings.NewReader(serverConfigurationProfileTaskBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.ServerConfigurationProfileTask mocked json: %v", err)
+	}
+
+	assertField(t, result.ID, "JID_878699900111")
+	assertField(t, result.TaskState, "Completed")
+	assertField(t, result.JobState, "Completed")
+	assertField(t, result.Message, "The Server Configuration Profile preview found 1 error(s).")
+	assertInt(t, len(result.Results), 3)
+	assertField(t, result.Results[0].MessageID, "SYS082")
+	assertField(t, result.Results[0].FQDD, "")
+	assertField(t, result.Results[1].FQDD, "BIOS.Setup.1-1")
+	assertField(t, result.Results[1].Name, "BootMode")
+	assertField(t, result.Results[1].OldValue, "Uefi")
+	assertField(t, result.Results[1].NewValue, "Legacy")
+	assertInt(t, result.Results[1].ErrCode, 2)
+
+	failures := result.Failures()
+	assertInt(t, len(failures), 1)
+	assertField(t, failures[0].Name, "BootMode")
+}
@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/stmcginnis/gofish/common"
+)
+
+// TimeService is used to represent the Dell time service of the iDRAC
+type TimeService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+
+	manageTimeTarget string
+}
+
+// UnmarshalJSON unmarshals the Dell time service object from the raw JSON
+func (t *TimeService) UnmarshalJSON(data []byte) error {
+	type temp TimeService
+	type action struct {
+		Target string
+	}
+	var ts struct {
+		temp
+		Actions struct {
+			ManageTime action `json:"#DellTimeService.ManageTime"`
+		}
+	}
+
+	err := json.Unmarshal(data, &ts)
+	if err != nil {
+		return err
+	}
+
+	*t = TimeService(ts.temp)
+	t.manageTimeTarget = ts.Actions.ManageTime.Target
+
+	return nil
+}
+
+// GetTime returns the date and time of the iDRAC, in the YYYY-MM-DDThh:mm:ss+hh:mm format
+func (t *TimeService) GetTime() (string, error) {
+	return t.manageTime(map[string]any{"GetRequest": true})
+}
+
+// SetTime sets the date and time of the iDRAC, timeData uses the YYYY-MM-DDThh:mm:ss+hh:mm format
+func (t *TimeService) SetTime(timeData string) error {
+	_, err := t.manageTime(map[string]any{"GetRequest": false, "TimeData": timeData})
+	return err
+}
+
+func (t *TimeService) manageTime(payload map[string]any) (string, error) {
+	if t.manageTimeTarget == "" {
+		return "", errors.New("ManageTime is not supported by this time service")
+	}
+	resp, err := t.PostWithResponse(t.manageTimeTarget, payload)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var result struct {
+		TimeData string
+	}
+	// setting the time may answer without a body
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil && payload["GetRequest"] == true {
+		return "", err
+	}
+	return result.TimeData, nil
+}
+
+// GetTimeService returns a TimeService pointer given a client and a uri to query
+func GetTimeService(c common.Client, uri string) (*TimeService, error) {
+	return common.GetObject[TimeService](c, uri)
+}
+
+// TimeService returns the time service of the manager
+func (m *ManagerExtended) TimeService() (*TimeService, error) {
+	if m.links.DellTimeService == "" {
+		return nil, errors.New("the manager does not provide a time service")
+	}
+	return GetTimeService(m.GetClient(), m.links.DellTimeService.String())
+}
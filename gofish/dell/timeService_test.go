@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var timeServiceBody = `
+{
+	"@odata.context": "/redfish/v1/$metadata#DellTimeService.DellTimeService",
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellTimeService",
+	"@odata.type": "#DellTimeService.v1_0_0.DellTimeService",
+	"Actions": {
+		"#DellTimeService.ManageTime": {
+			"GetRequest@Redfish.AllowableValues": [
+				"true",
+				"false"
+			],
+			"target": "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellTimeService/Actions/DellTimeService.ManageTime"
+		}
+	},
+	"Description": "The DellTimeService resource provides the actions to support time management functionality.",
+	"Id": "DellTimeService",
+	"Name": "DellTimeService"
+}
+`
+
+func TestDellTimeService(t *testing.T) {
+	var result TimeService
+	err := json.NewDecoder(strings.NewReader(timeServiceBody)).Decode(&result)
+	if err != nil {
+		t.Fatalf("couldn't decode dell.TimeService mocked json")
+	}
+
+	assertField(t, result.ID, "DellTimeService")
+	assertField(t, result.manageTimeTarget, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellTimeService/Actions/DellTimeService.ManageTime")
+}
+
+func TestDellTimeServiceActionsNotSupported(t *testing.T) {
+	var result TimeService
+	if _, err := result.GetTime(); err == nil {
+		t.Errorf("expected an error when ManageTime is not supported")
+	}
+	if err := result.SetTime("2026-01-02T03:04:05-06:00"); err == nil {
+		t.Errorf("expected an error when ManageTime is not supported")
+	}
+}
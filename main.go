@@ -24,6 +24,7 @@ import (
 	"log"
 	"terraform-provider-redfish/redfish/provider"
 
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -44,10 +45,13 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	err := providerserver.Serve(context.Background(), provider.New, providerserver.ServeOpts{
+	// keep a single provider instance so that its cached sessions can be logged out on exit
+	redfishProvider := provider.New()
+	err := providerserver.Serve(context.Background(), func() tfprovider.Provider { return redfishProvider }, providerserver.ServeOpts{
 		Address: "registry.terraform.io/dell/redfish",
 		Debug:   debug,
 	})
+	provider.Shutdown(context.Background(), redfishProvider)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
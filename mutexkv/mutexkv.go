@@ -19,50 +19,169 @@ limitations under the License.
 package mutexkv
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
 )
 
+// DefaultConcurrencyLimit is the number of shared holders allowed per key when no limit is configured
+const DefaultConcurrencyLimit = 4
+
 // MutexKV is a simple key/value store for arbitrary mutexes. It must be used
 // when creating resources, since some of them might restart the servers.
 // Not using MutexKV might lead to inconsistances because some resources
 // might reace each other.
+//
+// Every key can be held exclusively (Lock), for operations changing the server,
+// or shared (RLock) by up to the concurrency limit of read-only operations.
+// Waiting writers block new readers so that they are not starved.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyLock
+	limit int
+}
+
+// keyLock is the state of a single key. Waiters are woken up by closing changed.
+type keyLock struct {
+	readers        int
+	writer         bool
+	waitingWriters int
+	changed        chan struct{}
 }
 
 // Lock the mutex for the given key. The caller is responsible for calling
 // Unlock for the same key
 func (m *MutexKV) Lock(key string) {
+	_ = m.LockContext(context.Background(), key)
+}
+
+// LockContext locks the mutex for the given key exclusively, giving up with an error
+// when the context is done first. The caller is responsible for calling Unlock for the
+// same key when no error is returned.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %s", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %s", key)
+	registered := false
+	for {
+		m.lock.Lock()
+		kl := m.get(key)
+		if !kl.writer && kl.readers == 0 {
+			kl.writer = true
+			if registered {
+				kl.waitingWriters--
+			}
+			m.lock.Unlock()
+			log.Printf("[DEBUG] Locked %s", key)
+			return nil
+		}
+		if !registered {
+			kl.waitingWriters++
+			registered = true
+		}
+		changed := kl.changed
+		m.lock.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			m.lock.Lock()
+			kl.waitingWriters--
+			kl.broadcast()
+			m.lock.Unlock()
+			return fmt.Errorf("timed out waiting for the lock on %s: %w", key, ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the
 // same key first.
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %s", key)
-	m.get(key).Unlock()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	kl := m.get(key)
+	if !kl.writer {
+		panic("mutexkv: unlock of unlocked key " + key)
+	}
+	kl.writer = false
+	kl.broadcast()
 	log.Printf("[DEBUG] Unlocked %s", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+// RLockContext locks the mutex for the given key in shared mode, together with at most
+// the concurrency limit of other shared holders. It gives up with an error when the context
+// is done first. The caller is responsible for calling RUnlock for the same key when no
+// error is returned.
+func (m *MutexKV) RLockContext(ctx context.Context, key string) error {
+	log.Printf("[DEBUG] Read locking %s", key)
+	for {
+		m.lock.Lock()
+		kl := m.get(key)
+		if !kl.writer && kl.waitingWriters == 0 && kl.readers < m.limit {
+			kl.readers++
+			m.lock.Unlock()
+			log.Printf("[DEBUG] Read locked %s", key)
+			return nil
+		}
+		changed := kl.changed
+		m.lock.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the read lock on %s: %w", key, ctx.Err())
+		}
+	}
+}
+
+// RUnlock releases a shared lock for the given key. Caller must have called
+// RLockContext for the same key first.
+func (m *MutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Read unlocking %s", key)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	kl := m.get(key)
+	if kl.readers == 0 {
+		panic("mutexkv: read unlock of unlocked key " + key)
+	}
+	kl.readers--
+	kl.broadcast()
+	log.Printf("[DEBUG] Read unlocked %s", key)
+}
+
+// SetConcurrencyLimit sets the number of shared holders allowed per key, values lower than 1 are ignored
+func (m *MutexKV) SetConcurrencyLimit(limit int) {
+	if limit < 1 {
+		return
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	m.limit = limit
+	for _, kl := range m.store {
+		kl.broadcast()
+	}
+}
+
+// Returns the state for the given key, creating it when needed. Caller must hold m.lock.
+func (m *MutexKV) get(key string) *keyLock {
+	kl, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		kl = &keyLock{changed: make(chan struct{})}
+		m.store[key] = kl
 	}
-	return mutex
+	return kl
+}
+
+// broadcast wakes up every waiter of the key. Caller must hold m.lock.
+func (kl *keyLock) broadcast() {
+	close(kl.changed)
+	kl.changed = make(chan struct{})
 }
 
 // NewMutexKV returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyLock),
+		limit: DefaultConcurrencyLimit,
 	}
 }
@@ -18,8 +18,11 @@ limitations under the License.
 package mutexkv
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestMutexKV(t *testing.T) {
@@ -66,6 +69,92 @@ func TestMutexKV(t *testing.T) {
 	})
 }
 
+func TestMutexKVLockTimeout(t *testing.T) {
+	mutex := NewMutexKV()
+	mutex.Lock("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := mutex.LockContext(ctx, "test"); err == nil {
+		t.Fatal("expected a timeout while the key is locked, got nil")
+	}
+	if err := mutex.RLockContext(ctx, "test"); err == nil {
+		t.Fatal("expected a timeout while the key is locked, got nil")
+	}
+
+	// other keys are not affected, and the key is usable again once unlocked
+	if err := mutex.LockContext(context.Background(), "test2"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	mutex.Unlock("test2")
+	mutex.Unlock("test")
+	if err := mutex.LockContext(context.Background(), "test"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	mutex.Unlock("test")
+}
+
+func TestMutexKVReadConcurrency(t *testing.T) {
+	mutex := NewMutexKV()
+	mutex.SetConcurrencyLimit(3)
+
+	var current, peak int32
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := mutex.RLockContext(context.Background(), "test"); err != nil {
+				t.Errorf("expected no error, got %v", err)
+				return
+			}
+			defer mutex.RUnlock("test")
+			n := atomic.AddInt32(&current, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+	wg.Wait()
+	if peak < 2 || peak > 3 {
+		t.Errorf("expected between 2 and 3 concurrent readers, got %d", peak)
+	}
+}
+
+func TestMutexKVWriterExcludesReaders(t *testing.T) {
+	mutex := NewMutexKV()
+	if err := mutex.RLockContext(context.Background(), "test"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		mutex.Lock("test")
+		close(locked)
+	}()
+
+	// a waiting writer blocks new readers
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := mutex.RLockContext(ctx, "test"); err == nil {
+		t.Fatal("expected readers to wait for the pending writer, got nil")
+	}
+
+	mutex.RUnlock("test")
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("writer did not get the lock after the reader released it")
+	}
+	mutex.Unlock("test")
+}
+
 func assertSum(t testing.TB, got, want int) {
 	t.Helper()
 	if got != want {
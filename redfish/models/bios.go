@@ -33,14 +33,18 @@ type BiosDatasource struct {
 
 // Bios is struct to create schema for bios resource
 type Bios struct {
-	ID                types.String    `tfsdk:"id"`
-	Attributes        types.Map       `tfsdk:"attributes"`
-	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
-	SettingsApplyTime types.String    `tfsdk:"settings_apply_time"`
-	ResetType         types.String    `tfsdk:"reset_type"`
-	ResetTimeout      types.Int64     `tfsdk:"reset_timeout"`
-	JobTimeout        types.Int64     `tfsdk:"bios_job_timeout"`
-	SystemID          types.String    `tfsdk:"system_id"`
+	ID                types.String       `tfsdk:"id"`
+	Attributes        types.Map          `tfsdk:"attributes"`
+	RedfishServer     []RedfishServer    `tfsdk:"redfish_server"`
+	SettingsApplyTime types.String       `tfsdk:"settings_apply_time"`
+	MaintenanceWindow *MaintenanceWindow `tfsdk:"maintenance_window"`
+	StageOnly         types.Bool         `tfsdk:"stage_only"`
+	ResetType         types.String       `tfsdk:"reset_type"`
+	ResetTimeout      types.Int64        `tfsdk:"reset_timeout"`
+	JobTimeout        types.Int64        `tfsdk:"bios_job_timeout"`
+	SystemID          types.String       `tfsdk:"system_id"`
+	PendingAttributes types.Map          `tfsdk:"pending_attributes"`
+	PendingJobID      types.String       `tfsdk:"pending_job_id"`
 }
 
 // BiosBootOptions is strut for configuring boot options
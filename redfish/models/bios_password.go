@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BiosPassword is struct to create schema for bios password resource
+type BiosPassword struct {
+	ID                types.String    `tfsdk:"id"`
+	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
+	SystemID          types.String    `tfsdk:"system_id"`
+	PasswordName      types.String    `tfsdk:"password_name"`
+	NewPassword       types.String    `tfsdk:"new_password_wo"`
+	OldPassword       types.String    `tfsdk:"old_password_wo"`
+	PasswordWoVersion types.Int64     `tfsdk:"password_wo_version"`
+	PasswordSet       types.Bool      `tfsdk:"password_set"`
+	ResetType         types.String    `tfsdk:"reset_type"`
+	ResetTimeout      types.Int64     `tfsdk:"reset_timeout"`
+	JobTimeout        types.Int64     `tfsdk:"bios_job_timeout"`
+}
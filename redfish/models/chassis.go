@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChassisDatasource is struct for chassis data-source.
+type ChassisDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ChassisFilter *ChassisFilter  `tfsdk:"chassis_filter"`
+	Chassis       []Chassis       `tfsdk:"chassis"`
+}
+
+// ChassisFilter is the tfsdk model of ChassisFilter.
+type ChassisFilter struct {
+	ChassisIDs []types.String `tfsdk:"chassis_ids"`
+}
+
+// Chassis is the tfsdk model of Chassis.
+type Chassis struct {
+	ODataID                 types.String    `tfsdk:"odata_id"`
+	ID                      types.String    `tfsdk:"id"`
+	Name                    types.String    `tfsdk:"name"`
+	Description             types.String    `tfsdk:"description"`
+	ChassisType             types.String    `tfsdk:"chassis_type"`
+	Manufacturer            types.String    `tfsdk:"manufacturer"`
+	Model                   types.String    `tfsdk:"model"`
+	SKU                     types.String    `tfsdk:"sku"`
+	SerialNumber            types.String    `tfsdk:"serial_number"`
+	PartNumber              types.String    `tfsdk:"part_number"`
+	UUID                    types.String    `tfsdk:"uuid"`
+	AssetTag                types.String    `tfsdk:"asset_tag"`
+	PowerState              types.String    `tfsdk:"power_state"`
+	IndicatorLED            types.String    `tfsdk:"indicator_led"`
+	LocationIndicatorActive types.Bool      `tfsdk:"location_indicator_active"`
+	Location                ChassisLocation `tfsdk:"location"`
+	Status                  Status          `tfsdk:"status"`
+}
+
+// ChassisLocation is the tfsdk model of ChassisLocation.
+type ChassisLocation struct {
+	Info       types.String `tfsdk:"info"`
+	Building   types.String `tfsdk:"building"`
+	Floor      types.String `tfsdk:"floor"`
+	Room       types.String `tfsdk:"room"`
+	Row        types.String `tfsdk:"row"`
+	Rack       types.String `tfsdk:"rack"`
+	RackOffset types.Int64  `tfsdk:"rack_offset"`
+}
+
+// ChassisResource to construct terraform schema for the chassis resource.
+type ChassisResource struct {
+	ID                      types.String    `tfsdk:"id"`
+	RedfishServer           []RedfishServer `tfsdk:"redfish_server"`
+	ChassisID               types.String    `tfsdk:"chassis_id"`
+	SystemID                types.String    `tfsdk:"system_id"`
+	AssetTag                types.String    `tfsdk:"asset_tag"`
+	SystemAssetTag          types.String    `tfsdk:"system_asset_tag"`
+	LocationIndicatorActive types.Bool      `tfsdk:"location_indicator_active"`
+	IndicatorLED            types.String    `tfsdk:"indicator_led"`
+}
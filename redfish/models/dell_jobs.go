@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DellJobsDatasource is the tfsdk model of the Dell jobs data source.
+type DellJobsDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID     types.String    `tfsdk:"manager_id"`
+	JobFilter     *DellJobFilter  `tfsdk:"job_filter"`
+	Jobs          []DellJob       `tfsdk:"jobs"`
+}
+
+// DellJobFilter is the tfsdk model of the filter of the Dell jobs data source.
+type DellJobFilter struct {
+	JobIDs    []types.String `tfsdk:"job_ids"`
+	JobStates []types.String `tfsdk:"job_states"`
+	JobTypes  []types.String `tfsdk:"job_types"`
+}
+
+// DellJob is the tfsdk model of a Dell Lifecycle Controller job.
+type DellJob struct {
+	ODataID           types.String `tfsdk:"odata_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	JobState          types.String `tfsdk:"job_state"`
+	JobType           types.String `tfsdk:"job_type"`
+	PercentComplete   types.Int64  `tfsdk:"percent_complete"`
+	Message           types.String `tfsdk:"message"`
+	MessageID         types.String `tfsdk:"message_id"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+	CompletionTime    types.String `tfsdk:"completion_time"`
+	TargetSettingsURI types.String `tfsdk:"target_settings_uri"`
+}
+
+// DellJobQueue to construct terraform schema for the Dell job queue resource.
+type DellJobQueue struct {
+	ID                types.String    `tfsdk:"id"`
+	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID         types.String    `tfsdk:"manager_id"`
+	JobIDs            types.List      `tfsdk:"job_ids"`
+	ClearFinishedJobs types.Bool      `tfsdk:"clear_finished_jobs"`
+	ClearAllForce     types.Bool      `tfsdk:"clear_all_force"`
+	DeletedJobIDs     types.List      `tfsdk:"deleted_job_ids"`
+}
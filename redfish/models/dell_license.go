@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DellLicenseDatasource is the tfsdk model of the Dell license data source.
+type DellLicenseDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID     types.String    `tfsdk:"manager_id"`
+	Licenses      []DellLicense   `tfsdk:"licenses"`
+}
+
+// DellLicense is the tfsdk model of a Dell license.
+type DellLicense struct {
+	ODataID               types.String   `tfsdk:"odata_id"`
+	EntitlementID         types.String   `tfsdk:"entitlement_id"`
+	LicenseType           types.String   `tfsdk:"license_type"`
+	LicenseDescription    []types.String `tfsdk:"license_description"`
+	Status                types.String   `tfsdk:"status"`
+	Origin                types.String   `tfsdk:"origin"`
+	InstallDate           types.String   `tfsdk:"install_date"`
+	SoldDate              types.String   `tfsdk:"sold_date"`
+	EvalTimeRemainingDays types.Int64    `tfsdk:"eval_time_remaining_days"`
+	AutoRenew             types.Bool     `tfsdk:"auto_renew"`
+}
+
+// DellLicenseResource to construct terraform schema for the Dell license resource.
+type DellLicenseResource struct {
+	ID                    types.String    `tfsdk:"id"`
+	RedfishServer         []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID             types.String    `tfsdk:"manager_id"`
+	LicenseFile           types.String    `tfsdk:"license_file"`
+	LicenseContent        types.String    `tfsdk:"license_content"`
+	ImportOptions         types.String    `tfsdk:"import_options"`
+	EntitlementID         types.String    `tfsdk:"entitlement_id"`
+	LicenseType           types.String    `tfsdk:"license_type"`
+	LicenseDescription    types.List      `tfsdk:"license_description"`
+	Status                types.String    `tfsdk:"status"`
+	InstallDate           types.String    `tfsdk:"install_date"`
+	EvalTimeRemainingDays types.Int64     `tfsdk:"eval_time_remaining_days"`
+}
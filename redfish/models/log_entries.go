@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LogEntriesDatasource is the tfsdk model of the log entries data source.
+type LogEntriesDatasource struct {
+	ID             types.String    `tfsdk:"id"`
+	RedfishServer  []RedfishServer `tfsdk:"redfish_server"`
+	SystemID       types.String    `tfsdk:"system_id"`
+	ManagerID      types.String    `tfsdk:"manager_id"`
+	LogEntryFilter *LogEntryFilter `tfsdk:"log_entry_filter"`
+	LogEntries     []LogEntry      `tfsdk:"log_entries"`
+}
+
+// LogEntryFilter is the tfsdk model of LogEntryFilter.
+type LogEntryFilter struct {
+	Sources       []types.String `tfsdk:"sources"`
+	LogServiceIDs []types.String `tfsdk:"log_service_ids"`
+	Severities    []types.String `tfsdk:"severities"`
+	MessageIDs    []types.String `tfsdk:"message_ids"`
+	Since         types.String   `tfsdk:"since"`
+	Until         types.String   `tfsdk:"until"`
+	Within        types.String   `tfsdk:"within"`
+	MaxCount      types.Int64    `tfsdk:"max_count"`
+}
+
+// LogEntry is the tfsdk model of LogEntry.
+type LogEntry struct {
+	ODataID                types.String   `tfsdk:"odata_id"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Source                 types.String   `tfsdk:"source"`
+	LogServiceID           types.String   `tfsdk:"log_service_id"`
+	Created                types.String   `tfsdk:"created"`
+	Severity               types.String   `tfsdk:"severity"`
+	Message                types.String   `tfsdk:"message"`
+	MessageID              types.String   `tfsdk:"message_id"`
+	MessageRegistry        types.String   `tfsdk:"message_registry"`
+	MessageRegistryVersion types.String   `tfsdk:"message_registry_version"`
+	MessageKey             types.String   `tfsdk:"message_key"`
+	MessageArgs            []types.String `tfsdk:"message_args"`
+	EntryType              types.String   `tfsdk:"entry_type"`
+	EntryCode              types.String   `tfsdk:"entry_code"`
+	SensorType             types.String   `tfsdk:"sensor_type"`
+	SensorNumber           types.Int64    `tfsdk:"sensor_number"`
+	Resolution             types.String   `tfsdk:"resolution"`
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LogServiceClear to construct terraform schema for log service clear resource.
+type LogServiceClear struct {
+	ID                types.String    `tfsdk:"id"`
+	SystemID          types.String    `tfsdk:"system_id"`
+	LogServiceID      types.String    `tfsdk:"log_service_id"`
+	ExportPath        types.String    `tfsdk:"export_path"`
+	ExportedEntries   types.Int64     `tfsdk:"exported_entries"`
+	LogServiceODataID types.String    `tfsdk:"log_service_odata_id"`
+	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
+}
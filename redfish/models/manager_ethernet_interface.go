@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagerEthernetInterface to construct terraform schema for the manager ethernet interface resource.
+type ManagerEthernetInterface struct {
+	ID                        types.String    `tfsdk:"id"`
+	RedfishServer             []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID                 types.String    `tfsdk:"manager_id"`
+	InterfaceID               types.String    `tfsdk:"interface_id"`
+	HostName                  types.String    `tfsdk:"hostname"`
+	FQDN                      types.String    `tfsdk:"fqdn"`
+	DHCPv4Enabled             types.Bool      `tfsdk:"dhcpv4_enabled"`
+	DHCPv6Enabled             types.Bool      `tfsdk:"dhcpv6_enabled"`
+	IPv4StaticAddresses       types.List      `tfsdk:"ipv4_static_addresses"`
+	IPv6StaticAddresses       types.List      `tfsdk:"ipv6_static_addresses"`
+	IPv6StaticDefaultGateways types.List      `tfsdk:"ipv6_static_default_gateways"`
+	StaticNameServers         types.List      `tfsdk:"static_name_servers"`
+	VLAN                      types.Object    `tfsdk:"vlan"`
+	MACAddress                types.String    `tfsdk:"mac_address"`
+	IPv4Addresses             types.List      `tfsdk:"ipv4_addresses"`
+	IPv6Addresses             types.List      `tfsdk:"ipv6_addresses"`
+	NameServers               types.List      `tfsdk:"name_servers"`
+	ReconnectTimeout          types.Int64     `tfsdk:"reconnect_timeout"`
+	CurrentEndpoint           types.String    `tfsdk:"current_endpoint"`
+}
+
+// IPv4StaticAddress is the tfsdk model of a static IPv4 address of an ethernet interface.
+type IPv4StaticAddress struct {
+	Address    types.String `tfsdk:"address"`
+	SubnetMask types.String `tfsdk:"subnet_mask"`
+	Gateway    types.String `tfsdk:"gateway"`
+}
+
+// IPv6StaticAddress is the tfsdk model of a static IPv6 address or default gateway of an ethernet interface.
+type IPv6StaticAddress struct {
+	Address      types.String `tfsdk:"address"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+}
+
+// EthernetInterfaceVLAN is the tfsdk model of the VLAN of an ethernet interface.
+type EthernetInterfaceVLAN struct {
+	Enabled  types.Bool  `tfsdk:"enabled"`
+	VLANID   types.Int64 `tfsdk:"vlan_id"`
+	Priority types.Int64 `tfsdk:"priority"`
+}
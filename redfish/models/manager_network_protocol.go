@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagerNetworkProtocol to construct terraform schema for the manager network protocol resource.
+type ManagerNetworkProtocol struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID     types.String    `tfsdk:"manager_id"`
+	HostName      types.String    `tfsdk:"hostname"`
+	FQDN          types.String    `tfsdk:"fqdn"`
+	HTTP          types.Object    `tfsdk:"http"`
+	HTTPS         types.Object    `tfsdk:"https"`
+	SSH           types.Object    `tfsdk:"ssh"`
+	IPMI          types.Object    `tfsdk:"ipmi"`
+	SNMP          types.Object    `tfsdk:"snmp"`
+	KVMIP         types.Object    `tfsdk:"kvmip"`
+	VirtualMedia  types.Object    `tfsdk:"virtual_media"`
+	SSDP          types.Object    `tfsdk:"ssdp"`
+}
+
+// NetworkProtocolSetting is the tfsdk model of the settings of a network protocol of the manager.
+type NetworkProtocolSetting struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Port    types.Int64 `tfsdk:"port"`
+}
@@ -27,3 +27,15 @@ type RedfishManagerReset struct {
 	ResetType     types.String    `tfsdk:"reset_type"`
 	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
 }
+
+// ManagerResetToDefaults to construct terraform schema for the manager reset to defaults resource.
+type ManagerResetToDefaults struct {
+	ID              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID       types.String    `tfsdk:"manager_id"`
+	SystemID        types.String    `tfsdk:"system_id"`
+	ResetType       types.String    `tfsdk:"reset_type"`
+	Confirm         types.Bool      `tfsdk:"confirm"`
+	WaitForReady    types.Bool      `tfsdk:"wait_for_ready"`
+	DefaultPassword types.String    `tfsdk:"default_password"`
+}
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagerTime to construct terraform schema for the manager time resource.
+type ManagerTime struct {
+	ID               types.String    `tfsdk:"id"`
+	RedfishServer    []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID        types.String    `tfsdk:"manager_id"`
+	NTPEnabled       types.Bool      `tfsdk:"ntp_enabled"`
+	NTPServers       types.List      `tfsdk:"ntp_servers"`
+	TimeZone         types.String    `tfsdk:"time_zone"`
+	DateTime         types.String    `tfsdk:"date_time"`
+	BMCDateTime      types.String    `tfsdk:"bmc_date_time"`
+	ClockSkewSeconds types.Int64     `tfsdk:"clock_skew_seconds"`
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MemoryDatasource is struct for memory data-source.
+type MemoryDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	MemoryFilter  *MemoryFilter   `tfsdk:"memory_filter"`
+	Memory        []Memory        `tfsdk:"memory"`
+}
+
+// MemoryFilter is the tfsdk model of MemoryFilter.
+type MemoryFilter struct {
+	Systems []MemorySystemFilter `tfsdk:"systems"`
+}
+
+// MemorySystemFilter is the tfsdk model of MemorySystemFilter.
+type MemorySystemFilter struct {
+	SystemID  types.String   `tfsdk:"system_id"`
+	MemoryIDs []types.String `tfsdk:"memory_ids"`
+}
+
+// Memory is the tfsdk model of Memory.
+type Memory struct {
+	ODataID           types.String   `tfsdk:"odata_id"`
+	ID                types.String   `tfsdk:"id"`
+	SystemID          types.String   `tfsdk:"system_id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	DeviceLocator     types.String   `tfsdk:"device_locator"`
+	CapacityMiB       types.Int64    `tfsdk:"capacity_mib"`
+	Manufacturer      types.String   `tfsdk:"manufacturer"`
+	PartNumber        types.String   `tfsdk:"part_number"`
+	SerialNumber      types.String   `tfsdk:"serial_number"`
+	RankCount         types.Int64    `tfsdk:"rank_count"`
+	MemoryDeviceType  types.String   `tfsdk:"memory_device_type"`
+	MemoryType        types.String   `tfsdk:"memory_type"`
+	BaseModuleType    types.String   `tfsdk:"base_module_type"`
+	OperatingSpeedMhz types.Int64    `tfsdk:"operating_speed_mhz"`
+	ErrorCorrection   types.String   `tfsdk:"error_correction"`
+	MemoryLocation    MemoryLocation `tfsdk:"memory_location"`
+	Status            Status         `tfsdk:"status"`
+	Oem               MemoryOEM      `tfsdk:"oem"`
+}
+
+// MemoryLocation is the tfsdk model of MemoryLocation.
+type MemoryLocation struct {
+	Socket           types.Int64 `tfsdk:"socket"`
+	MemoryController types.Int64 `tfsdk:"memory_controller"`
+	Channel          types.Int64 `tfsdk:"channel"`
+	Slot             types.Int64 `tfsdk:"slot"`
+}
+
+// MemoryOEM is the tfsdk model of MemoryOEM.
+type MemoryOEM struct {
+	Dell MemoryOEMDell `tfsdk:"dell"`
+}
+
+// MemoryOEMDell is the tfsdk model of MemoryOEMDell.
+type MemoryOEMDell struct {
+	DellMemory DellMemory `tfsdk:"dell_memory"`
+}
+
+// DellMemory is the tfsdk model of DellMemory.
+type DellMemory struct {
+	BankLabel                           types.String `tfsdk:"bank_label"`
+	LastSystemInventoryTime             types.String `tfsdk:"last_system_inventory_time"`
+	LastUpdateTime                      types.String `tfsdk:"last_update_time"`
+	ManufactureDate                     types.String `tfsdk:"manufacture_date"`
+	MemoryTechnology                    types.String `tfsdk:"memory_technology"`
+	Model                               types.String `tfsdk:"model"`
+	RemainingRatedWriteEndurancePercent types.Int64  `tfsdk:"remaining_rated_write_endurance_percent"`
+	SystemEraseCapability               types.String `tfsdk:"system_erase_capability"`
+}
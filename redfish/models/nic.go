@@ -38,6 +38,8 @@ type NICResource struct {
 	MaintenanceWindow    *MaintenanceWindow `tfsdk:"maintenance_window"`
 	ResetTimeout         types.Int64        `tfsdk:"reset_timeout"`
 	ResetType            types.String       `tfsdk:"reset_type"`
+	PendingAttributes    types.Map          `tfsdk:"pending_attributes"`
+	PendingJobID         types.String       `tfsdk:"pending_job_id"`
 }
 
 // NetworkDeviceFunctionSettings is the tfsdk model of NetworkDeviceFunctionSettings.
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PCIeDevicesDatasource is struct for PCIe devices data-source.
+type PCIeDevicesDatasource struct {
+	ID               types.String      `tfsdk:"id"`
+	RedfishServer    []RedfishServer   `tfsdk:"redfish_server"`
+	PCIeDeviceFilter *PCIeDeviceFilter `tfsdk:"pcie_device_filter"`
+	PCIeDevices      []PCIeDevice      `tfsdk:"pcie_devices"`
+}
+
+// PCIeDeviceFilter is the tfsdk model of PCIeDeviceFilter.
+type PCIeDeviceFilter struct {
+	Systems []PCIeDeviceSystemFilter `tfsdk:"systems"`
+}
+
+// PCIeDeviceSystemFilter is the tfsdk model of PCIeDeviceSystemFilter.
+type PCIeDeviceSystemFilter struct {
+	SystemID      types.String   `tfsdk:"system_id"`
+	PCIeDeviceIDs []types.String `tfsdk:"pcie_device_ids"`
+}
+
+// PCIeDevice is the tfsdk model of PCIeDevice.
+type PCIeDevice struct {
+	ODataID         types.String  `tfsdk:"odata_id"`
+	ID              types.String  `tfsdk:"id"`
+	SystemID        types.String  `tfsdk:"system_id"`
+	Name            types.String  `tfsdk:"name"`
+	Description     types.String  `tfsdk:"description"`
+	DeviceType      types.String  `tfsdk:"device_type"`
+	Manufacturer    types.String  `tfsdk:"manufacturer"`
+	Model           types.String  `tfsdk:"model"`
+	PartNumber      types.String  `tfsdk:"part_number"`
+	SerialNumber    types.String  `tfsdk:"serial_number"`
+	SKU             types.String  `tfsdk:"sku"`
+	AssetTag        types.String  `tfsdk:"asset_tag"`
+	FirmwareVersion types.String  `tfsdk:"firmware_version"`
+	PCIeInterface   PCIeInterface `tfsdk:"pcie_interface"`
+	Status          Status        `tfsdk:"status"`
+}
+
+// PCIeInterface is the tfsdk model of PCIeInterface.
+type PCIeInterface struct {
+	PCIeType    types.String `tfsdk:"pcie_type"`
+	MaxPCIeType types.String `tfsdk:"max_pcie_type"`
+	LanesInUse  types.Int64  `tfsdk:"lanes_in_use"`
+	MaxLanes    types.Int64  `tfsdk:"max_lanes"`
+}
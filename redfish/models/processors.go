@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProcessorsDatasource is struct for processors data-source.
+type ProcessorsDatasource struct {
+	ID              types.String     `tfsdk:"id"`
+	RedfishServer   []RedfishServer  `tfsdk:"redfish_server"`
+	ProcessorFilter *ProcessorFilter `tfsdk:"processor_filter"`
+	Processors      []Processor      `tfsdk:"processors"`
+}
+
+// ProcessorFilter is the tfsdk model of ProcessorFilter.
+type ProcessorFilter struct {
+	Systems []ProcessorSystemFilter `tfsdk:"systems"`
+}
+
+// ProcessorSystemFilter is the tfsdk model of ProcessorSystemFilter.
+type ProcessorSystemFilter struct {
+	SystemID     types.String   `tfsdk:"system_id"`
+	ProcessorIDs []types.String `tfsdk:"processor_ids"`
+}
+
+// Processor is the tfsdk model of Processor.
+type Processor struct {
+	ODataID               types.String `tfsdk:"odata_id"`
+	ID                    types.String `tfsdk:"id"`
+	SystemID              types.String `tfsdk:"system_id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	Socket                types.String `tfsdk:"socket"`
+	Manufacturer          types.String `tfsdk:"manufacturer"`
+	Model                 types.String `tfsdk:"model"`
+	ProcessorType         types.String `tfsdk:"processor_type"`
+	ProcessorArchitecture types.String `tfsdk:"processor_architecture"`
+	InstructionSet        types.String `tfsdk:"instruction_set"`
+	MaxSpeedMHz           types.Int64  `tfsdk:"max_speed_mhz"`
+	OperatingSpeedMHz     types.Int64  `tfsdk:"operating_speed_mhz"`
+	TotalCores            types.Int64  `tfsdk:"total_cores"`
+	TotalEnabledCores     types.Int64  `tfsdk:"total_enabled_cores"`
+	TotalThreads          types.Int64  `tfsdk:"total_threads"`
+	SerialNumber          types.String `tfsdk:"serial_number"`
+	PartNumber            types.String `tfsdk:"part_number"`
+	Status                Status       `tfsdk:"status"`
+	Oem                   ProcessorOEM `tfsdk:"oem"`
+}
+
+// ProcessorOEM is the tfsdk model of ProcessorOEM.
+type ProcessorOEM struct {
+	Dell ProcessorOEMDell `tfsdk:"dell"`
+}
+
+// ProcessorOEMDell is the tfsdk model of ProcessorOEMDell.
+type ProcessorOEMDell struct {
+	DellProcessor DellProcessor `tfsdk:"dell_processor"`
+}
+
+// DellProcessor is the tfsdk model of DellProcessor.
+type DellProcessor struct {
+	CPUFamily                       types.String `tfsdk:"cpu_family"`
+	CPUStatus                       types.String `tfsdk:"cpu_status"`
+	Cache1InstalledSizeKB           types.Int64  `tfsdk:"cache1_installed_size_kb"`
+	Cache2InstalledSizeKB           types.Int64  `tfsdk:"cache2_installed_size_kb"`
+	Cache3InstalledSizeKB           types.Int64  `tfsdk:"cache3_installed_size_kb"`
+	CurrentClockSpeedMhz            types.Int64  `tfsdk:"current_clock_speed_mhz"`
+	ExternalBusClockSpeedMhz        types.Int64  `tfsdk:"external_bus_clock_speed_mhz"`
+	HyperThreadingCapable           types.String `tfsdk:"hyper_threading_capable"`
+	HyperThreadingEnabled           types.String `tfsdk:"hyper_threading_enabled"`
+	LastSystemInventoryTime         types.String `tfsdk:"last_system_inventory_time"`
+	LastUpdateTime                  types.String `tfsdk:"last_update_time"`
+	TurboModeCapable                types.String `tfsdk:"turbo_mode_capable"`
+	TurboModeEnabled                types.String `tfsdk:"turbo_mode_enabled"`
+	VirtualizationTechnologyCapable types.String `tfsdk:"virtualization_technology_capable"`
+	VirtualizationTechnologyEnabled types.String `tfsdk:"virtualization_technology_enabled"`
+	Volts                           types.String `tfsdk:"volts"`
+}
@@ -23,9 +23,14 @@ import (
 
 // ProviderConfig can be used to store data from the Terraform configuration.
 type ProviderConfig struct {
-	Username types.String `tfsdk:"user"`
-	Password types.String `tfsdk:"password"`
-	Servers  types.Map    `tfsdk:"redfish_servers"`
+	Username           types.String `tfsdk:"user"`
+	Password           types.String `tfsdk:"password"`
+	Servers            types.Map    `tfsdk:"redfish_servers"`
+	CABundle           types.String `tfsdk:"ca_bundle"`
+	TLSMinVersion      types.String `tfsdk:"tls_min_version"`
+	Retry              types.Object `tfsdk:"retry"`
+	LockTimeout        types.Int64  `tfsdk:"lock_timeout"`
+	MaxConcurrentReads types.Int64  `tfsdk:"max_concurrent_reads"`
 }
 
 // RedfishServer to configure server config for resource/datasource.
@@ -39,8 +44,23 @@ type RedfishServer struct {
 
 // RedfishServerPure defines server config without RedfishAlias.
 type RedfishServerPure struct {
-	User        types.String `tfsdk:"user"`
-	Password    types.String `tfsdk:"password"`
-	Endpoint    types.String `tfsdk:"endpoint"`
-	SslInsecure types.Bool   `tfsdk:"ssl_insecure"`
+	User                    types.String `tfsdk:"user"`
+	Password                types.String `tfsdk:"password"`
+	Endpoint                types.String `tfsdk:"endpoint"`
+	SslInsecure             types.Bool   `tfsdk:"ssl_insecure"`
+	CABundle                types.String `tfsdk:"ca_bundle"`
+	CertificateFingerprints types.List   `tfsdk:"certificate_fingerprints"`
+	TLSMinVersion           types.String `tfsdk:"tls_min_version"`
+	Retry                   types.Object `tfsdk:"retry"`
+}
+
+// RetrySettings defines how failed requests to a BMC are retried.
+type RetrySettings struct {
+	MaxRetries           types.Int64 `tfsdk:"max_retries"`
+	RetryInterval        types.Int64 `tfsdk:"retry_interval"`
+	MaxRetryInterval     types.Int64 `tfsdk:"max_retry_interval"`
+	ExponentialBackoff   types.Bool  `tfsdk:"exponential_backoff"`
+	Jitter               types.Bool  `tfsdk:"jitter"`
+	RetryableStatusCodes types.List  `tfsdk:"retryable_status_codes"`
+	RetryNonIdempotent   types.Bool  `tfsdk:"retry_non_idempotent"`
 }
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SecureBoot is struct to create schema for secure boot resource
+type SecureBoot struct {
+	ID                    types.String    `tfsdk:"id"`
+	RedfishServer         []RedfishServer `tfsdk:"redfish_server"`
+	SystemID              types.String    `tfsdk:"system_id"`
+	SecureBootEnable      types.Bool      `tfsdk:"secure_boot_enable"`
+	SecureBootMode        types.String    `tfsdk:"secure_boot_mode"`
+	SecureBootCurrentBoot types.String    `tfsdk:"secure_boot_current_boot"`
+	ResetKeys             types.String    `tfsdk:"reset_keys"`
+	Certificates          types.Set       `tfsdk:"certificates"`
+	ResetType             types.String    `tfsdk:"reset_type"`
+	ResetTimeout          types.Int64     `tfsdk:"reset_timeout"`
+	JobTimeout            types.Int64     `tfsdk:"bios_job_timeout"`
+}
+
+// SecureBootCertificate is a certificate of a secure boot database managed by the secure boot resource
+type SecureBootCertificate struct {
+	DatabaseID        types.String `tfsdk:"database_id"`
+	CertificateString types.String `tfsdk:"certificate_string"`
+}
+
+// SecureBootDatasource is struct for secure boot data-source
+type SecureBootDatasource struct {
+	ID                    types.String         `tfsdk:"id"`
+	OdataID               types.String         `tfsdk:"odata_id"`
+	RedfishServer         []RedfishServer      `tfsdk:"redfish_server"`
+	SystemID              types.String         `tfsdk:"system_id"`
+	SecureBootEnable      types.Bool           `tfsdk:"secure_boot_enable"`
+	SecureBootMode        types.String         `tfsdk:"secure_boot_mode"`
+	SecureBootCurrentBoot types.String         `tfsdk:"secure_boot_current_boot"`
+	Databases             []SecureBootDatabase `tfsdk:"databases"`
+}
+
+// SecureBootDatabase is a UEFI secure boot database with its enrolled certificates
+type SecureBootDatabase struct {
+	OdataID      types.String                    `tfsdk:"odata_id"`
+	DatabaseID   types.String                    `tfsdk:"database_id"`
+	Description  types.String                    `tfsdk:"description"`
+	Certificates []SecureBootDatabaseCertificate `tfsdk:"certificates"`
+}
+
+// SecureBootDatabaseCertificate is a certificate enrolled in a UEFI secure boot database
+type SecureBootDatabaseCertificate struct {
+	OdataID           types.String `tfsdk:"odata_id"`
+	ID                types.String `tfsdk:"id"`
+	CertificateType   types.String `tfsdk:"certificate_type"`
+	CertificateString types.String `tfsdk:"certificate_string"`
+	Subject           Subject      `tfsdk:"subject"`
+	Issuer            Subject      `tfsdk:"issuer"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	ValidNotBefore    types.String `tfsdk:"valid_not_before"`
+	ValidNotAfter     types.String `tfsdk:"valid_not_after"`
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SensorsDatasource is struct for sensors data-source.
+type SensorsDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ChassisID     types.String    `tfsdk:"chassis_id"`
+	Temperatures  []Temperature   `tfsdk:"temperatures"`
+	Fans          []Fan           `tfsdk:"fans"`
+	PowerSupplies []PowerSupply   `tfsdk:"power_supplies"`
+	PowerControls []PowerControl  `tfsdk:"power_controls"`
+	Redundancy    []Redundancy    `tfsdk:"redundancy"`
+	Sensors       []Sensor        `tfsdk:"sensors"`
+}
+
+// Temperature is the tfsdk model of Temperature.
+type Temperature struct {
+	ID                        types.String  `tfsdk:"id"`
+	Name                      types.String  `tfsdk:"name"`
+	PhysicalContext           types.String  `tfsdk:"physical_context"`
+	ReadingCelsius            types.Float64 `tfsdk:"reading_celsius"`
+	UpperThresholdNonCritical types.Float64 `tfsdk:"upper_threshold_non_critical"`
+	UpperThresholdCritical    types.Float64 `tfsdk:"upper_threshold_critical"`
+	UpperThresholdFatal       types.Float64 `tfsdk:"upper_threshold_fatal"`
+	LowerThresholdNonCritical types.Float64 `tfsdk:"lower_threshold_non_critical"`
+	LowerThresholdCritical    types.Float64 `tfsdk:"lower_threshold_critical"`
+	LowerThresholdFatal       types.Float64 `tfsdk:"lower_threshold_fatal"`
+	Status                    Status        `tfsdk:"status"`
+}
+
+// Fan is the tfsdk model of Fan.
+type Fan struct {
+	ID                        types.String  `tfsdk:"id"`
+	Name                      types.String  `tfsdk:"name"`
+	PhysicalContext           types.String  `tfsdk:"physical_context"`
+	Reading                   types.Float64 `tfsdk:"reading"`
+	ReadingUnits              types.String  `tfsdk:"reading_units"`
+	UpperThresholdNonCritical types.Float64 `tfsdk:"upper_threshold_non_critical"`
+	UpperThresholdCritical    types.Float64 `tfsdk:"upper_threshold_critical"`
+	UpperThresholdFatal       types.Float64 `tfsdk:"upper_threshold_fatal"`
+	LowerThresholdNonCritical types.Float64 `tfsdk:"lower_threshold_non_critical"`
+	LowerThresholdCritical    types.Float64 `tfsdk:"lower_threshold_critical"`
+	LowerThresholdFatal       types.Float64 `tfsdk:"lower_threshold_fatal"`
+	Status                    Status        `tfsdk:"status"`
+}
+
+// PowerSupply is the tfsdk model of PowerSupply.
+type PowerSupply struct {
+	ID                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	Manufacturer         types.String  `tfsdk:"manufacturer"`
+	Model                types.String  `tfsdk:"model"`
+	SerialNumber         types.String  `tfsdk:"serial_number"`
+	PartNumber           types.String  `tfsdk:"part_number"`
+	FirmwareVersion      types.String  `tfsdk:"firmware_version"`
+	PowerCapacityWatts   types.Float64 `tfsdk:"power_capacity_watts"`
+	PowerInputWatts      types.Float64 `tfsdk:"power_input_watts"`
+	PowerOutputWatts     types.Float64 `tfsdk:"power_output_watts"`
+	LastPowerOutputWatts types.Float64 `tfsdk:"last_power_output_watts"`
+	LineInputVoltage     types.Float64 `tfsdk:"line_input_voltage"`
+	Status               Status        `tfsdk:"status"`
+}
+
+// PowerControl is the tfsdk model of PowerControl.
+type PowerControl struct {
+	ID                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	PowerConsumedWatts   types.Float64 `tfsdk:"power_consumed_watts"`
+	PowerCapacityWatts   types.Float64 `tfsdk:"power_capacity_watts"`
+	PowerAllocatedWatts  types.Float64 `tfsdk:"power_allocated_watts"`
+	PowerAvailableWatts  types.Float64 `tfsdk:"power_available_watts"`
+	PowerRequestedWatts  types.Float64 `tfsdk:"power_requested_watts"`
+	AverageConsumedWatts types.Float64 `tfsdk:"average_consumed_watts"`
+	MinConsumedWatts     types.Float64 `tfsdk:"min_consumed_watts"`
+	MaxConsumedWatts     types.Float64 `tfsdk:"max_consumed_watts"`
+	Status               Status        `tfsdk:"status"`
+}
+
+// Redundancy is the tfsdk model of Redundancy.
+type Redundancy struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Mode            types.String `tfsdk:"mode"`
+	MinNumNeeded    types.Int64  `tfsdk:"min_num_needed"`
+	MaxNumSupported types.Int64  `tfsdk:"max_num_supported"`
+	Status          Status       `tfsdk:"status"`
+}
+
+// Sensor is the tfsdk model of Sensor.
+type Sensor struct {
+	ID              types.String     `tfsdk:"id"`
+	Name            types.String     `tfsdk:"name"`
+	PhysicalContext types.String     `tfsdk:"physical_context"`
+	ReadingType     types.String     `tfsdk:"reading_type"`
+	Reading         types.Float64    `tfsdk:"reading"`
+	ReadingUnits    types.String     `tfsdk:"reading_units"`
+	Thresholds      SensorThresholds `tfsdk:"thresholds"`
+	Status          Status           `tfsdk:"status"`
+}
+
+// SensorThresholds is the tfsdk model of SensorThresholds.
+type SensorThresholds struct {
+	UpperCaution  types.Float64 `tfsdk:"upper_caution"`
+	UpperCritical types.Float64 `tfsdk:"upper_critical"`
+	UpperFatal    types.Float64 `tfsdk:"upper_fatal"`
+	LowerCaution  types.Float64 `tfsdk:"lower_caution"`
+	LowerCritical types.Float64 `tfsdk:"lower_critical"`
+	LowerFatal    types.Float64 `tfsdk:"lower_fatal"`
+}
@@ -66,6 +66,15 @@ type RedfishScpImport struct {
 	TimeToWait     types.Int64     `tfsdk:"time_to_wait"`
 	// ShareParameters Object of type TFShareParameters
 	ShareParameters types.Object `tfsdk:"share_parameters"`
+	ImportFile      types.String `tfsdk:"import_file"`
+	ImportFileHash  types.String `tfsdk:"import_file_hash"`
+	TemplateVars    types.Map    `tfsdk:"template_vars"`
+	FQDDs           types.List   `tfsdk:"fqdds"`
+	JobID           types.String `tfsdk:"job_id"`
+	JobState        types.String `tfsdk:"job_state"`
+	ErrorCount      types.Int64  `tfsdk:"error_count"`
+	// Results List of type ScpAttributeResult
+	Results types.List `tfsdk:"results"`
 }
 
 // TFRedfishScpExport is the tfsdk model of ScpExport
@@ -78,6 +87,9 @@ type TFRedfishScpExport struct {
 	IncludeInExport types.List      `tfsdk:"include_in_export"`
 	// ShareParameters Object of type TFShareParameters
 	ShareParameters types.Object `tfsdk:"share_parameters"`
+	// Filter Object of type ScpProfileFilter
+	Filter     types.Object `tfsdk:"filter"`
+	Components types.Map    `tfsdk:"components"`
 }
 
 // TFShareParameters to provide configuration for local/network share type
@@ -98,3 +110,75 @@ type TFShareParameters struct {
 	Target                   types.List   `tfsdk:"target"`
 	Username                 types.String `tfsdk:"username"`
 }
+
+// SCPImportPreview to provide payload for server configuration profile import preview
+type SCPImportPreview struct {
+	ImportBuffer    string          `json:"ImportBuffer,omitempty"`
+	ShareParameters ShareParameters `json:"ShareParameters"`
+}
+
+// ScpImportPreviewDatasource is the tfsdk model of the server configuration profile import preview data source
+type ScpImportPreviewDatasource struct {
+	ID              types.String               `tfsdk:"id"`
+	RedfishServer   []RedfishServer            `tfsdk:"redfish_server"`
+	ImportBuffer    types.String               `tfsdk:"import_buffer"`
+	ShareParameters *ScpPreviewShareParameters `tfsdk:"share_parameters"`
+	Target          []types.String             `tfsdk:"target"`
+	FailOnError     types.Bool                 `tfsdk:"fail_on_error"`
+	JobID           types.String               `tfsdk:"job_id"`
+	JobState        types.String               `tfsdk:"job_state"`
+	Message         types.String               `tfsdk:"message"`
+	ErrorCount      types.Int64                `tfsdk:"error_count"`
+	Results         []ScpAttributeResult       `tfsdk:"results"`
+}
+
+// ScpPreviewShareParameters is the tfsdk model of the network share of the profile to preview
+type ScpPreviewShareParameters struct {
+	FileName                 types.String `tfsdk:"filename"`
+	ShareType                types.String `tfsdk:"share_type"`
+	IPAddress                types.String `tfsdk:"ip_address"`
+	ShareName                types.String `tfsdk:"share_name"`
+	Username                 types.String `tfsdk:"username"`
+	Password                 types.String `tfsdk:"password"`
+	PortNumber               types.Int64  `tfsdk:"port_number"`
+	IgnoreCertificateWarning types.Bool   `tfsdk:"ignore_certificate_warning"`
+}
+
+// ScpAttributeResult is the tfsdk model of the result of an attribute of a server configuration profile job
+type ScpAttributeResult struct {
+	FQDD      types.String `tfsdk:"fqdd"`
+	Name      types.String `tfsdk:"name"`
+	OldValue  types.String `tfsdk:"old_value"`
+	NewValue  types.String `tfsdk:"new_value"`
+	Status    types.String `tfsdk:"status"`
+	Message   types.String `tfsdk:"message"`
+	MessageID types.String `tfsdk:"message_id"`
+	Severity  types.String `tfsdk:"severity"`
+	ErrorCode types.Int64  `tfsdk:"error_code"`
+}
+
+// ScpProfileFilter is the tfsdk model of the filter of the components and attributes of a server configuration profile
+type ScpProfileFilter struct {
+	Components []types.String `tfsdk:"components"`
+	Attributes []types.String `tfsdk:"attributes"`
+}
+
+// ScpDiffDatasource is the tfsdk model of the server configuration profile diff data source
+type ScpDiffDatasource struct {
+	ID             types.String       `tfsdk:"id"`
+	Baseline       types.String       `tfsdk:"baseline"`
+	Profile        types.String       `tfsdk:"profile"`
+	Filter         *ScpProfileFilter  `tfsdk:"filter"`
+	HasDifferences types.Bool         `tfsdk:"has_differences"`
+	Added          []ScpAttributeDiff `tfsdk:"added"`
+	Removed        []ScpAttributeDiff `tfsdk:"removed"`
+	Changed        []ScpAttributeDiff `tfsdk:"changed"`
+}
+
+// ScpAttributeDiff is the tfsdk model of an attribute which differs between two server configuration profiles
+type ScpAttributeDiff struct {
+	FQDD          types.String `tfsdk:"fqdd"`
+	Name          types.String `tfsdk:"name"`
+	BaselineValue types.String `tfsdk:"baseline_value"`
+	Value         types.String `tfsdk:"value"`
+}
@@ -37,6 +37,8 @@ type StorageControllerResource struct {
 	SystemID          types.String       `tfsdk:"system_id"`
 	StorageController types.Object       `tfsdk:"storage_controller"`
 	Security          types.Object       `tfsdk:"security"`
+	PendingAttributes types.Map          `tfsdk:"pending_attributes"`
+	PendingJobID      types.String       `tfsdk:"pending_job_id"`
 }
 
 // SecurityAttributes is the struct for security.
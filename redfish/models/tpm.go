@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TPM is struct to create schema for tpm resource
+type TPM struct {
+	ID                types.String    `tfsdk:"id"`
+	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
+	SystemID          types.String    `tfsdk:"system_id"`
+	TpmSecurity       types.String    `tfsdk:"tpm_security"`
+	Tpm2Hierarchy     types.String    `tfsdk:"tpm2_hierarchy"`
+	TpmPpiBypassClear types.String    `tfsdk:"tpm_ppi_bypass_clear"`
+	ClearVersion      types.Int64     `tfsdk:"clear_version"`
+	ResetType         types.String    `tfsdk:"reset_type"`
+	ResetTimeout      types.Int64     `tfsdk:"reset_timeout"`
+	JobTimeout        types.Int64     `tfsdk:"bios_job_timeout"`
+}
+
+// TrustedModulesDatasource is struct for trusted modules data-source
+type TrustedModulesDatasource struct {
+	ID             types.String    `tfsdk:"id"`
+	RedfishServer  []RedfishServer `tfsdk:"redfish_server"`
+	SystemID       types.String    `tfsdk:"system_id"`
+	TrustedModules []TrustedModule `tfsdk:"trusted_modules"`
+}
+
+// TrustedModule is a trusted module of the computer system, such as a TPM
+type TrustedModule struct {
+	InterfaceType          types.String `tfsdk:"interface_type"`
+	InterfaceTypeSelection types.String `tfsdk:"interface_type_selection"`
+	FirmwareVersion        types.String `tfsdk:"firmware_version"`
+	FirmwareVersion2       types.String `tfsdk:"firmware_version2"`
+	State                  types.String `tfsdk:"state"`
+	Health                 types.String `tfsdk:"health"`
+}
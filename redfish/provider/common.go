@@ -23,8 +23,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
 	"time"
@@ -32,11 +35,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
@@ -177,6 +183,93 @@ func RedfishServerDatasourceBlockMap() map[string]datasourceSchema.Block {
 	}
 }
 
+// filterByID returns the members whose ID is one of the filtered ids, or all the members when no id is given,
+// along with the IDs of all the members to report the valid ones and whether every filtered id matched a member.
+func filterByID[T any](members []T, memberID func(T) string, ids []string) ([]T, []string, bool) {
+	var filtered []T
+	validIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		validIDs = append(validIDs, memberID(member))
+		if len(ids) == 0 || slices.Contains(ids, memberID(member)) {
+			filtered = append(filtered, member)
+		}
+	}
+	// an id given more than once matches a single member
+	uniqueIDs := slices.Compact(slices.Sorted(slices.Values(ids)))
+	return filtered, validIDs, len(ids) == 0 || len(filtered) == len(uniqueIDs)
+}
+
+// filterSystemsByID returns the computer systems of the service filtered by their IDs, all of them when no id is given.
+func filterSystemsByID(service *gofish.Service, ids []string) ([]*redfish.ComputerSystem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	systems, err := service.Systems()
+	if err != nil {
+		diags.AddError("Error fetching computer systems collection", err.Error())
+		return nil, diags
+	}
+	filtered, validIDs, found := filterByID(systems, func(system *redfish.ComputerSystem) string { return system.ID }, ids)
+	if !found {
+		diags.AddError(
+			"Error one or more of the filtered system ids are not valid.",
+			fmt.Sprintf("Valid system ids are [%v]", strings.Join(validIDs, ", ")),
+		)
+		return nil, diags
+	}
+	return filtered, diags
+}
+
+// systemInventory reads the members of an inventory collection of the computer systems, such as their memory,
+// and converts them to their tfsdk model M. F is the tfsdk model of the filter of a system.
+type systemInventory[T, M, F any] struct {
+	// collection is the name of the collection in errors, memberName the name of its members
+	collection string
+	memberName string
+	members    func(system *redfish.ComputerSystem) ([]T, error)
+	memberID   func(member T) string
+	filterIDs  func(filter F) (systemID types.String, memberIDs []types.String)
+	newMember  func(systemID string, member T) M
+}
+
+// read returns the members of the systems of the filters, only those of the member ids of the filter of their system,
+// and the members of every system when there are no filters.
+func (inventory systemInventory[T, M, F]) read(service *gofish.Service, filters []F) ([]M, diag.Diagnostics) {
+	memberFilter := make(map[string][]string)
+	var systemIDs []string
+	for _, filter := range filters {
+		systemID, memberIDs := inventory.filterIDs(filter)
+		systemIDs = append(systemIDs, systemID.ValueString())
+		for _, memberID := range memberIDs {
+			memberFilter[systemID.ValueString()] = append(memberFilter[systemID.ValueString()], memberID.ValueString())
+		}
+	}
+
+	result := []M{}
+	systems, diags := filterSystemsByID(service, systemIDs)
+	if diags.HasError() {
+		return result, diags
+	}
+	for _, system := range systems {
+		members, err := inventory.members(system)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error fetching %s collection", inventory.collection), err.Error())
+			return result, diags
+		}
+		filtered, validIDs, found := filterByID(members, inventory.memberID, memberFilter[system.ID])
+		// check for an invalid member id for a system id in the filter
+		if !found {
+			diags.AddError(
+				fmt.Sprintf("Error one or more of the filtered %s ids are not valid for the system id %s", inventory.memberName, system.ID),
+				fmt.Sprintf("Valid %s ids are [%v]", inventory.memberName, strings.Join(validIDs, ", ")),
+			)
+			return result, diags
+		}
+		for _, member := range filtered {
+			result = append(result, inventory.newMember(system.ID, member))
+		}
+	}
+	return result, diags
+}
+
 // getSystemResourceWithService retrieves a concrete ComputerSystem resource for a given Service instance,
 // optionally filtering the systems using the given sysid.
 //
@@ -215,6 +308,55 @@ func getSystemResource(service *gofish.Service, sysid string) (*redfish.Computer
 	return nil, errors.New("no computer system found with given system id")
 }
 
+// getManagerResource returns the manager with the given ID, or the first manager when managerID is empty.
+func getManagerResource(service *gofish.Service, managerID string) (*redfish.Manager, error) {
+	if service == nil {
+		return nil, fmt.Errorf("gofish.Service is nil")
+	}
+
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(managers) == 0 {
+		return nil, errors.New("no managers found")
+	}
+
+	if len(managerID) == 0 {
+		return managers[0], nil
+	}
+
+	return getManagerFromCollection(managers, managerID)
+}
+
+// getChassisResource returns the chassis with the given ID, or the first chassis when chassisID is empty.
+func getChassisResource(service *gofish.Service, chassisID string) (*redfish.Chassis, error) {
+	if service == nil {
+		return nil, fmt.Errorf("gofish.Service is nil")
+	}
+
+	chassisCollection, err := service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chassisCollection) == 0 {
+		return nil, errors.New("no chassis found")
+	}
+
+	if len(chassisID) == 0 {
+		return chassisCollection[0], nil
+	}
+
+	for _, chassis := range chassisCollection {
+		if chassis.ID == chassisID {
+			return chassis, nil
+		}
+	}
+	return nil, fmt.Errorf("chassis %s not found", chassisID)
+}
+
 // NewConfig function creates the needed gofish structs to query the redfish API
 // See https://github.com/stmcginnis/gofish for details. This function returns a Service struct which can then be
 // used to make any required API calls.
@@ -230,6 +372,14 @@ func NewConfig(pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofi
 	if err := getActiveAliasRedfishServer(pconfig, &rserver1); err != nil {
 		return nil, err
 	}
+	tlsSettings, err := getRedfishServerTLSSettings(pconfig, &rserver1)
+	if err != nil {
+		return nil, err
+	}
+	retryConfig, err := getRedfishServerRetryConfig(pconfig, &rserver1)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(rserver1.User.ValueString()) > 0 {
 		redfishClientUser = rserver1.User.ValueString()
@@ -251,12 +401,32 @@ func NewConfig(pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofi
 		return nil, fmt.Errorf("error. Either Redfish client username or password has not been set. Please check your configuration")
 	}
 
+	endpoint := rserver1.Endpoint.ValueString()
+	if !strings.HasPrefix(endpoint, "http") {
+		return nil, fmt.Errorf("error connecting to redfish API: endpoint must starts with http or https")
+	}
+
+	// Use retry-enabled HTTP client verifying the BMC certificate as configured for this server
+	httpClient, err := pconfig.GetHTTPClient(tlsSettings, retryConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring TLS for redfish API: %w", err)
+	}
+
+	// Reuse the X-Auth-Token session of this endpoint and user, logging in only when needed
+	session, err := pconfig.sessions.get(context.TODO(), httpClient, endpoint, redfishClientUser, redfishClientPass)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to redfish API: %w", err)
+	}
+
+	// Authentication is handled by the session transport, so the client does not own the session
+	// and api.Logout() leaves it open for the other resources of this run.
 	clientConfig := gofish.ClientConfig{
-		Endpoint:   rserver1.Endpoint.ValueString(),
-		Username:   redfishClientUser,
-		Password:   redfishClientPass,
-		Insecure:   rserver1.SslInsecure.ValueBool(),
-		HTTPClient: pconfig.GetHTTPClient(), // Use retry-enabled HTTP client
+		Endpoint: endpoint,
+		Insecure: rserver1.SslInsecure.ValueBool(),
+		HTTPClient: &http.Client{
+			Transport: &sessionTransport{transport: httpClient.Transport, session: session},
+			Timeout:   httpClient.Timeout,
+		},
 	}
 
 	api, err := gofish.Connect(clientConfig)
@@ -278,11 +448,9 @@ func getActiveAliasRedfishServer(pconfig *redfishProvider, rserver *models.Redfi
 		return fmt.Errorf("when `redfish_alias` is not null, provider's `redfish_servers` is required")
 	}
 
-	serversMap := make(map[string]models.RedfishServerPure)
-	_ = pconfig.Servers.ElementsAs(context.TODO(), &serversMap, true)
-	aliasServer, ok := serversMap[serverAlias]
-	if !ok {
-		return fmt.Errorf("redfish_alias: %s is not key in the map of provider's `redfish_servers`", serverAlias)
+	aliasServer, err := getAliasServer(pconfig, serverAlias)
+	if err != nil {
+		return err
 	}
 	rserver.Endpoint = aliasServer.Endpoint
 	rserver.User = aliasServer.User
@@ -291,6 +459,119 @@ func getActiveAliasRedfishServer(pconfig *redfishProvider, rserver *models.Redfi
 	return nil
 }
 
+// getAliasServer returns the entry of provider's `redfish_servers` for the given alias.
+func getAliasServer(pconfig *redfishProvider, serverAlias string) (*models.RedfishServerPure, error) {
+	serversMap := make(map[string]models.RedfishServerPure)
+	_ = pconfig.Servers.ElementsAs(context.TODO(), &serversMap, true)
+	aliasServer, ok := serversMap[serverAlias]
+	if !ok {
+		return nil, fmt.Errorf("redfish_alias: %s is not key in the map of provider's `redfish_servers`", serverAlias)
+	}
+	return &aliasServer, nil
+}
+
+// getRedfishServerTLSSettings resolves how the certificate of a server is verified.
+// Values set on the provider's `redfish_servers` entry take precedence over the provider level ones.
+func getRedfishServerTLSSettings(pconfig *redfishProvider, rserver *models.RedfishServer) (TLSSettings, error) {
+	settings := TLSSettings{
+		Insecure:   rserver.SslInsecure.ValueBool(),
+		CABundle:   pconfig.CABundle.ValueString(),
+		MinVersion: pconfig.TLSMinVersion.ValueString(),
+	}
+
+	serverAlias := rserver.RedfishAlias.ValueString()
+	if serverAlias == "" || pconfig.Servers.IsNull() {
+		return settings, nil
+	}
+	aliasServer, err := getAliasServer(pconfig, serverAlias)
+	if err != nil {
+		return settings, err
+	}
+	if aliasServer.CABundle.ValueString() != "" {
+		settings.CABundle = aliasServer.CABundle.ValueString()
+	}
+	if aliasServer.TLSMinVersion.ValueString() != "" {
+		settings.MinVersion = aliasServer.TLSMinVersion.ValueString()
+	}
+	if !aliasServer.CertificateFingerprints.IsNull() && !aliasServer.CertificateFingerprints.IsUnknown() {
+		if diags := aliasServer.CertificateFingerprints.ElementsAs(context.TODO(), &settings.Fingerprints, true); diags.HasError() {
+			return settings, fmt.Errorf("invalid certificate_fingerprints for redfish_alias %s", serverAlias)
+		}
+	}
+	return settings, nil
+}
+
+// getRedfishServerRetryConfig returns the retry configuration of the server, the attributes of the
+// `retry` block of a `redfish_servers` entry overriding the provider level ones.
+func getRedfishServerRetryConfig(pconfig *redfishProvider, rserver *models.RedfishServer) (RetryConfig, error) {
+	config := pconfig.RetryConfig
+	serverAlias := rserver.RedfishAlias.ValueString()
+	if serverAlias == "" || pconfig.Servers.IsNull() {
+		return config, nil
+	}
+	aliasServer, err := getAliasServer(pconfig, serverAlias)
+	if err != nil {
+		return config, err
+	}
+	config, diags := mergeRetrySettings(context.TODO(), config, aliasServer.Retry)
+	if diags.HasError() {
+		return config, fmt.Errorf("invalid retry configuration for redfish_alias %s", serverAlias)
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("invalid retry configuration for redfish_alias %s: %w", serverAlias, err)
+	}
+	return config, nil
+}
+
+// waitForRedfishServerReady waits for the iDRAC of the redfish_server block to be ready for operations,
+// unless the readiness check is disabled in the retry settings of the server
+func waitForRedfishServerReady(ctx context.Context, pconfig *redfishProvider, rserver []models.RedfishServer) error {
+	if len(rserver) == 0 {
+		return fmt.Errorf("no provider block was found")
+	}
+	server := rserver[0]
+	retryConfig, err := getRedfishServerRetryConfig(pconfig, &server)
+	if err != nil {
+		return err
+	}
+	if !retryConfig.EnableReadinessCheck {
+		return nil
+	}
+	if err := getActiveAliasRedfishServer(pconfig, &server); err != nil {
+		return err
+	}
+	checker, err := newRedfishServerReadinessChecker(pconfig, &server, server.User.ValueString(), server.Password.ValueString(), retryConfig)
+	if err != nil {
+		return err
+	}
+	return checker.WaitForReady(ctx)
+}
+
+// newRedfishServerReadinessChecker returns the readiness checker of the iDRAC of a resolved redfish_server block.
+// The provider credentials are used when user or password is empty, and the BMC certificate is verified with the
+// TLS settings of the server, like for the Redfish client.
+func newRedfishServerReadinessChecker(pconfig *redfishProvider, server *models.RedfishServer, user, password string,
+	retryConfig RetryConfig,
+) (*IDRACReadinessChecker, error) {
+	if user == "" {
+		user = pconfig.Username.ValueString()
+	}
+	if password == "" {
+		password = pconfig.Password.ValueString()
+	}
+	tlsSettings, err := getRedfishServerTLSSettings(pconfig, server)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := pconfig.getTransport(tlsSettings, retryConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring TLS for the iDRAC readiness check: %w", err)
+	}
+	checker := NewIDRACReadinessChecker(server.Endpoint.ValueString(), user, password, server.SslInsecure.ValueBool(), retryConfig)
+	checker.httpClient = &http.Client{Transport: transport, Timeout: checker.httpClient.Timeout}
+	return checker, nil
+}
+
 type powerOperator struct {
 	ctx     context.Context
 	service *gofish.Service
@@ -452,3 +733,9 @@ func isServerGenerationSeventeenAndAbove(service *gofish.Service) (bool, error)
 
 	return genVal >= Seventeen, nil
 }
+
+// isRedfishNotFoundError reports whether the BMC answered 404 Not Found
+func isRedfishNotFoundError(err error) bool {
+	var redfishErr *redfishcommon.Error
+	return errors.As(err, &redfishErr) && redfishErr.HTTPReturnedStatusCode == http.StatusNotFound
+}
@@ -114,6 +114,13 @@ func (g *BiosDatasource) Read(ctx context.Context, req datasource.ReadRequest, r
 	var plan models.BiosDatasource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	api, err := NewConfig(g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
@@ -0,0 +1,353 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &ChassisDatasource{}
+	_ datasource.DataSourceWithConfigure = &ChassisDatasource{}
+)
+
+// NewChassisDatasource is new datasource for the chassis
+func NewChassisDatasource() datasource.DataSource {
+	return &ChassisDatasource{}
+}
+
+// ChassisDatasource to construct datasource
+type ChassisDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *ChassisDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*ChassisDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "chassis"
+}
+
+// Schema implements datasource.DataSource
+func (*ChassisDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the chassis inventory, such as the asset tag, location and indicator LED state." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the chassis inventory, such as the asset tag, location and indicator LED state." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: ChassisDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"chassis_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Chassis filter",
+				Description:         "Chassis filter",
+				Attributes:          ChassisFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *ChassisDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.ChassisDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishChassis(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishChassis populates the filtered chassis in the datasource model
+func readDatasourceRedfishChassis(service *gofish.Service, d models.ChassisDatasource) (models.ChassisDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+	d.Chassis = []models.Chassis{}
+
+	var chassisIDs []string
+	if d.ChassisFilter != nil {
+		for _, chassisID := range d.ChassisFilter.ChassisIDs {
+			chassisIDs = append(chassisIDs, chassisID.ValueString())
+		}
+	}
+
+	chassisCollection, err := service.Chassis()
+	if err != nil {
+		diags.AddError("Error fetching chassis collection", err.Error())
+		return d, diags
+	}
+	filtered, validIDs, found := filterByID(chassisCollection, func(chassis *redfish.Chassis) string { return chassis.ID }, chassisIDs)
+	// check for an invalid chassis id in the filter
+	if !found {
+		diags.AddError(
+			"Error one or more of the filtered chassis ids are not valid.",
+			fmt.Sprintf("Valid chassis ids are [%v]", strings.Join(validIDs, ", ")),
+		)
+		return d, diags
+	}
+	for _, chassis := range filtered {
+		d.Chassis = append(d.Chassis, newChassis(chassis))
+	}
+	return d, diags
+}
+
+// newChassis converts redfish.Chassis to models.Chassis
+func newChassis(chassis *redfish.Chassis) models.Chassis {
+	return models.Chassis{
+		ODataID:                 types.StringValue(chassis.ODataID),
+		ID:                      types.StringValue(chassis.ID),
+		Name:                    types.StringValue(chassis.Name),
+		Description:             types.StringValue(chassis.Description),
+		ChassisType:             types.StringValue(string(chassis.ChassisType)),
+		Manufacturer:            types.StringValue(chassis.Manufacturer),
+		Model:                   types.StringValue(chassis.Model),
+		SKU:                     types.StringValue(chassis.SKU),
+		SerialNumber:            types.StringValue(chassis.SerialNumber),
+		PartNumber:              types.StringValue(chassis.PartNumber),
+		UUID:                    types.StringValue(chassis.UUID),
+		AssetTag:                types.StringValue(chassis.AssetTag),
+		PowerState:              types.StringValue(string(chassis.PowerState)),
+		IndicatorLED:            types.StringValue(string(chassis.IndicatorLED)),
+		LocationIndicatorActive: types.BoolValue(chassis.LocationIndicatorActive),
+		Location: models.ChassisLocation{
+			Info:       types.StringValue(chassis.Location.Info),
+			Building:   types.StringValue(chassis.Location.PostalAddress.Building),
+			Floor:      types.StringValue(chassis.Location.PostalAddress.Floor),
+			Room:       types.StringValue(chassis.Location.PostalAddress.Room),
+			Row:        types.StringValue(chassis.Location.Placement.Row),
+			Rack:       types.StringValue(chassis.Location.Placement.Rack),
+			RackOffset: types.Int64Value(int64(chassis.Location.Placement.RackOffset)),
+		},
+		Status: newStatus(chassis.Status),
+	}
+}
+
+// ChassisFilterSchema to construct schema of chassis filter
+func ChassisFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"chassis_ids": schema.SetAttribute{
+			MarkdownDescription: "IDs of the chassis to read, for example `System.Embedded.1`.",
+			Description:         "IDs of the chassis to read, for example System.Embedded.1.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// ChassisDatasourceSchema to define the chassis data-source schema
+func ChassisDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis data-source",
+			Description:         "ID of the chassis data-source",
+			Computed:            true,
+		},
+		"chassis": schema.ListNestedAttribute{
+			MarkdownDescription: "List of chassis fetched.",
+			Description:         "List of chassis fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ChassisSchema(),
+			},
+		},
+	}
+}
+
+// ChassisSchema to define the chassis schema
+func ChassisSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the chassis",
+			Description:         "OData ID of the chassis",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis",
+			Description:         "ID of the chassis",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the chassis",
+			Description:         "Name of the chassis",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the chassis",
+			Description:         "Description of the chassis",
+			Computed:            true,
+		},
+		"chassis_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the chassis, such as RackMount or Enclosure",
+			Description:         "Type of the chassis, such as RackMount or Enclosure",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the chassis",
+			Description:         "Manufacturer of the chassis",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the chassis",
+			Description:         "Model of the chassis",
+			Computed:            true,
+		},
+		"sku": schema.StringAttribute{
+			MarkdownDescription: "SKU of the chassis, which is the service tag on Dell servers",
+			Description:         "SKU of the chassis, which is the service tag on Dell servers",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the chassis",
+			Description:         "Serial number of the chassis",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the chassis",
+			Description:         "Part number of the chassis",
+			Computed:            true,
+		},
+		"uuid": schema.StringAttribute{
+			MarkdownDescription: "UUID of the chassis",
+			Description:         "UUID of the chassis",
+			Computed:            true,
+		},
+		"asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the chassis",
+			Description:         "Asset tag of the chassis",
+			Computed:            true,
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "Power state of the chassis",
+			Description:         "Power state of the chassis",
+			Computed:            true,
+		},
+		"indicator_led": schema.StringAttribute{
+			MarkdownDescription: "State of the indicator LED of the chassis",
+			Description:         "State of the indicator LED of the chassis",
+			Computed:            true,
+		},
+		"location_indicator_active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the location indicator of the chassis is active",
+			Description:         "Whether the location indicator of the chassis is active",
+			Computed:            true,
+		},
+		"location": schema.SingleNestedAttribute{
+			MarkdownDescription: "Location of the chassis",
+			Description:         "Location of the chassis",
+			Computed:            true,
+			Attributes:          ChassisLocationSchema(),
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the chassis",
+			Description:         "The status and health of the chassis",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// ChassisLocationSchema to define the chassis location schema
+func ChassisLocationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"info": schema.StringAttribute{
+			MarkdownDescription: "Location information of the chassis",
+			Description:         "Location information of the chassis",
+			Computed:            true,
+		},
+		"building": schema.StringAttribute{
+			MarkdownDescription: "Name of the building of the chassis",
+			Description:         "Name of the building of the chassis",
+			Computed:            true,
+		},
+		"floor": schema.StringAttribute{
+			MarkdownDescription: "Floor of the chassis",
+			Description:         "Floor of the chassis",
+			Computed:            true,
+		},
+		"room": schema.StringAttribute{
+			MarkdownDescription: "Name of the room of the chassis",
+			Description:         "Name of the room of the chassis",
+			Computed:            true,
+		},
+		"row": schema.StringAttribute{
+			MarkdownDescription: "Name of the row of the chassis",
+			Description:         "Name of the row of the chassis",
+			Computed:            true,
+		},
+		"rack": schema.StringAttribute{
+			MarkdownDescription: "Name of the rack of the chassis",
+			Description:         "Name of the rack of the chassis",
+			Computed:            true,
+		},
+		"rack_offset": schema.Int64Attribute{
+			MarkdownDescription: "Vertical location of the chassis in the rack, in rack units from the bottom",
+			Description:         "Vertical location of the chassis in the rack, in rack units from the bottom",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to fetch the chassis - Positive
+func TestAccRedfishChassisDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_chassis.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceChassisConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "chassis.#"),
+					resource.TestCheckResourceAttrSet(dsName, "chassis.0.odata_id"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceChassisConfig(creds, `
+				chassis_filter {
+					chassis_ids = ["System.Embedded.1"]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "chassis.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "chassis.0.id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet(dsName, "chassis.0.sku"),
+					resource.TestCheckResourceAttrSet(dsName, "chassis.0.indicator_led"),
+				),
+			},
+		},
+	})
+}
+
+// Test to fetch the chassis with an invalid filter - Negative
+func TestAccRedfishChassisDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceChassisConfig(creds, `
+				chassis_filter {
+					chassis_ids = ["System.Invalid.1"]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered chassis ids are not valid*.`),
+			},
+		},
+	})
+}
+
+func testAccRedfishDataSourceChassisConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_chassis" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
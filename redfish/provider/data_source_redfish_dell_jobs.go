@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &DellJobsDatasource{}
+	_ datasource.DataSourceWithConfigure = &DellJobsDatasource{}
+)
+
+// NewDellJobsDatasource is new datasource for Dell Lifecycle Controller jobs
+func NewDellJobsDatasource() datasource.DataSource {
+	return &DellJobsDatasource{}
+}
+
+// DellJobsDatasource to construct datasource
+type DellJobsDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *DellJobsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*DellJobsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "dell_jobs"
+}
+
+// Schema implements datasource.DataSource
+func (*DellJobsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the Lifecycle Controller jobs of the iDRAC job queue." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the Lifecycle Controller jobs of the iDRAC job queue." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: DellJobsDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"job_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Filter for the jobs",
+				Description:         "Filter for the jobs",
+				Attributes:          DellJobFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// DellJobsDatasourceSchema to define the Dell jobs data-source schema
+func DellJobsDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Dell jobs data-source",
+			Description:         "ID of the Dell jobs data-source",
+			Computed:            true,
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager whose job queue is read. Defaults to the first manager.",
+			Description:         "ID of the manager whose job queue is read. Defaults to the first manager.",
+			Optional:            true,
+		},
+		"jobs": schema.ListNestedAttribute{
+			MarkdownDescription: "Jobs of the job queue matching the filter.",
+			Description:         "Jobs of the job queue matching the filter.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: DellJobSchema(),
+			},
+		},
+	}
+}
+
+// DellJobFilterSchema to define the Dell job filter schema
+func DellJobFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"job_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the jobs to read, for example `JID_878682850779`.",
+			Description:         "IDs of the jobs to read, for example JID_878682850779.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"job_states": schema.ListAttribute{
+			MarkdownDescription: "States of the jobs to read, for example `Scheduled`, `Running`, `Completed` or `Failed`.",
+			Description:         "States of the jobs to read, for example Scheduled, Running, Completed or Failed.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"job_types": schema.ListAttribute{
+			MarkdownDescription: "Types of the jobs to read, for example `BIOSConfiguration` or `RAIDConfiguration`.",
+			Description:         "Types of the jobs to read, for example BIOSConfiguration or RAIDConfiguration.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// DellJobSchema to define the Dell job schema
+func DellJobSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the job",
+			Description:         "OData ID of the job",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the job",
+			Description:         "ID of the job",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the job",
+			Description:         "Name of the job",
+			Computed:            true,
+		},
+		"job_state": schema.StringAttribute{
+			MarkdownDescription: "State of the job",
+			Description:         "State of the job",
+			Computed:            true,
+		},
+		"job_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the job",
+			Description:         "Type of the job",
+			Computed:            true,
+		},
+		"percent_complete": schema.Int64Attribute{
+			MarkdownDescription: "Progress of the job in percent",
+			Description:         "Progress of the job in percent",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Status message of the job",
+			Description:         "Status message of the job",
+			Computed:            true,
+		},
+		"message_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the status message of the job",
+			Description:         "ID of the status message of the job",
+			Computed:            true,
+		},
+		"start_time": schema.StringAttribute{
+			MarkdownDescription: "Scheduled start time of the job",
+			Description:         "Scheduled start time of the job",
+			Computed:            true,
+		},
+		"end_time": schema.StringAttribute{
+			MarkdownDescription: "Time after which the job is not started anymore",
+			Description:         "Time after which the job is not started anymore",
+			Computed:            true,
+		},
+		"completion_time": schema.StringAttribute{
+			MarkdownDescription: "Time the job completed",
+			Description:         "Time the job completed",
+			Computed:            true,
+		},
+		"target_settings_uri": schema.StringAttribute{
+			MarkdownDescription: "URI of the settings applied by the job",
+			Description:         "URI of the settings applied by the job",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *DellJobsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.DellJobsDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	jobs, err := dellManager.Jobs()
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch jobs", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(dellManager.ID)
+	plan.Jobs = make([]models.DellJob, 0, len(jobs))
+	for _, job := range jobs {
+		if matchDellJob(plan.JobFilter, job) {
+			plan.Jobs = append(plan.Jobs, newDellJobModel(job))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// matchDellJob reports whether the job is selected by the filter, an empty list of the filter selecting every job
+func matchDellJob(filter *models.DellJobFilter, job *dell.Job) bool {
+	if filter == nil {
+		return true
+	}
+	matches := func(values []types.String, value string) bool {
+		return len(values) == 0 || slices.ContainsFunc(values, func(v types.String) bool {
+			return v.ValueString() == value
+		})
+	}
+	return matches(filter.JobIDs, job.ID) && matches(filter.JobStates, job.JobState) && matches(filter.JobTypes, job.JobType)
+}
+
+// newDellJobModel converts a Dell job to its tfsdk model
+func newDellJobModel(job *dell.Job) models.DellJob {
+	return models.DellJob{
+		ODataID:           types.StringValue(job.ODataID),
+		ID:                types.StringValue(job.ID),
+		Name:              types.StringValue(job.Name),
+		JobState:          types.StringValue(job.JobState),
+		JobType:           types.StringValue(job.JobType),
+		PercentComplete:   types.Int64Value(int64(job.PercentComplete)),
+		Message:           types.StringValue(job.Message),
+		MessageID:         types.StringValue(job.MessageID),
+		StartTime:         types.StringValue(job.StartTime),
+		EndTime:           types.StringValue(job.EndTime),
+		CompletionTime:    types.StringValue(job.CompletionTime),
+		TargetSettingsURI: types.StringValue(job.TargetSettingsURI),
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// Test to read the jobs of the job queue - Positive
+func TestAccRedfishDellJobsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceDellJobsConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_dell_jobs.jobs", "id"),
+					resource.TestCheckResourceAttrSet("data.redfish_dell_jobs.jobs", "jobs.#"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceDellJobsConfig(creds, `
+				job_filter {
+					job_ids = ["JID_000000000000"]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_dell_jobs.jobs", "jobs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Test to read the jobs with a mocked error - Negative
+func TestAccRedfishDellJobsDataSource_MockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock((*dell.ManagerExtended).Jobs).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishDataSourceDellJobsConfig(creds, ""),
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestMatchDellJob(t *testing.T) {
+	job := &dell.Job{Entity: common.Entity{ID: "JID_1"}, JobState: "Scheduled", JobType: "BIOSConfiguration"}
+	tests := []struct {
+		name   string
+		filter *models.DellJobFilter
+		want   bool
+	}{
+		{"no filter", nil, true},
+		{"empty filter", &models.DellJobFilter{}, true},
+		{"matching state", &models.DellJobFilter{JobStates: []types.String{types.StringValue("Completed"), types.StringValue("Scheduled")}}, true},
+		{"other state", &models.DellJobFilter{JobStates: []types.String{types.StringValue("Completed")}}, false},
+		{"matching id and type", &models.DellJobFilter{
+			JobIDs:   []types.String{types.StringValue("JID_1")},
+			JobTypes: []types.String{types.StringValue("BIOSConfiguration")},
+		}, true},
+		{"other type", &models.DellJobFilter{JobTypes: []types.String{types.StringValue("RAIDConfiguration")}}, false},
+	}
+	for _, tt := range tests {
+		if got := matchDellJob(tt.filter, job); got != tt.want {
+			t.Errorf("%s: matchDellJob() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func testAccRedfishDataSourceDellJobsConfig(testingInfo TestingServerCredentials, filter string) string {
+	return fmt.Sprintf(`
+		data "redfish_dell_jobs" "jobs" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		filter,
+	)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+var (
+	_ datasource.DataSource              = &DellLicenseDatasource{}
+	_ datasource.DataSourceWithConfigure = &DellLicenseDatasource{}
+)
+
+// NewDellLicenseDatasource is new datasource for Dell licenses
+func NewDellLicenseDatasource() datasource.DataSource {
+	return &DellLicenseDatasource{}
+}
+
+// DellLicenseDatasource to construct datasource
+type DellLicenseDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *DellLicenseDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*DellLicenseDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "dell_license"
+}
+
+// Schema implements datasource.DataSource
+func (*DellLicenseDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the licenses installed on the iDRAC." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the licenses installed on the iDRAC." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: DellLicenseDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// DellLicenseDatasourceSchema to define the Dell license data-source schema
+func DellLicenseDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Dell license data-source",
+			Description:         "ID of the Dell license data-source",
+			Computed:            true,
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager whose licenses are read. Defaults to the first manager.",
+			Description:         "ID of the manager whose licenses are read. Defaults to the first manager.",
+			Optional:            true,
+		},
+		"licenses": schema.ListNestedAttribute{
+			MarkdownDescription: "Licenses installed on the iDRAC.",
+			Description:         "Licenses installed on the iDRAC.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"odata_id": schema.StringAttribute{
+						MarkdownDescription: "OData ID of the license",
+						Description:         "OData ID of the license",
+						Computed:            true,
+					},
+					"entitlement_id": schema.StringAttribute{
+						MarkdownDescription: "Entitlement ID of the license",
+						Description:         "Entitlement ID of the license",
+						Computed:            true,
+					},
+					"license_type": schema.StringAttribute{
+						MarkdownDescription: "Type of the license, such as `Perpetual`, `Evaluation` or `Subscription`",
+						Description:         "Type of the license, such as Perpetual, Evaluation or Subscription",
+						Computed:            true,
+					},
+					"license_description": schema.ListAttribute{
+						MarkdownDescription: "Description of the features of the license",
+						Description:         "Description of the features of the license",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Status of the license",
+						Description:         "Status of the license",
+						Computed:            true,
+					},
+					"origin": schema.StringAttribute{
+						MarkdownDescription: "Origin of the license",
+						Description:         "Origin of the license",
+						Computed:            true,
+					},
+					"install_date": schema.StringAttribute{
+						MarkdownDescription: "Date the license was installed",
+						Description:         "Date the license was installed",
+						Computed:            true,
+					},
+					"sold_date": schema.StringAttribute{
+						MarkdownDescription: "Date the license was sold",
+						Description:         "Date the license was sold",
+						Computed:            true,
+					},
+					"eval_time_remaining_days": schema.Int64Attribute{
+						MarkdownDescription: "Number of days before an evaluation license expires",
+						Description:         "Number of days before an evaluation license expires",
+						Computed:            true,
+					},
+					"auto_renew": schema.BoolAttribute{
+						MarkdownDescription: "Whether the license is renewed automatically",
+						Description:         "Whether the license is renewed automatically",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *DellLicenseDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.DellLicenseDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	licenses, err := dellManager.Licenses()
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch licenses", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(dellManager.ID)
+	plan.Licenses = make([]models.DellLicense, 0, len(licenses))
+	for _, license := range licenses {
+		plan.Licenses = append(plan.Licenses, newDellLicenseModel(license))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// getDellManager returns the Dell OEM extension of the manager with the given ID, or of the first manager
+func getDellManager(service *gofish.Service, managerID string) (*dell.ManagerExtended, error) {
+	manager, err := getManagerResource(service, managerID)
+	if err != nil {
+		return nil, err
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the Dell OEM data of the manager: %w", err)
+	}
+	return dellManager, nil
+}
+
+// newDellLicenseModel converts a Dell license to its tfsdk model
+func newDellLicenseModel(license *dell.License) models.DellLicense {
+	description := make([]types.String, 0, len(license.LicenseDescription))
+	for _, value := range license.LicenseDescription {
+		description = append(description, types.StringValue(value))
+	}
+	return models.DellLicense{
+		ODataID:               types.StringValue(license.ODataID),
+		EntitlementID:         types.StringValue(licenseEntitlementID(license)),
+		LicenseType:           types.StringValue(license.LicenseType),
+		LicenseDescription:    description,
+		Status:                types.StringValue(license.LicensePrimaryStatus),
+		Origin:                types.StringValue(license.LicenseOrigin),
+		InstallDate:           types.StringValue(license.LicenseInstallDate),
+		SoldDate:              types.StringValue(license.LicenseSoldDate),
+		EvalTimeRemainingDays: types.Int64Value(int64(license.EvalLicenseTimeRemainingDays)),
+		AutoRenew:             types.BoolValue(license.AutoRenew),
+	}
+}
+
+// licenseEntitlementID returns the entitlement ID of the license, which older firmwares only provide as its ID
+func licenseEntitlementID(license *dell.License) string {
+	if license.EntitlementID != "" {
+		return license.EntitlementID
+	}
+	return license.ID
+}
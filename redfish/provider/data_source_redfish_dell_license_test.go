@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to read the licenses of the iDRAC - Positive
+func TestAccRedfishDellLicenseDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceDellLicenseConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_dell_license.licenses", "id"),
+					resource.TestCheckResourceAttrSet("data.redfish_dell_license.licenses", "licenses.#"),
+				),
+			},
+		},
+	})
+}
+
+// Test to read the licenses of an invalid manager - Negative
+func TestAccRedfishDellLicenseDataSource_InvalidManager(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceDellLicenseConfig(creds, `manager_id = "invalid"`),
+				ExpectError: regexp.MustCompile("Error fetching manager"),
+			},
+		},
+	})
+}
+
+// Test to read the licenses with a mocked error - Negative
+func TestAccRedfishDellLicenseDataSource_MockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(getDellManager).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishDataSourceDellLicenseConfig(creds, ""),
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func testAccRedfishDataSourceDellLicenseConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		data "redfish_dell_license" "licenses" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -119,6 +119,13 @@ func (g *DirectoryServiceAuthProviderDatasource) Read(ctx context.Context, req d
 	var plan models.DirectoryServiceAuthProviderDatasource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	api, err := NewConfig(g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
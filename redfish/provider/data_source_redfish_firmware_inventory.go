@@ -115,6 +115,13 @@ func (g *FirmwareInventoryDatasource) Read(ctx context.Context, req datasource.R
 	var plan models.FirmwareInventory
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	api, err := NewConfig(g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
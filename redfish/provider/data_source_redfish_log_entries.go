@@ -0,0 +1,712 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// logSourceSystem identifies the log services of the computer system
+	logSourceSystem = "System"
+	// logSourceManager identifies the log services of the manager
+	logSourceManager = "Manager"
+)
+
+var (
+	_ datasource.DataSource              = &LogEntriesDatasource{}
+	_ datasource.DataSourceWithConfigure = &LogEntriesDatasource{}
+)
+
+// NewLogEntriesDatasource is new datasource for log entries
+func NewLogEntriesDatasource() datasource.DataSource {
+	return &LogEntriesDatasource{}
+}
+
+// LogEntriesDatasource to construct datasource
+type LogEntriesDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *LogEntriesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*LogEntriesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "log_entries"
+}
+
+// Schema implements datasource.DataSource
+func (*LogEntriesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the entries of the log services of the system and the manager," +
+			" such as the System Event Log, the Lifecycle Controller log and the fault list.",
+		Description: "This Terraform datasource is used to query the entries of the log services of the system and the manager," +
+			" such as the System Event Log, the Lifecycle Controller log and the fault list.",
+		Attributes: LogEntriesDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"log_entry_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Filter for the log services and their entries",
+				Description:         "Filter for the log services and their entries",
+				Attributes:          LogEntryFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// LogEntriesDatasourceSchema to define the log entries data-source schema
+func LogEntriesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the log entries data-source",
+			Description:         "ID of the log entries data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the computer system whose log services are read. Defaults to the first system.",
+			Description:         "ID of the computer system whose log services are read. Defaults to the first system.",
+			Optional:            true,
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager whose log services are read. Defaults to the first manager.",
+			Description:         "ID of the manager whose log services are read. Defaults to the first manager.",
+			Optional:            true,
+		},
+		"log_entries": schema.ListNestedAttribute{
+			MarkdownDescription: "Log entries matching the filter, the most recent first.",
+			Description:         "Log entries matching the filter, the most recent first.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: LogEntrySchema(),
+			},
+		},
+	}
+}
+
+// LogEntryFilterSchema to define the log entry filter schema
+func LogEntryFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"sources": schema.ListAttribute{
+			MarkdownDescription: "Owners of the log services read. Accepted values: `System`, `Manager`. Both are read by default.",
+			Description:         "Owners of the log services read. Accepted values: System, Manager. Both are read by default.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(logSourceSystem, logSourceManager)),
+			},
+		},
+		"log_service_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the log services read, for example `Sel`, `Lclog` or `FaultList`. All log services are read by default.",
+			Description:         "IDs of the log services read, for example Sel, Lclog or FaultList. All log services are read by default.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"severities": schema.ListAttribute{
+			MarkdownDescription: "Severities of the entries returned. Accepted values: `OK`, `Warning`, `Critical`.",
+			Description:         "Severities of the entries returned. Accepted values: OK, Warning, Critical.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(
+					string(redfish.OKEventSeverity),
+					string(redfish.WarningEventSeverity),
+					string(redfish.CriticalEventSeverity),
+				)),
+			},
+		},
+		"message_ids": schema.ListAttribute{
+			MarkdownDescription: "Message IDs of the entries returned. Either the full ID, for example `IDRAC.2.8.SYS1003`," +
+				" or the message key alone, for example `SYS1003`, is accepted.",
+			Description: "Message IDs of the entries returned. Either the full ID, for example IDRAC.2.8.SYS1003," +
+				" or the message key alone, for example SYS1003, is accepted.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"since": schema.StringAttribute{
+			MarkdownDescription: "Only entries created at or after this RFC 3339 timestamp are returned, for example `2026-01-02T15:04:05Z`.",
+			Description:         "Only entries created at or after this RFC 3339 timestamp are returned, for example 2026-01-02T15:04:05Z.",
+			Optional:            true,
+		},
+		"until": schema.StringAttribute{
+			MarkdownDescription: "Only entries created at or before this RFC 3339 timestamp are returned.",
+			Description:         "Only entries created at or before this RFC 3339 timestamp are returned.",
+			Optional:            true,
+		},
+		"within": schema.StringAttribute{
+			MarkdownDescription: "Only entries created within this duration before the read are returned, for example `1h` or `30m`.",
+			Description:         "Only entries created within this duration before the read are returned, for example 1h or 30m.",
+			Optional:            true,
+		},
+		"max_count": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of entries returned, the most recent ones are kept.",
+			Description:         "Maximum number of entries returned, the most recent ones are kept.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+// LogEntrySchema to define the log entry schema
+func LogEntrySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the log entry",
+			Description:         "OData ID of the log entry",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the log entry",
+			Description:         "ID of the log entry",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the log entry",
+			Description:         "Name of the log entry",
+			Computed:            true,
+		},
+		"source": schema.StringAttribute{
+			MarkdownDescription: "Owner of the log service of the entry, `System` or `Manager`",
+			Description:         "Owner of the log service of the entry, System or Manager",
+			Computed:            true,
+		},
+		"log_service_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the log service of the entry",
+			Description:         "ID of the log service of the entry",
+			Computed:            true,
+		},
+		"created": schema.StringAttribute{
+			MarkdownDescription: "Time the log entry was created",
+			Description:         "Time the log entry was created",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "Severity of the log entry",
+			Description:         "Severity of the log entry",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Message of the log entry, with the message arguments substituted",
+			Description:         "Message of the log entry, with the message arguments substituted",
+			Computed:            true,
+		},
+		"message_id": schema.StringAttribute{
+			MarkdownDescription: "Message ID of the log entry",
+			Description:         "Message ID of the log entry",
+			Computed:            true,
+		},
+		"message_registry": schema.StringAttribute{
+			MarkdownDescription: "Prefix of the message registry of the message ID",
+			Description:         "Prefix of the message registry of the message ID",
+			Computed:            true,
+		},
+		"message_registry_version": schema.StringAttribute{
+			MarkdownDescription: "Version of the message registry of the message ID",
+			Description:         "Version of the message registry of the message ID",
+			Computed:            true,
+		},
+		"message_key": schema.StringAttribute{
+			MarkdownDescription: "Key of the message in its registry",
+			Description:         "Key of the message in its registry",
+			Computed:            true,
+		},
+		"message_args": schema.ListAttribute{
+			MarkdownDescription: "Arguments of the message",
+			Description:         "Arguments of the message",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"entry_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the log entry",
+			Description:         "Type of the log entry",
+			Computed:            true,
+		},
+		"entry_code": schema.StringAttribute{
+			MarkdownDescription: "Entry code of the SEL entries",
+			Description:         "Entry code of the SEL entries",
+			Computed:            true,
+		},
+		"sensor_type": schema.StringAttribute{
+			MarkdownDescription: "Sensor type of the SEL entries",
+			Description:         "Sensor type of the SEL entries",
+			Computed:            true,
+		},
+		"sensor_number": schema.Int64Attribute{
+			MarkdownDescription: "Sensor number of the SEL entries",
+			Description:         "Sensor number of the SEL entries",
+			Computed:            true,
+		},
+		"resolution": schema.StringAttribute{
+			MarkdownDescription: "Suggested resolution of the log entry",
+			Description:         "Suggested resolution of the log entry",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *LogEntriesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.LogEntriesDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newLogEntryMatcher(plan.LogEntryFilter, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	entries, err := readRedfishLogEntries(api.Service, plan.SystemID.ValueString(), plan.ManagerID.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch log entries", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue("log_entries")
+	plan.LogEntries = entries
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// logEntryMatcher holds the parsed log entry filter
+type logEntryMatcher struct {
+	sources       map[string]bool
+	logServiceIDs map[string]bool
+	severities    map[string]bool
+	messageIDs    map[string]bool
+	since         time.Time
+	until         time.Time
+	maxCount      int
+}
+
+// newLogEntryMatcher parses and validates the filter, the `within` duration being relative to now
+func newLogEntryMatcher(filter *models.LogEntryFilter, now time.Time) (*logEntryMatcher, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	matcher := &logEntryMatcher{}
+	if filter == nil {
+		return matcher, diags
+	}
+
+	toSet := func(values []types.String, fold bool) map[string]bool {
+		if len(values) == 0 {
+			return nil
+		}
+		set := make(map[string]bool, len(values))
+		for _, value := range values {
+			key := value.ValueString()
+			if fold {
+				key = strings.ToLower(key)
+			}
+			set[key] = true
+		}
+		return set
+	}
+	matcher.sources = toSet(filter.Sources, false)
+	matcher.logServiceIDs = toSet(filter.LogServiceIDs, false)
+	matcher.severities = toSet(filter.Severities, false)
+	matcher.messageIDs = toSet(filter.MessageIDs, true)
+	matcher.maxCount = int(filter.MaxCount.ValueInt64())
+
+	filterPath := path.Root("log_entry_filter")
+	if value := filter.Since.ValueString(); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			diags.AddAttributeError(filterPath.AtName("since"), "Invalid timestamp", err.Error())
+		}
+		matcher.since = since
+	}
+	if value := filter.Until.ValueString(); value != "" {
+		until, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			diags.AddAttributeError(filterPath.AtName("until"), "Invalid timestamp", err.Error())
+		}
+		matcher.until = until
+	}
+	if value := filter.Within.ValueString(); value != "" {
+		within, err := time.ParseDuration(value)
+		if err != nil || within <= 0 {
+			diags.AddAttributeError(filterPath.AtName("within"), "Invalid duration",
+				fmt.Sprintf("%q is not a positive duration such as 1h or 30m", value))
+		} else if since := now.Add(-within); since.After(matcher.since) {
+			matcher.since = since
+		}
+	}
+	return matcher, diags
+}
+
+// matchSource reports whether the log services of the system or the manager are read
+func (m *logEntryMatcher) matchSource(source string) bool {
+	return m.sources == nil || m.sources[source]
+}
+
+// matchService reports whether the entries of the log service are read
+func (m *logEntryMatcher) matchService(logServiceID string) bool {
+	return m.logServiceIDs == nil || m.logServiceIDs[logServiceID]
+}
+
+// matchEntry reports whether the entry passes the filter. Entries whose creation time is missing or
+// cannot be parsed only pass when no time window is set.
+func (m *logEntryMatcher) matchEntry(entry *redfish.LogEntry) bool {
+	if m.severities != nil && !m.severities[string(entry.Severity)] {
+		return false
+	}
+	if m.messageIDs != nil {
+		_, _, key := parseMessageID(entry.MessageID)
+		if !m.messageIDs[strings.ToLower(entry.MessageID)] && !m.messageIDs[strings.ToLower(key)] {
+			return false
+		}
+	}
+	if m.since.IsZero() && m.until.IsZero() {
+		return true
+	}
+	created, err := time.Parse(time.RFC3339, entry.Created)
+	if err != nil {
+		return false
+	}
+	if !m.since.IsZero() && created.Before(m.since) {
+		return false
+	}
+	return m.until.IsZero() || !created.After(m.until)
+}
+
+// timeQuery returns the $filter query of the time window, or an empty string when no window is set
+func (m *logEntryMatcher) timeQuery() string {
+	var conditions []string
+	if !m.since.IsZero() {
+		conditions = append(conditions, "Created ge "+m.since.UTC().Format(time.RFC3339))
+	}
+	if !m.until.IsZero() {
+		conditions = append(conditions, "Created le "+m.until.UTC().Format(time.RFC3339))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "?$filter=" + url.PathEscape(strings.Join(conditions, " and "))
+}
+
+// readRedfishLogEntries reads the matching entries of the log services of the system and the manager
+func readRedfishLogEntries(service *gofish.Service, systemID, managerID string, filter *logEntryMatcher) ([]models.LogEntry, error) {
+	type sourceServices struct {
+		source   string
+		services func() ([]*redfish.LogService, error)
+	}
+	sources := []sourceServices{
+		{logSourceSystem, func() ([]*redfish.LogService, error) {
+			system, err := getSystemResource(service, systemID)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching computer system: %w", err)
+			}
+			return system.LogServices()
+		}},
+		{logSourceManager, func() ([]*redfish.LogService, error) {
+			manager, err := getManagerResource(service, managerID)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching manager: %w", err)
+			}
+			return manager.LogServices()
+		}},
+	}
+
+	type matchedEntry struct {
+		entry        *redfish.LogEntry
+		source       string
+		logServiceID string
+	}
+	var matched []matchedEntry
+	for _, source := range sources {
+		if !filter.matchSource(source.source) {
+			continue
+		}
+		logServices, err := source.services()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching %s log services: %w", strings.ToLower(source.source), err)
+		}
+		for _, logService := range logServices {
+			if !filter.matchService(logService.ID) {
+				continue
+			}
+			entries, err := listLogServiceEntries(service.GetClient(), logService, filter,
+				service.ProtocolFeaturesSupported.FilterQuery)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching the entries of the %s log service: %w", logService.ID, err)
+			}
+			for _, entry := range entries {
+				matched = append(matched, matchedEntry{entry, source.source, logService.ID})
+			}
+		}
+	}
+
+	// most recent first, entries without a valid creation time last
+	sort.SliceStable(matched, func(i, j int) bool {
+		ti, erri := time.Parse(time.RFC3339, matched[i].entry.Created)
+		tj, errj := time.Parse(time.RFC3339, matched[j].entry.Created)
+		if erri != nil || errj != nil {
+			return erri == nil && errj != nil
+		}
+		return ti.After(tj)
+	})
+	if filter.maxCount > 0 && len(matched) > filter.maxCount {
+		matched = matched[:filter.maxCount]
+	}
+
+	logEntries := make([]models.LogEntry, 0, len(matched))
+	for _, item := range matched {
+		logEntries = append(logEntries, newLogEntryModel(item.entry, item.source, item.logServiceID))
+	}
+	return logEntries, nil
+}
+
+// listLogServiceEntries reads the entries of the log service that pass the filter. The time window is sent
+// to the BMC as a $filter query when filterQuery is set, and the entries are read again without it when the
+// BMC rejects the query. Paging stops once max_count entries have been matched, as long as the BMC has
+// listed them most recent first, since no later page can then hold a more recent entry.
+func listLogServiceEntries(client common.Client, logService *redfish.LogService, filter *logEntryMatcher,
+	filterQuery bool,
+) ([]*redfish.LogEntry, error) {
+	link, err := logServiceEntriesLink(logService)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*redfish.LogEntry
+	var previous time.Time
+	sorted := true
+	visit := func(member json.RawMessage) (bool, error) {
+		entry := &redfish.LogEntry{}
+		if err := json.Unmarshal(member, entry); err != nil {
+			return false, err
+		}
+		if !filter.matchEntry(entry) {
+			return true, nil
+		}
+		entries = append(entries, entry)
+
+		created, err := time.Parse(time.RFC3339, entry.Created)
+		if err != nil || (!previous.IsZero() && created.After(previous)) {
+			sorted = false
+		}
+		previous = created
+		return filter.maxCount == 0 || !sorted || len(entries) < filter.maxCount, nil
+	}
+
+	if query := filter.timeQuery(); filterQuery && query != "" {
+		if err := walkLogServiceMembers(client, link+query, visit); err == nil {
+			return entries, nil
+		}
+		entries, previous, sorted = nil, time.Time{}, true
+	}
+	if err := walkLogServiceMembers(client, link, visit); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// listLogServiceMembers reads all the pages of the entries collection of the log service and returns the
+// entries as sent by the BMC.
+func listLogServiceMembers(client common.Client, logService *redfish.LogService) ([]json.RawMessage, error) {
+	link, err := logServiceEntriesLink(logService)
+	if err != nil {
+		return nil, err
+	}
+	var members []json.RawMessage
+	err = walkLogServiceMembers(client, link, func(member json.RawMessage) (bool, error) {
+		members = append(members, member)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// logServiceEntriesLink returns the link to the entries collection of the log service
+func logServiceEntriesLink(logService *redfish.LogService) (string, error) {
+	rawDataBytes, err := dell.GetRawDataBytes(logService)
+	if err != nil {
+		return "", err
+	}
+	var links struct {
+		Entries common.Link
+	}
+	if err := json.Unmarshal(rawDataBytes, &links); err != nil {
+		return "", err
+	}
+	if links.Entries.String() == "" {
+		return "", fmt.Errorf("the %s log service has no entries collection", logService.ID)
+	}
+	return links.Entries.String(), nil
+}
+
+// walkLogServiceMembers reads the pages of the entries collection at the link and passes each entry, as sent
+// by the BMC, to visit until it returns false. The members are used as returned in the collection, and only
+// fetched one by one when the BMC returns links alone.
+func walkLogServiceMembers(client common.Client, link string, visit func(json.RawMessage) (bool, error)) error {
+	for link != "" {
+		var page struct {
+			Members  []json.RawMessage `json:"Members"`
+			NextLink string            `json:"Members@odata.nextLink"`
+		}
+		if err := getRedfishJSON(client, link, &page); err != nil {
+			return err
+		}
+
+		for _, member := range page.Members {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(member, &fields); err != nil {
+				return err
+			}
+			if _, ok := fields["Id"]; !ok {
+				var ref common.Link
+				if err := json.Unmarshal(member, &ref); err != nil {
+					return err
+				}
+				if err := getRedfishJSON(client, ref.String(), &member); err != nil {
+					return err
+				}
+			}
+			next, err := visit(member)
+			if err != nil || !next {
+				return err
+			}
+		}
+		link = page.NextLink
+	}
+	return nil
+}
+
+// getRedfishJSON decodes the body of a GET request on the URI into out
+func getRedfishJSON(client common.Client, uri string, out interface{}) error {
+	resp, err := client.Get(uri)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// newLogEntryModel converts a log entry to its tfsdk model
+func newLogEntryModel(entry *redfish.LogEntry, source, logServiceID string) models.LogEntry {
+	registry, version, key := parseMessageID(entry.MessageID)
+	args := make([]types.String, 0, len(entry.MessageArgs))
+	for _, arg := range entry.MessageArgs {
+		args = append(args, types.StringValue(arg))
+	}
+	return models.LogEntry{
+		ODataID:                types.StringValue(entry.ODataID),
+		ID:                     types.StringValue(entry.ID),
+		Name:                   types.StringValue(entry.Name),
+		Source:                 types.StringValue(source),
+		LogServiceID:           types.StringValue(logServiceID),
+		Created:                types.StringValue(entry.Created),
+		Severity:               types.StringValue(string(entry.Severity)),
+		Message:                types.StringValue(formatRegistryMessage(entry.Message, entry.MessageArgs)),
+		MessageID:              types.StringValue(entry.MessageID),
+		MessageRegistry:        types.StringValue(registry),
+		MessageRegistryVersion: types.StringValue(version),
+		MessageKey:             types.StringValue(key),
+		MessageArgs:            args,
+		EntryType:              types.StringValue(string(entry.EntryType)),
+		EntryCode:              types.StringValue(string(entry.EntryCode)),
+		SensorType:             types.StringValue(string(entry.SensorType)),
+		SensorNumber:           types.Int64Value(int64(entry.SensorNumber)),
+		Resolution:             types.StringValue(entry.Resolution),
+	}
+}
+
+// parseMessageID splits a message ID of the form RegistryPrefix.MajorVersion.MinorVersion.MessageKey.
+// IDs without a registry, such as the ones of some SEL entries, are returned as the key alone.
+func parseMessageID(messageID string) (registry, version, key string) {
+	parts := strings.Split(messageID, ".")
+	if len(parts) < 4 {
+		return "", "", parts[len(parts)-1]
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-3], "."), parts[n-3] + "." + parts[n-2], parts[n-1]
+}
+
+// formatRegistryMessage substitutes the %1, %2... placeholders of a registry message with its arguments.
+// Higher indexes are replaced first so that %1 does not match the start of %10.
+func formatRegistryMessage(message string, args []string) string {
+	for i := len(args); i >= 1; i-- {
+		message = strings.ReplaceAll(message, "%"+strconv.Itoa(i), args[i-1])
+	}
+	return message
+}
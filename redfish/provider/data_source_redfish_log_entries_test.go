@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for log entries DataSource
+func TestAccRedfishLogEntriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceLogEntriesConfig(creds, `
+				log_entry_filter {
+					sources         = ["Manager"]
+					log_service_ids = ["Sel"]
+					max_count       = 5
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_log_entries.logs", "log_entries.#"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceLogEntriesConfig(creds, `
+				log_entry_filter {
+					severities = ["Critical"]
+					within     = "1h"
+				}`),
+			},
+		},
+	})
+}
+
+// Test case for log entries DataSource with an invalid filter - Negative
+func TestAccRedfishLogEntriesDataSource_InvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceLogEntriesConfig(creds, `
+				log_entry_filter {
+					within = "one hour"
+				}`),
+				ExpectError: regexp.MustCompile("Invalid duration"),
+			},
+			{
+				Config: testAccRedfishDataSourceLogEntriesConfig(creds, `
+				log_entry_filter {
+					severities = ["Fatal"]
+				}`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+// Test case for log entries DataSource with Mock err
+func TestAccRedfishLogEntriesDataSource_MockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(listLogServiceEntries).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishDataSourceLogEntriesConfig(creds, ""),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestLogEntryMatcher(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	matcher, diags := newLogEntryMatcher(&models.LogEntryFilter{
+		Severities: []types.String{types.StringValue("Critical")},
+		MessageIDs: []types.String{types.StringValue("sys1003")},
+		Within:     types.StringValue("1h"),
+	}, now)
+	if diags.HasError() {
+		t.Fatalf("Expected no error, got %v", diags)
+	}
+
+	tests := []struct {
+		name  string
+		entry redfish.LogEntry
+		want  bool
+	}{
+		{"match", redfish.LogEntry{Severity: "Critical", MessageID: "IDRAC.2.8.SYS1003", Created: "2026-01-02T11:30:00Z"}, true},
+		{"full message id", redfish.LogEntry{Severity: "Critical", MessageID: "SYS1003", Created: "2026-01-02T11:30:00+00:00"}, true},
+		{"severity", redfish.LogEntry{Severity: "Warning", MessageID: "IDRAC.2.8.SYS1003", Created: "2026-01-02T11:30:00Z"}, false},
+		{"message id", redfish.LogEntry{Severity: "Critical", MessageID: "IDRAC.2.8.SYS1001", Created: "2026-01-02T11:30:00Z"}, false},
+		{"too old", redfish.LogEntry{Severity: "Critical", MessageID: "IDRAC.2.8.SYS1003", Created: "2026-01-02T10:30:00Z"}, false},
+		{"no creation time", redfish.LogEntry{Severity: "Critical", MessageID: "IDRAC.2.8.SYS1003"}, false},
+		{"invalid creation time", redfish.LogEntry{Severity: "Critical", MessageID: "IDRAC.2.8.SYS1003", Created: "now"}, false},
+	}
+	for _, tt := range tests {
+		if got := matcher.matchEntry(&tt.entry); got != tt.want {
+			t.Errorf("%s: matchEntry() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// without a time window, entries without a creation time are kept
+	matcher, _ = newLogEntryMatcher(&models.LogEntryFilter{}, now)
+	if !matcher.matchEntry(&redfish.LogEntry{Severity: "Critical"}) {
+		t.Error("Expected an entry without a creation time to match a filter without a time window")
+	}
+	if query := matcher.timeQuery(); query != "" {
+		t.Errorf("Expected no $filter query without a time window, got %q", query)
+	}
+
+	if _, diags := newLogEntryMatcher(&models.LogEntryFilter{Within: types.StringValue("-1h")}, now); !diags.HasError() {
+		t.Error("Expected an error for a negative duration, got none")
+	}
+	if _, diags := newLogEntryMatcher(&models.LogEntryFilter{Since: types.StringValue("yesterday")}, now); !diags.HasError() {
+		t.Error("Expected an error for an invalid timestamp, got none")
+	}
+}
+
+func TestListLogServiceEntries(t *testing.T) {
+	page := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}
+	logService := &redfish.LogService{}
+	if err := json.Unmarshal([]byte(`{"Id": "Sel", "Entries": {"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries"}}`),
+		logService); err != nil {
+		t.Fatal(err)
+	}
+	firstPage := page(`{"Members": [
+		{"Id": "3", "Created": "2026-01-02T11:50:00Z"},
+		{"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries/2"}
+	], "Members@odata.nextLink": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries?$skip=2"}`)
+	secondEntry := page(`{"Id": "2", "Created": "2026-01-02T11:40:00Z"}`)
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	// paging stops once max_count entries have been read most recent first, the entries link being followed
+	client := &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{http.MethodGet: {firstPage, secondEntry}}}
+	matcher, _ := newLogEntryMatcher(&models.LogEntryFilter{MaxCount: types.Int64Value(2)}, now)
+	entries, err := listLogServiceEntries(client, logService, matcher, false)
+	if err != nil || len(entries) != 2 || len(client.CapturedCalls()) != 2 {
+		t.Fatalf("Expected 2 entries after 2 reads, got %d entries and %v after %d reads", len(entries), err, len(client.CapturedCalls()))
+	}
+	if url := client.CapturedCalls()[0].URL; url != "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries" {
+		t.Fatalf("Expected the entries link of the log service to be read, got %s", url)
+	}
+
+	// the time window is sent as a $filter query, and the entries are read again without it when it is rejected
+	client = &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{http.MethodGet: {
+		&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{}`))},
+		page(`{"Members": [{"Id": "1", "Created": "2026-01-02T10:00:00Z"}, {"Id": "4", "Created": "2026-01-02T11:55:00Z"}]}`),
+	}}}
+	matcher, _ = newLogEntryMatcher(&models.LogEntryFilter{Within: types.StringValue("1h")}, now)
+	entries, err = listLogServiceEntries(client, logService, matcher, true)
+	if err != nil || len(entries) != 1 || entries[0].ID != "4" {
+		t.Fatalf("Expected the entry of the last hour, got %v and %v", entries, err)
+	}
+	calls := client.CapturedCalls()
+	if len(calls) != 2 || !strings.HasSuffix(calls[0].URL, "/Entries?$filter=Created%20ge%202026-01-02T11:00:00Z") ||
+		strings.Contains(calls[1].URL, "$filter") {
+		t.Fatalf("Expected a filtered read followed by an unfiltered one, got %v", calls)
+	}
+}
+
+func TestParseMessageID(t *testing.T) {
+	tests := []struct {
+		messageID              string
+		registry, version, key string
+	}{
+		{"IDRAC.2.8.SYS1003", "IDRAC", "2.8", "SYS1003"},
+		{"Base.1.16.Success", "Base", "1.16", "Success"},
+		{"SEL9901", "", "", "SEL9901"},
+		{"", "", "", ""},
+	}
+	for _, tt := range tests {
+		registry, version, key := parseMessageID(tt.messageID)
+		if registry != tt.registry || version != tt.version || key != tt.key {
+			t.Errorf("parseMessageID(%q) = %q, %q, %q, want %q, %q, %q", tt.messageID, registry, version, key, tt.registry, tt.version, tt.key)
+		}
+	}
+
+	message := formatRegistryMessage("The %1 of %2 is %10.", []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"})
+	if message != "The a of b is j." {
+		t.Errorf("formatRegistryMessage() = %q", message)
+	}
+}
+
+func testAccRedfishDataSourceLogEntriesConfig(testingInfo TestingServerCredentials, filter string) string {
+	return fmt.Sprintf(`
+		data "redfish_log_entries" "logs" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		filter,
+	)
+}
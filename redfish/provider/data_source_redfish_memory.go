@@ -0,0 +1,438 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &MemoryDatasource{}
+	_ datasource.DataSourceWithConfigure = &MemoryDatasource{}
+)
+
+// NewMemoryDatasource is new datasource for the memory of the computer systems
+func NewMemoryDatasource() datasource.DataSource {
+	return &MemoryDatasource{}
+}
+
+// MemoryDatasource to construct datasource
+type MemoryDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *MemoryDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*MemoryDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "memory"
+}
+
+// Schema implements datasource.DataSource
+func (*MemoryDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the memory of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the memory of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: MemoryDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"memory_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Memory filter for systems and memory",
+				Description:         "Memory filter for systems and memory",
+				Attributes:          MemoryFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *MemoryDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.MemoryDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishMemory(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishMemory populates the memory of the filtered systems in the datasource model
+func readDatasourceRedfishMemory(service *gofish.Service, d models.MemoryDatasource) (models.MemoryDatasource, diag.Diagnostics) {
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	var filters []models.MemorySystemFilter
+	if d.MemoryFilter != nil {
+		filters = d.MemoryFilter.Systems
+	}
+	var diags diag.Diagnostics
+	d.Memory, diags = systemInventory[*redfish.Memory, models.Memory, models.MemorySystemFilter]{
+		collection: "memory",
+		memberName: "memory",
+		members:    (*redfish.ComputerSystem).Memory,
+		memberID:   func(module *redfish.Memory) string { return module.ID },
+		filterIDs: func(filter models.MemorySystemFilter) (types.String, []types.String) {
+			return filter.SystemID, filter.MemoryIDs
+		},
+		newMember: newMemory,
+	}.read(service, filters)
+	return d, diags
+}
+
+// newMemory converts redfish.Memory to models.Memory
+func newMemory(systemID string, memory *redfish.Memory) models.Memory {
+	memoryExtended, _ := dell.Memory(memory)
+	return models.Memory{
+		ODataID:           types.StringValue(memory.ODataID),
+		ID:                types.StringValue(memory.ID),
+		SystemID:          types.StringValue(systemID),
+		Name:              types.StringValue(memory.Name),
+		Description:       types.StringValue(memory.Description),
+		DeviceLocator:     types.StringValue(memory.DeviceLocator),
+		CapacityMiB:       types.Int64Value(int64(memory.CapacityMiB)),
+		Manufacturer:      types.StringValue(memory.Manufacturer),
+		PartNumber:        types.StringValue(memory.PartNumber),
+		SerialNumber:      types.StringValue(memory.SerialNumber),
+		RankCount:         types.Int64Value(int64(memory.RankCount)),
+		MemoryDeviceType:  types.StringValue(string(memory.MemoryDeviceType)),
+		MemoryType:        types.StringValue(string(memory.MemoryType)),
+		BaseModuleType:    types.StringValue(string(memory.BaseModuleType)),
+		OperatingSpeedMhz: types.Int64Value(int64(memory.OperatingSpeedMhz)),
+		ErrorCorrection:   types.StringValue(string(memory.ErrorCorrection)),
+		MemoryLocation: models.MemoryLocation{
+			Socket:           types.Int64Value(int64(memory.MemoryLocation.Socket)),
+			MemoryController: types.Int64Value(int64(memory.MemoryLocation.MemoryController)),
+			Channel:          types.Int64Value(int64(memory.MemoryLocation.Channel)),
+			Slot:             types.Int64Value(int64(memory.MemoryLocation.Slot)),
+		},
+		Status: newStatus(memory.Status),
+		Oem: models.MemoryOEM{
+			Dell: models.MemoryOEMDell{
+				DellMemory: newDellMemory(memoryExtended.Oem.Dell.DellMemory),
+			},
+		},
+	}
+}
+
+// newDellMemory converts dell.DellMemory to models.DellMemory
+func newDellMemory(input dell.DellMemory) models.DellMemory {
+	return models.DellMemory{
+		BankLabel:                           types.StringValue(input.BankLabel),
+		LastSystemInventoryTime:             types.StringValue(input.LastSystemInventoryTime),
+		LastUpdateTime:                      types.StringValue(input.LastUpdateTime),
+		ManufactureDate:                     types.StringValue(input.ManufactureDate),
+		MemoryTechnology:                    types.StringValue(input.MemoryTechnology),
+		Model:                               types.StringValue(input.Model),
+		RemainingRatedWriteEndurancePercent: types.Int64Value(input.RemainingRatedWriteEndurancePercent),
+		SystemEraseCapability:               types.StringValue(input.SystemEraseCapability),
+	}
+}
+
+// MemoryFilterSchema to construct schema of memory filter
+func MemoryFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"systems": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Filter for systems and memory",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"system_id": schema.StringAttribute{
+						Required:    true,
+						Description: "Filter for systems",
+					},
+					"memory_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Filter for memory",
+					},
+				},
+			},
+		},
+	}
+}
+
+// MemoryDatasourceSchema to define the memory data-source schema
+func MemoryDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the memory data-source",
+			Description:         "ID of the memory data-source",
+			Computed:            true,
+		},
+		"memory": schema.ListNestedAttribute{
+			MarkdownDescription: "List of memory fetched.",
+			Description:         "List of memory fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: MemorySchema(),
+			},
+		},
+	}
+}
+
+// MemorySchema to define the memory schema
+func MemorySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the memory",
+			Description:         "OData ID of the memory",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the memory",
+			Description:         "ID of the memory",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the computer system of the memory",
+			Description:         "ID of the computer system of the memory",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the memory",
+			Description:         "Name of the memory",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the memory",
+			Description:         "Description of the memory",
+			Computed:            true,
+		},
+		"device_locator": schema.StringAttribute{
+			MarkdownDescription: "Location of the memory, such as the label of its DIMM slot",
+			Description:         "Location of the memory, such as the label of its DIMM slot",
+			Computed:            true,
+		},
+		"capacity_mib": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the memory in MiB",
+			Description:         "Capacity of the memory in MiB",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the memory",
+			Description:         "Manufacturer of the memory",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the memory",
+			Description:         "Part number of the memory",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the memory",
+			Description:         "Serial number of the memory",
+			Computed:            true,
+		},
+		"rank_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of ranks of the memory",
+			Description:         "Number of ranks of the memory",
+			Computed:            true,
+		},
+		"memory_device_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the memory device, such as DDR4 or DDR5",
+			Description:         "Type of the memory device, such as DDR4 or DDR5",
+			Computed:            true,
+		},
+		"memory_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the memory, such as DRAM",
+			Description:         "Type of the memory, such as DRAM",
+			Computed:            true,
+		},
+		"base_module_type": schema.StringAttribute{
+			MarkdownDescription: "Base module type of the memory, such as RDIMM or LRDIMM",
+			Description:         "Base module type of the memory, such as RDIMM or LRDIMM",
+			Computed:            true,
+		},
+		"operating_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Operating speed of the memory in MHz or MT/s",
+			Description:         "Operating speed of the memory in MHz or MT/s",
+			Computed:            true,
+		},
+		"error_correction": schema.StringAttribute{
+			MarkdownDescription: "Error correction scheme supported by the memory",
+			Description:         "Error correction scheme supported by the memory",
+			Computed:            true,
+		},
+		"memory_location": schema.SingleNestedAttribute{
+			MarkdownDescription: "Location of the memory in the system",
+			Description:         "Location of the memory in the system",
+			Computed:            true,
+			Attributes:          MemoryLocationSchema(),
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the memory",
+			Description:         "The status and health of the memory",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+		"oem": schema.SingleNestedAttribute{
+			MarkdownDescription: "The OEM extension of the memory",
+			Description:         "The OEM extension of the memory",
+			Computed:            true,
+			Attributes:          MemoryOEMSchema(),
+		},
+	}
+}
+
+// MemoryLocationSchema to define the memory location schema
+func MemoryLocationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"socket": schema.Int64Attribute{
+			MarkdownDescription: "Socket number of the memory",
+			Description:         "Socket number of the memory",
+			Computed:            true,
+		},
+		"memory_controller": schema.Int64Attribute{
+			MarkdownDescription: "Memory controller number of the memory",
+			Description:         "Memory controller number of the memory",
+			Computed:            true,
+		},
+		"channel": schema.Int64Attribute{
+			MarkdownDescription: "Channel number of the memory",
+			Description:         "Channel number of the memory",
+			Computed:            true,
+		},
+		"slot": schema.Int64Attribute{
+			MarkdownDescription: "Slot number of the memory",
+			Description:         "Slot number of the memory",
+			Computed:            true,
+		},
+	}
+}
+
+// MemoryOEMSchema to define the memory OEM schema
+func MemoryOEMSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dell": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dell OEM data of the memory",
+			Description:         "Dell OEM data of the memory",
+			Computed:            true,
+			Attributes:          MemoryOEMDellSchema(),
+		},
+	}
+}
+
+// MemoryOEMDellSchema to define the Dell memory OEM schema
+func MemoryOEMDellSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dell_memory": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dell memory data",
+			Description:         "Dell memory data",
+			Computed:            true,
+			Attributes:          DellMemorySchema(),
+		},
+	}
+}
+
+// DellMemorySchema to define the Dell memory schema
+func DellMemorySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"bank_label": schema.StringAttribute{
+			MarkdownDescription: "Label of the memory bank",
+			Description:         "Label of the memory bank",
+			Computed:            true,
+		},
+		"last_system_inventory_time": schema.StringAttribute{
+			MarkdownDescription: "Time of the last system inventory",
+			Description:         "Time of the last system inventory",
+			Computed:            true,
+		},
+		"last_update_time": schema.StringAttribute{
+			MarkdownDescription: "Time of the last update of the memory data",
+			Description:         "Time of the last update of the memory data",
+			Computed:            true,
+		},
+		"manufacture_date": schema.StringAttribute{
+			MarkdownDescription: "Manufacture date of the memory",
+			Description:         "Manufacture date of the memory",
+			Computed:            true,
+		},
+		"memory_technology": schema.StringAttribute{
+			MarkdownDescription: "Technology of the memory",
+			Description:         "Technology of the memory",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the memory",
+			Description:         "Model of the memory",
+			Computed:            true,
+		},
+		"remaining_rated_write_endurance_percent": schema.Int64Attribute{
+			MarkdownDescription: "Remaining rated write endurance of the memory in percent",
+			Description:         "Remaining rated write endurance of the memory in percent",
+			Computed:            true,
+		},
+		"system_erase_capability": schema.StringAttribute{
+			MarkdownDescription: "System erase capability of the memory",
+			Description:         "System erase capability of the memory",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to fetch the memory of the computer systems - Positive
+func TestAccRedfishMemoryDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_memory.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceMemoryConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "memory.#"),
+					resource.TestCheckResourceAttrSet(dsName, "memory.0.odata_id"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceMemoryConfig(creds, `
+				memory_filter {
+					systems = [
+						{
+							system_id = "System.Embedded.1"
+							memory_ids = ["DIMM.Socket.A1"]
+						}
+					]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "memory.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "memory.0.id", "DIMM.Socket.A1"),
+					resource.TestCheckResourceAttr(dsName, "memory.0.system_id", "System.Embedded.1"),
+				),
+			},
+		},
+	})
+}
+
+// Test to fetch the memory with invalid filters - Negative
+func TestAccRedfishMemoryDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceMemoryConfig(creds, `
+				memory_filter {
+					systems = [{ system_id = "System.Invalid.1" }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered system ids are not valid*.`),
+			},
+			{
+				Config: testAccRedfishDataSourceMemoryConfig(creds, `
+				memory_filter {
+					systems = [{ system_id = "System.Embedded.1", memory_ids = ["Invalid.1"] }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered memory ids are not valid*.`),
+			},
+		},
+	})
+}
+
+func testAccRedfishDataSourceMemoryConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_memory" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &PCIeDevicesDatasource{}
+	_ datasource.DataSourceWithConfigure = &PCIeDevicesDatasource{}
+)
+
+// NewPCIeDevicesDatasource is new datasource for the PCIe devices of the computer systems
+func NewPCIeDevicesDatasource() datasource.DataSource {
+	return &PCIeDevicesDatasource{}
+}
+
+// PCIeDevicesDatasource to construct datasource
+type PCIeDevicesDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *PCIeDevicesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*PCIeDevicesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "pcie_devices"
+}
+
+// Schema implements datasource.DataSource
+func (*PCIeDevicesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the PCIe devices of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the PCIe devices of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: PCIeDevicesDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"pcie_device_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "PCIe device filter for systems and PCIe devices",
+				Description:         "PCIe device filter for systems and PCIe devices",
+				Attributes:          PCIeDeviceFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *PCIeDevicesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.PCIeDevicesDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishPCIeDevices(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishPCIeDevices populates the PCIe devices of the filtered systems in the datasource model
+func readDatasourceRedfishPCIeDevices(service *gofish.Service, d models.PCIeDevicesDatasource) (models.PCIeDevicesDatasource, diag.Diagnostics) {
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	var filters []models.PCIeDeviceSystemFilter
+	if d.PCIeDeviceFilter != nil {
+		filters = d.PCIeDeviceFilter.Systems
+	}
+	var diags diag.Diagnostics
+	d.PCIeDevices, diags = systemInventory[*redfish.PCIeDevice, models.PCIeDevice, models.PCIeDeviceSystemFilter]{
+		collection: "PCIe devices",
+		memberName: "PCIe device",
+		members:    (*redfish.ComputerSystem).PCIeDevices,
+		memberID:   func(device *redfish.PCIeDevice) string { return device.ID },
+		filterIDs: func(filter models.PCIeDeviceSystemFilter) (types.String, []types.String) {
+			return filter.SystemID, filter.PCIeDeviceIDs
+		},
+		newMember: newPCIeDevice,
+	}.read(service, filters)
+	return d, diags
+}
+
+// newPCIeDevice converts redfish.PCIeDevice to models.PCIeDevice
+func newPCIeDevice(systemID string, device *redfish.PCIeDevice) models.PCIeDevice {
+	return models.PCIeDevice{
+		ODataID:         types.StringValue(device.ODataID),
+		ID:              types.StringValue(device.ID),
+		SystemID:        types.StringValue(systemID),
+		Name:            types.StringValue(device.Name),
+		Description:     types.StringValue(device.Description),
+		DeviceType:      types.StringValue(string(device.DeviceType)),
+		Manufacturer:    types.StringValue(device.Manufacturer),
+		Model:           types.StringValue(device.Model),
+		PartNumber:      types.StringValue(device.PartNumber),
+		SerialNumber:    types.StringValue(device.SerialNumber),
+		SKU:             types.StringValue(device.SKU),
+		AssetTag:        types.StringValue(device.AssetTag),
+		FirmwareVersion: types.StringValue(device.FirmwareVersion),
+		PCIeInterface: models.PCIeInterface{
+			PCIeType:    types.StringValue(string(device.PCIeInterface.PCIeType)),
+			MaxPCIeType: types.StringValue(string(device.PCIeInterface.MaxPCIeType)),
+			LanesInUse:  types.Int64Value(int64(device.PCIeInterface.LanesInUse)),
+			MaxLanes:    types.Int64Value(int64(device.PCIeInterface.MaxLanes)),
+		},
+		Status: newStatus(device.Status),
+	}
+}
+
+// PCIeDeviceFilterSchema to construct schema of PCIe device filter
+func PCIeDeviceFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"systems": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Filter for systems and PCIe devices",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"system_id": schema.StringAttribute{
+						Required:    true,
+						Description: "Filter for systems",
+					},
+					"pcie_device_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Filter for PCIe devices",
+					},
+				},
+			},
+		},
+	}
+}
+
+// PCIeDevicesDatasourceSchema to define the PCIe devices data-source schema
+func PCIeDevicesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the PCIe devices data-source",
+			Description:         "ID of the PCIe devices data-source",
+			Computed:            true,
+		},
+		"pcie_devices": schema.ListNestedAttribute{
+			MarkdownDescription: "List of PCIe devices fetched.",
+			Description:         "List of PCIe devices fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: PCIeDeviceSchema(),
+			},
+		},
+	}
+}
+
+// PCIeDeviceSchema to define the PCIe device schema
+func PCIeDeviceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the PCIe device",
+			Description:         "OData ID of the PCIe device",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the PCIe device",
+			Description:         "ID of the PCIe device",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the computer system of the PCIe device",
+			Description:         "ID of the computer system of the PCIe device",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the PCIe device",
+			Description:         "Name of the PCIe device",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the PCIe device",
+			Description:         "Description of the PCIe device",
+			Computed:            true,
+		},
+		"device_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the PCIe device, such as SingleFunction or MultiFunction",
+			Description:         "Type of the PCIe device, such as SingleFunction or MultiFunction",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the PCIe device",
+			Description:         "Manufacturer of the PCIe device",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the PCIe device",
+			Description:         "Model of the PCIe device",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the PCIe device",
+			Description:         "Part number of the PCIe device",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the PCIe device",
+			Description:         "Serial number of the PCIe device",
+			Computed:            true,
+		},
+		"sku": schema.StringAttribute{
+			MarkdownDescription: "SKU of the PCIe device",
+			Description:         "SKU of the PCIe device",
+			Computed:            true,
+		},
+		"asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the PCIe device",
+			Description:         "Asset tag of the PCIe device",
+			Computed:            true,
+		},
+		"firmware_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version of the PCIe device",
+			Description:         "Firmware version of the PCIe device",
+			Computed:            true,
+		},
+		"pcie_interface": schema.SingleNestedAttribute{
+			MarkdownDescription: "PCIe interface of the device",
+			Description:         "PCIe interface of the device",
+			Computed:            true,
+			Attributes:          PCIeInterfaceSchema(),
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the PCIe device",
+			Description:         "The status and health of the PCIe device",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// PCIeInterfaceSchema to define the PCIe interface schema
+func PCIeInterfaceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"pcie_type": schema.StringAttribute{
+			MarkdownDescription: "PCIe generation in use, such as Gen4",
+			Description:         "PCIe generation in use, such as Gen4",
+			Computed:            true,
+		},
+		"max_pcie_type": schema.StringAttribute{
+			MarkdownDescription: "Highest PCIe generation supported by the device",
+			Description:         "Highest PCIe generation supported by the device",
+			Computed:            true,
+		},
+		"lanes_in_use": schema.Int64Attribute{
+			MarkdownDescription: "Number of PCIe lanes in use",
+			Description:         "Number of PCIe lanes in use",
+			Computed:            true,
+		},
+		"max_lanes": schema.Int64Attribute{
+			MarkdownDescription: "Number of PCIe lanes supported by the device",
+			Description:         "Number of PCIe lanes supported by the device",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to fetch the PCIe devices of the computer systems - Positive
+func TestAccRedfishPCIeDevicesDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_pcie_devices.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourcePCIeDevicesConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "pcie_devices.#"),
+					resource.TestCheckResourceAttrSet(dsName, "pcie_devices.0.odata_id"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourcePCIeDevicesConfig(creds, `
+				pcie_device_filter {
+					systems = [
+						{
+							system_id = "System.Embedded.1"
+							pcie_device_ids = ["0-31"]
+						}
+					]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "pcie_devices.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "pcie_devices.0.id", "0-31"),
+					resource.TestCheckResourceAttr(dsName, "pcie_devices.0.system_id", "System.Embedded.1"),
+				),
+			},
+		},
+	})
+}
+
+// Test to fetch the PCIe devices with invalid filters - Negative
+func TestAccRedfishPCIeDevicesDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourcePCIeDevicesConfig(creds, `
+				pcie_device_filter {
+					systems = [{ system_id = "System.Invalid.1" }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered system ids are not valid*.`),
+			},
+			{
+				Config: testAccRedfishDataSourcePCIeDevicesConfig(creds, `
+				pcie_device_filter {
+					systems = [{ system_id = "System.Embedded.1", pcie_device_ids = ["Invalid.1"] }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered PCIe device ids are not valid*.`),
+			},
+		},
+	})
+}
+
+func testAccRedfishDataSourcePCIeDevicesConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_pcie_devices" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -0,0 +1,461 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &ProcessorsDatasource{}
+	_ datasource.DataSourceWithConfigure = &ProcessorsDatasource{}
+)
+
+// NewProcessorsDatasource is new datasource for the processors of the computer systems
+func NewProcessorsDatasource() datasource.DataSource {
+	return &ProcessorsDatasource{}
+}
+
+// ProcessorsDatasource to construct datasource
+type ProcessorsDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *ProcessorsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*ProcessorsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "processors"
+}
+
+// Schema implements datasource.DataSource
+func (*ProcessorsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the processors of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the processors of the computer systems." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: ProcessorsDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"processor_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Processor filter for systems and processors",
+				Description:         "Processor filter for systems and processors",
+				Attributes:          ProcessorFilterSchema(),
+			},
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *ProcessorsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.ProcessorsDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishProcessors(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishProcessors populates the processors of the filtered systems in the datasource model
+func readDatasourceRedfishProcessors(service *gofish.Service, d models.ProcessorsDatasource) (models.ProcessorsDatasource, diag.Diagnostics) {
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	var filters []models.ProcessorSystemFilter
+	if d.ProcessorFilter != nil {
+		filters = d.ProcessorFilter.Systems
+	}
+	var diags diag.Diagnostics
+	d.Processors, diags = systemInventory[*redfish.Processor, models.Processor, models.ProcessorSystemFilter]{
+		collection: "processors",
+		memberName: "processor",
+		members:    (*redfish.ComputerSystem).Processors,
+		memberID:   func(processor *redfish.Processor) string { return processor.ID },
+		filterIDs: func(filter models.ProcessorSystemFilter) (types.String, []types.String) {
+			return filter.SystemID, filter.ProcessorIDs
+		},
+		newMember: newProcessor,
+	}.read(service, filters)
+	return d, diags
+}
+
+// newProcessor converts redfish.Processor to models.Processor
+func newProcessor(systemID string, processor *redfish.Processor) models.Processor {
+	processorExtended, _ := dell.Processor(processor)
+	return models.Processor{
+		ODataID:               types.StringValue(processor.ODataID),
+		ID:                    types.StringValue(processor.ID),
+		SystemID:              types.StringValue(systemID),
+		Name:                  types.StringValue(processor.Name),
+		Description:           types.StringValue(processor.Description),
+		Socket:                types.StringValue(processor.Socket),
+		Manufacturer:          types.StringValue(processor.Manufacturer),
+		Model:                 types.StringValue(processor.Model),
+		ProcessorType:         types.StringValue(string(processor.ProcessorType)),
+		ProcessorArchitecture: types.StringValue(string(processor.ProcessorArchitecture)),
+		InstructionSet:        types.StringValue(string(processor.InstructionSet)),
+		MaxSpeedMHz:           types.Int64Value(int64(processor.MaxSpeedMHz)),
+		OperatingSpeedMHz:     types.Int64Value(int64(processor.OperatingSpeedMHz)),
+		TotalCores:            types.Int64Value(int64(processor.TotalCores)),
+		TotalEnabledCores:     types.Int64Value(int64(processor.TotalEnabledCores)),
+		TotalThreads:          types.Int64Value(int64(processor.TotalThreads)),
+		SerialNumber:          types.StringValue(processor.SerialNumber),
+		PartNumber:            types.StringValue(processor.PartNumber),
+		Status:                newStatus(processor.Status),
+		Oem: models.ProcessorOEM{
+			Dell: models.ProcessorOEMDell{
+				DellProcessor: newDellProcessor(processorExtended.Oem.Dell.DellProcessor),
+			},
+		},
+	}
+}
+
+// newDellProcessor converts dell.DellProcessor to models.DellProcessor
+func newDellProcessor(input dell.DellProcessor) models.DellProcessor {
+	return models.DellProcessor{
+		CPUFamily:                       types.StringValue(input.CPUFamily),
+		CPUStatus:                       types.StringValue(input.CPUStatus),
+		Cache1InstalledSizeKB:           types.Int64Value(input.Cache1InstalledSizeKB),
+		Cache2InstalledSizeKB:           types.Int64Value(input.Cache2InstalledSizeKB),
+		Cache3InstalledSizeKB:           types.Int64Value(input.Cache3InstalledSizeKB),
+		CurrentClockSpeedMhz:            types.Int64Value(input.CurrentClockSpeedMhz),
+		ExternalBusClockSpeedMhz:        types.Int64Value(input.ExternalBusClockSpeedMhz),
+		HyperThreadingCapable:           types.StringValue(input.HyperThreadingCapable),
+		HyperThreadingEnabled:           types.StringValue(input.HyperThreadingEnabled),
+		LastSystemInventoryTime:         types.StringValue(input.LastSystemInventoryTime),
+		LastUpdateTime:                  types.StringValue(input.LastUpdateTime),
+		TurboModeCapable:                types.StringValue(input.TurboModeCapable),
+		TurboModeEnabled:                types.StringValue(input.TurboModeEnabled),
+		VirtualizationTechnologyCapable: types.StringValue(input.VirtualizationTechnologyCapable),
+		VirtualizationTechnologyEnabled: types.StringValue(input.VirtualizationTechnologyEnabled),
+		Volts:                           types.StringValue(input.Volts),
+	}
+}
+
+// ProcessorFilterSchema to construct schema of processor filter
+func ProcessorFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"systems": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Filter for systems and processors",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"system_id": schema.StringAttribute{
+						Required:    true,
+						Description: "Filter for systems",
+					},
+					"processor_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Filter for processors",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ProcessorsDatasourceSchema to define the processors data-source schema
+func ProcessorsDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the processors data-source",
+			Description:         "ID of the processors data-source",
+			Computed:            true,
+		},
+		"processors": schema.ListNestedAttribute{
+			MarkdownDescription: "List of processors fetched.",
+			Description:         "List of processors fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ProcessorSchema(),
+			},
+		},
+	}
+}
+
+// ProcessorSchema to define the processor schema
+func ProcessorSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the processor",
+			Description:         "OData ID of the processor",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the processor",
+			Description:         "ID of the processor",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the computer system of the processor",
+			Description:         "ID of the computer system of the processor",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the processor",
+			Description:         "Name of the processor",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the processor",
+			Description:         "Description of the processor",
+			Computed:            true,
+		},
+		"socket": schema.StringAttribute{
+			MarkdownDescription: "Socket or location of the processor",
+			Description:         "Socket or location of the processor",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the processor",
+			Description:         "Manufacturer of the processor",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the processor",
+			Description:         "Model of the processor",
+			Computed:            true,
+		},
+		"processor_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the processor, such as CPU or GPU",
+			Description:         "Type of the processor, such as CPU or GPU",
+			Computed:            true,
+		},
+		"processor_architecture": schema.StringAttribute{
+			MarkdownDescription: "Architecture of the processor",
+			Description:         "Architecture of the processor",
+			Computed:            true,
+		},
+		"instruction_set": schema.StringAttribute{
+			MarkdownDescription: "Instruction set of the processor",
+			Description:         "Instruction set of the processor",
+			Computed:            true,
+		},
+		"max_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Maximum clock speed of the processor in MHz",
+			Description:         "Maximum clock speed of the processor in MHz",
+			Computed:            true,
+		},
+		"operating_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Operating clock speed of the processor in MHz",
+			Description:         "Operating clock speed of the processor in MHz",
+			Computed:            true,
+		},
+		"total_cores": schema.Int64Attribute{
+			MarkdownDescription: "Total number of cores of the processor",
+			Description:         "Total number of cores of the processor",
+			Computed:            true,
+		},
+		"total_enabled_cores": schema.Int64Attribute{
+			MarkdownDescription: "Total number of enabled cores of the processor",
+			Description:         "Total number of enabled cores of the processor",
+			Computed:            true,
+		},
+		"total_threads": schema.Int64Attribute{
+			MarkdownDescription: "Total number of execution threads of the processor",
+			Description:         "Total number of execution threads of the processor",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the processor",
+			Description:         "Serial number of the processor",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the processor",
+			Description:         "Part number of the processor",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the processor",
+			Description:         "The status and health of the processor",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+		"oem": schema.SingleNestedAttribute{
+			MarkdownDescription: "The OEM extension of the processor",
+			Description:         "The OEM extension of the processor",
+			Computed:            true,
+			Attributes:          ProcessorOEMSchema(),
+		},
+	}
+}
+
+// ProcessorOEMSchema to define the processor OEM schema
+func ProcessorOEMSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dell": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dell OEM data of the processor",
+			Description:         "Dell OEM data of the processor",
+			Computed:            true,
+			Attributes:          ProcessorOEMDellSchema(),
+		},
+	}
+}
+
+// ProcessorOEMDellSchema to define the Dell processor OEM schema
+func ProcessorOEMDellSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dell_processor": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dell processor data",
+			Description:         "Dell processor data",
+			Computed:            true,
+			Attributes:          DellProcessorSchema(),
+		},
+	}
+}
+
+// DellProcessorSchema to define the Dell processor schema
+func DellProcessorSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cpu_family": schema.StringAttribute{
+			MarkdownDescription: "Family of the processor",
+			Description:         "Family of the processor",
+			Computed:            true,
+		},
+		"cpu_status": schema.StringAttribute{
+			MarkdownDescription: "Status of the processor",
+			Description:         "Status of the processor",
+			Computed:            true,
+		},
+		"cache1_installed_size_kb": schema.Int64Attribute{
+			MarkdownDescription: "Installed size of the level 1 cache in KB",
+			Description:         "Installed size of the level 1 cache in KB",
+			Computed:            true,
+		},
+		"cache2_installed_size_kb": schema.Int64Attribute{
+			MarkdownDescription: "Installed size of the level 2 cache in KB",
+			Description:         "Installed size of the level 2 cache in KB",
+			Computed:            true,
+		},
+		"cache3_installed_size_kb": schema.Int64Attribute{
+			MarkdownDescription: "Installed size of the level 3 cache in KB",
+			Description:         "Installed size of the level 3 cache in KB",
+			Computed:            true,
+		},
+		"current_clock_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Current clock speed of the processor in MHz",
+			Description:         "Current clock speed of the processor in MHz",
+			Computed:            true,
+		},
+		"external_bus_clock_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Clock speed of the external bus in MHz",
+			Description:         "Clock speed of the external bus in MHz",
+			Computed:            true,
+		},
+		"hyper_threading_capable": schema.StringAttribute{
+			MarkdownDescription: "Whether the processor supports hyper-threading",
+			Description:         "Whether the processor supports hyper-threading",
+			Computed:            true,
+		},
+		"hyper_threading_enabled": schema.StringAttribute{
+			MarkdownDescription: "Whether hyper-threading is enabled",
+			Description:         "Whether hyper-threading is enabled",
+			Computed:            true,
+		},
+		"last_system_inventory_time": schema.StringAttribute{
+			MarkdownDescription: "Time of the last system inventory",
+			Description:         "Time of the last system inventory",
+			Computed:            true,
+		},
+		"last_update_time": schema.StringAttribute{
+			MarkdownDescription: "Time of the last update of the processor data",
+			Description:         "Time of the last update of the processor data",
+			Computed:            true,
+		},
+		"turbo_mode_capable": schema.StringAttribute{
+			MarkdownDescription: "Whether the processor supports turbo mode",
+			Description:         "Whether the processor supports turbo mode",
+			Computed:            true,
+		},
+		"turbo_mode_enabled": schema.StringAttribute{
+			MarkdownDescription: "Whether turbo mode is enabled",
+			Description:         "Whether turbo mode is enabled",
+			Computed:            true,
+		},
+		"virtualization_technology_capable": schema.StringAttribute{
+			MarkdownDescription: "Whether the processor supports virtualization technology",
+			Description:         "Whether the processor supports virtualization technology",
+			Computed:            true,
+		},
+		"virtualization_technology_enabled": schema.StringAttribute{
+			MarkdownDescription: "Whether virtualization technology is enabled",
+			Description:         "Whether virtualization technology is enabled",
+			Computed:            true,
+		},
+		"volts": schema.StringAttribute{
+			MarkdownDescription: "Voltage of the processor",
+			Description:         "Voltage of the processor",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to fetch the processors of the computer systems - Positive
+func TestAccRedfishProcessorsDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_processors.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceProcessorsConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "processors.#"),
+					resource.TestCheckResourceAttrSet(dsName, "processors.0.odata_id"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceProcessorsConfig(creds, `
+				processor_filter {
+					systems = [
+						{
+							system_id = "System.Embedded.1"
+							processor_ids = ["CPU.Socket.1"]
+						}
+					]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "processors.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "processors.0.id", "CPU.Socket.1"),
+					resource.TestCheckResourceAttr(dsName, "processors.0.system_id", "System.Embedded.1"),
+				),
+			},
+		},
+	})
+}
+
+// Test to fetch the processors with invalid filters - Negative
+func TestAccRedfishProcessorsDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceProcessorsConfig(creds, `
+				processor_filter {
+					systems = [{ system_id = "System.Invalid.1" }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered system ids are not valid*.`),
+			},
+			{
+				Config: testAccRedfishDataSourceProcessorsConfig(creds, `
+				processor_filter {
+					systems = [{ system_id = "System.Embedded.1", processor_ids = ["Invalid.1"] }]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Error one or more of the filtered processor ids are not valid*.`),
+			},
+		},
+	})
+}
+
+func TestFilterByID(t *testing.T) {
+	members := []string{"CPU.Socket.1", "CPU.Socket.2"}
+	memberID := func(member string) string { return member }
+
+	all, validIDs, found := filterByID(members, memberID, nil)
+	if !slices.Equal(all, members) || !slices.Equal(validIDs, members) || !found {
+		t.Fatalf("Expected all the members without a filter, got %v and %v", all, validIDs)
+	}
+
+	filtered, _, found := filterByID(members, memberID, []string{"CPU.Socket.2"})
+	if !slices.Equal(filtered, []string{"CPU.Socket.2"}) || !found {
+		t.Fatalf("Expected the filtered member, got %v", filtered)
+	}
+
+	// an unknown id is reported as not found
+	filtered, _, found = filterByID(members, memberID, []string{"CPU.Socket.1", "CPU.Socket.3"})
+	if len(filtered) != 1 || found {
+		t.Fatalf("Expected only the known member and an unknown id, got %v and %v", filtered, found)
+	}
+
+	// an id given more than once is found
+	filtered, _, found = filterByID(members, memberID, []string{"CPU.Socket.1", "CPU.Socket.1"})
+	if !slices.Equal(filtered, []string{"CPU.Socket.1"}) || !found {
+		t.Fatalf("Expected the repeated member to be found once, got %v and %v", filtered, found)
+	}
+}
+
+func testAccRedfishDataSourceProcessorsConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_processors" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ScpDiffDatasource{}
+
+// NewScpDiffDatasource is new datasource for the differences between two server configuration profiles
+func NewScpDiffDatasource() datasource.DataSource {
+	return &ScpDiffDatasource{}
+}
+
+// ScpDiffDatasource to construct datasource
+type ScpDiffDatasource struct{}
+
+// Metadata implements datasource.DataSource
+func (*ScpDiffDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "idrac_server_configuration_profile_diff"
+}
+
+// Schema implements datasource.DataSource
+func (*ScpDiffDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to compare two Server Configuration Profiles, such as the exports of two servers" +
+			" or the export of a server and a golden profile, and to return the attributes added, removed and changed between them." +
+			" The profiles are compared locally, without connecting to any server.",
+		Description: "This Terraform datasource is used to compare two Server Configuration Profiles, such as the exports of two servers" +
+			" or the export of a server and a golden profile, and to return the attributes added, removed and changed between them." +
+			" The profiles are compared locally, without connecting to any server.",
+		Attributes: ScpDiffDatasourceSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (*ScpDiffDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.ScpDiffDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := readDatasourceRedfishScpDiff(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishScpDiff compares the profiles and populates their differences in the datasource model
+func readDatasourceRedfishScpDiff(d models.ScpDiffDatasource) (models.ScpDiffDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter, err := newScpProfileFilterFromModel(d.Filter)
+	if err != nil {
+		diags.AddAttributeError(path.Root("filter"), "Invalid Server Configuration Profile filter", err.Error())
+		return d, diags
+	}
+	baseline, err := parseScpProfile(d.Baseline.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("baseline"), "Unable to parse the baseline Server Configuration Profile", err.Error())
+		return d, diags
+	}
+	profile, err := parseScpProfile(d.Profile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Unable to parse the Server Configuration Profile", err.Error())
+		return d, diags
+	}
+
+	added, removed, changed := diffScpProfiles(filter.apply(baseline), filter.apply(profile))
+	d.ID = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Added = newScpAttributeDiffs(added)
+	d.Removed = newScpAttributeDiffs(removed)
+	d.Changed = newScpAttributeDiffs(changed)
+	d.HasDifferences = types.BoolValue(len(added)+len(removed)+len(changed) > 0)
+	return d, diags
+}
+
+// newScpAttributeDiffs converts the differences of the profiles to models.ScpAttributeDiff,
+// the value missing from one of the profiles is null
+func newScpAttributeDiffs(diffs []scpAttributeDiff) []models.ScpAttributeDiff {
+	result := make([]models.ScpAttributeDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		result = append(result, models.ScpAttributeDiff{
+			FQDD:          types.StringValue(diff.FQDD),
+			Name:          types.StringValue(diff.Name),
+			BaselineValue: types.StringPointerValue(diff.BaselineValue),
+			Value:         types.StringPointerValue(diff.Value),
+		})
+	}
+	return result
+}
+
+// ScpDiffDatasourceSchema to define the server configuration profile diff data-source schema
+func ScpDiffDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the server configuration profile diff data-source",
+			Description:         "ID of the server configuration profile diff data-source",
+			Computed:            true,
+		},
+		"baseline": schema.StringAttribute{
+			MarkdownDescription: "Content of the baseline Server Configuration Profile, in XML or JSON format, such as a golden profile" +
+				" read with `file()` or the decoded `file_content` of an export.",
+			Description: "Content of the baseline Server Configuration Profile, in XML or JSON format, such as a golden profile" +
+				" read with file() or the decoded file_content of an export.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"profile": schema.StringAttribute{
+			MarkdownDescription: "Content of the Server Configuration Profile compared with the baseline, in XML or JSON format," +
+				" such as the decoded `file_content` of an export.",
+			Description: "Content of the Server Configuration Profile compared with the baseline, in XML or JSON format," +
+				" such as the decoded file_content of an export.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"filter": schema.SingleNestedAttribute{
+			MarkdownDescription: "Filter of the components and attributes of the profiles to compare.",
+			Description:         "Filter of the components and attributes of the profiles to compare.",
+			Optional:            true,
+			Attributes:          ScpProfileFilterDatasourceSchema(),
+		},
+		"has_differences": schema.BoolAttribute{
+			MarkdownDescription: "Whether any attribute was added, removed or changed.",
+			Description:         "Whether any attribute was added, removed or changed.",
+			Computed:            true,
+		},
+		"added": schema.ListNestedAttribute{
+			MarkdownDescription: "Attributes of the profile which are not in the baseline.",
+			Description:         "Attributes of the profile which are not in the baseline.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ScpAttributeDiffSchema(),
+			},
+		},
+		"removed": schema.ListNestedAttribute{
+			MarkdownDescription: "Attributes of the baseline which are not in the profile.",
+			Description:         "Attributes of the baseline which are not in the profile.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ScpAttributeDiffSchema(),
+			},
+		},
+		"changed": schema.ListNestedAttribute{
+			MarkdownDescription: "Attributes whose value in the profile differs from the baseline.",
+			Description:         "Attributes whose value in the profile differs from the baseline.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ScpAttributeDiffSchema(),
+			},
+		},
+	}
+}
+
+// ScpProfileFilterDatasourceSchema to define the schema of the filter of the components and attributes of the profiles
+func ScpProfileFilterDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"components": schema.ListAttribute{
+			MarkdownDescription: "Regular expressions matching the FQDDs of the components to compare, such as `^NIC\\.` or `BIOS.Setup.1-1`." +
+				" All the components are compared when it is not set.",
+			Description: "Regular expressions matching the FQDDs of the components to compare, such as ^NIC\\. or BIOS.Setup.1-1." +
+				" All the components are compared when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"attributes": schema.ListAttribute{
+			MarkdownDescription: "Regular expressions matching the names of the attributes to compare, such as `^SNMP\\.` or `BootMode`." +
+				" All the attributes are compared when it is not set.",
+			Description: "Regular expressions matching the names of the attributes to compare, such as ^SNMP\\. or BootMode." +
+				" All the attributes are compared when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+// ScpAttributeDiffSchema to define the schema of an attribute which differs between two profiles
+func ScpAttributeDiffSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"fqdd": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified device descriptor of the component of the attribute",
+			Description:         "Fully qualified device descriptor of the component of the attribute",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the attribute",
+			Description:         "Name of the attribute",
+			Computed:            true,
+		},
+		"baseline_value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the baseline, null when the attribute was added",
+			Description:         "Value of the attribute in the baseline, null when the attribute was added",
+			Computed:            true,
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the profile, null when the attribute was removed",
+			Description:         "Value of the attribute in the profile, null when the attribute was removed",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to compare two server configuration profiles - Positive
+func TestAccRedfishScpDiffDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_idrac_server_configuration_profile_diff.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceScpDiffConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "has_differences", "true"),
+					resource.TestCheckResourceAttr(dsName, "added.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "added.0.name", "SNMP.1#TrapFormat"),
+					resource.TestCheckNoResourceAttr(dsName, "added.0.baseline_value"),
+					resource.TestCheckResourceAttr(dsName, "removed.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "removed.0.name", "SNMP.1#AgentEnable"),
+					resource.TestCheckResourceAttr(dsName, "changed.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "changed.0.baseline_value", "public"),
+					resource.TestCheckResourceAttr(dsName, "changed.0.value", "private"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceScpDiffConfig(`
+				filter = {
+					components = ["^RAID\\.", "^Disk\\."]
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "has_differences", "false"),
+					resource.TestCheckResourceAttr(dsName, "changed.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Test to compare server configuration profiles with an invalid filter - Negative
+func TestAccRedfishScpDiffDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceScpDiffConfig(`
+				filter = {
+					attributes = ["("]
+				}
+				`),
+				ExpectError: regexp.MustCompile(`.*Invalid Server Configuration Profile filter*.`),
+			},
+		},
+	})
+}
+
+func testAccRedfishDataSourceScpDiffConfig(args string) string {
+	return fmt.Sprintf(`
+	data "redfish_idrac_server_configuration_profile_diff" "test" {
+		baseline = <<-EOT
+		%s
+		EOT
+		profile = <<-EOT
+		%s
+		EOT
+		%s
+	}
+	`,
+		scpXMLProfile,
+		scpJSONProfile,
+		args,
+	)
+}
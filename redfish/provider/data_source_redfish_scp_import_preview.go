@@ -0,0 +1,459 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// scpPreviewTimeout is the time to wait for a preview job to finish, in seconds
+	scpPreviewTimeout int64 = 600
+)
+
+var (
+	_ datasource.DataSource              = &ScpImportPreviewDatasource{}
+	_ datasource.DataSourceWithConfigure = &ScpImportPreviewDatasource{}
+)
+
+// NewScpImportPreviewDatasource is new datasource for the preview of a server configuration profile import
+func NewScpImportPreviewDatasource() datasource.DataSource {
+	return &ScpImportPreviewDatasource{}
+}
+
+// ScpImportPreviewDatasource to construct datasource
+type ScpImportPreviewDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *ScpImportPreviewDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*ScpImportPreviewDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "idrac_server_configuration_profile_preview"
+}
+
+// Schema implements datasource.DataSource
+func (*ScpImportPreviewDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to preview the import of a Server Configuration Profile." +
+			" The iDRAC validates the profile against the server in a preview job, without changing the server," +
+			" and the validation results of the attributes of each component are returned." +
+			" Every read of the datasource, including each refresh of a plan, runs a new preview job, which can take" +
+			" several minutes, and holds the lock on the server while it runs, so resources of the same server wait for it.",
+		Description: "This Terraform datasource is used to preview the import of a Server Configuration Profile." +
+			" The iDRAC validates the profile against the server in a preview job, without changing the server," +
+			" and the validation results of the attributes of each component are returned." +
+			" Every read of the datasource, including each refresh of a plan, runs a new preview job, which can take" +
+			" several minutes, and holds the lock on the server while it runs, so resources of the same server wait for it.",
+		Attributes: ScpImportPreviewDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *ScpImportPreviewDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.ScpImportPreviewDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the preview runs a job on the server, so it waits for the changes in progress
+	unlock, err := lockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishScpImportPreview(ctx, api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishScpImportPreview runs the preview job of the profile and populates its results in the datasource model
+func readDatasourceRedfishScpImportPreview(ctx context.Context, service *gofish.Service,
+	d models.ScpImportPreviewDatasource,
+) (models.ScpImportPreviewDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	manager, err := getManagerResource(service, "")
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return d, diags
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		diags.AddError("Error fetching Dell manager", err.Error())
+		return d, diags
+	}
+	previewURL := dellManager.Actions.ImportSystemConfigurationPreviewTarget
+	if previewURL == "" {
+		diags.AddError("Error previewing the Server Configuration Profile",
+			"The manager does not support the ImportSystemConfigurationPreview action")
+		return d, diags
+	}
+
+	tflog.Info(ctx, "Previewing the Server Configuration Profile import")
+	response, err := service.GetClient().Post(previewURL, scpImportPreviewPayload(d, dellManager.FirmwareVersion))
+	if err != nil {
+		diags.AddError("Error previewing the Server Configuration Profile", err.Error())
+		return d, diags
+	}
+	location, err := response.Location()
+	_ = response.Body.Close()
+	if err != nil {
+		diags.AddError("Error previewing the Server Configuration Profile", "The preview job was not created: "+err.Error())
+		return d, diags
+	}
+
+	task, err := waitForScpTask(ctx, service, location.EscapedPath(), intervalJobCheckTime, scpPreviewTimeout,
+		common.IsRetryableTaskError)
+	if err != nil {
+		diags.AddError("Error waiting for the Server Configuration Profile preview job", err.Error())
+		return d, diags
+	}
+
+	d.ID = types.StringValue(task.ID)
+	d.JobID = types.StringValue(task.ID)
+	d.JobState = types.StringValue(scpJobState(task))
+	d.Message = types.StringValue(task.Message)
+	d.Results = newScpAttributeResults(task)
+	failures := task.Failures()
+	d.ErrorCount = types.Int64Value(int64(len(failures)))
+
+	if d.FailOnError.ValueBool() && (len(failures) > 0 || scpJobState(task) == "Failed") {
+		diags.AddError("The Server Configuration Profile would not import on the server", scpFailuresDetail(task, failures))
+	}
+	return d, diags
+}
+
+// scpImportPreviewPayload returns the payload of the preview job of the profile
+func scpImportPreviewPayload(d models.ScpImportPreviewDatasource, firmwareVersion string) models.SCPImportPreview {
+	target := []string{"ALL"}
+	if len(d.Target) > 0 {
+		target = []string{}
+		for _, component := range d.Target {
+			target = append(target, component.ValueString())
+		}
+	}
+	payload := models.SCPImportPreview{
+		ImportBuffer: d.ImportBuffer.ValueString(),
+		ShareParameters: models.ShareParameters{
+			Target: scpTarget(target, firmwareVersion),
+		},
+	}
+	if sp := d.ShareParameters; sp != nil {
+		payload.ShareParameters.FileName = sp.FileName.ValueString()
+		payload.ShareParameters.ShareType = sp.ShareType.ValueString()
+		payload.ShareParameters.IPAddress = sp.IPAddress.ValueString()
+		payload.ShareParameters.ShareName = sp.ShareName.ValueString()
+		payload.ShareParameters.Username = sp.Username.ValueString()
+		payload.ShareParameters.Password = sp.Password.ValueString()
+		if !sp.PortNumber.IsNull() {
+			payload.ShareParameters.PortNumber = strconv.FormatInt(sp.PortNumber.ValueInt64(), defaultIntBase)
+		}
+		if !sp.IgnoreCertificateWarning.IsNull() {
+			payload.ShareParameters.IgnoreCertificateWarning = "Enabled"
+			if sp.IgnoreCertificateWarning.ValueBool() {
+				payload.ShareParameters.IgnoreCertificateWarning = "Disabled"
+			}
+		}
+	}
+	return payload
+}
+
+// waitForScpTask waits for the task of a server configuration profile job to finish and returns it.
+// The errors reading the task are retried when retryable reports so, all of them when it is nil.
+func waitForScpTask(ctx context.Context, service *gofish.Service, taskURI string, interval, timeout int64,
+	retryable func(error) bool,
+) (*dell.ServerConfigurationProfileTask, error) {
+	return common.WaitForDellTask(ctx, service, taskURI, interval, timeout, dell.GetServerConfigurationProfileTask,
+		func(task *dell.ServerConfigurationProfileTask) (bool, error) {
+			switch redfish.TaskState(task.TaskState) {
+			case redfish.CompletedTaskState, redfish.KilledTaskState, redfish.ExceptionTaskState, redfish.CancelledTaskState:
+				return true, nil
+			}
+			return false, nil
+		}, retryable)
+}
+
+// scpJobState returns the state of the Dell job of the task, or the state of the task when it has none
+func scpJobState(task *dell.ServerConfigurationProfileTask) string {
+	if task.JobState != "" {
+		return task.JobState
+	}
+	return task.TaskState
+}
+
+// scpFailuresDetail describes the failed job or attributes of a server configuration profile job
+func scpFailuresDetail(task *dell.ServerConfigurationProfileTask, failures []dell.ServerConfigurationProfileResult) string {
+	detail := []string{fmt.Sprintf("The job %s finished in the %s state: %s", task.ID, scpJobState(task), task.Message)}
+	for _, failure := range failures {
+		detail = append(detail, fmt.Sprintf("%s %s: %s", failure.FQDD, failure.Name, failure.Message))
+	}
+	return strings.Join(detail, "\n")
+}
+
+// newScpAttributeResults converts the attribute results of the task to models.ScpAttributeResult,
+// the messages of the task which are not about an attribute are left out
+func newScpAttributeResults(task *dell.ServerConfigurationProfileTask) []models.ScpAttributeResult {
+	results := []models.ScpAttributeResult{}
+	for _, result := range task.Results {
+		if result.FQDD == "" && result.Name == "" {
+			continue
+		}
+		results = append(results, models.ScpAttributeResult{
+			FQDD:      types.StringValue(result.FQDD),
+			Name:      types.StringValue(result.Name),
+			OldValue:  types.StringValue(result.OldValue),
+			NewValue:  types.StringValue(result.NewValue),
+			Status:    types.StringValue(result.Status),
+			Message:   types.StringValue(result.Message),
+			MessageID: types.StringValue(result.MessageID),
+			Severity:  types.StringValue(result.Severity),
+			ErrorCode: types.Int64Value(int64(result.ErrCode)),
+		})
+	}
+	return results
+}
+
+// ScpImportPreviewDatasourceSchema to define the server configuration profile import preview data-source schema
+func ScpImportPreviewDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the server configuration profile import preview data-source",
+			Description:         "ID of the server configuration profile import preview data-source",
+			Computed:            true,
+		},
+		"import_buffer": schema.StringAttribute{
+			MarkdownDescription: "Content of the Server Configuration Profile to preview, in XML or JSON format. Conflicts with `share_parameters`.",
+			Description:         "Content of the Server Configuration Profile to preview, in XML or JSON format. Conflicts with share_parameters.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("share_parameters")),
+			},
+		},
+		"share_parameters": schema.SingleNestedAttribute{
+			MarkdownDescription: "Network share of the Server Configuration Profile to preview. Conflicts with `import_buffer`.",
+			Description:         "Network share of the Server Configuration Profile to preview. Conflicts with import_buffer.",
+			Optional:            true,
+			Attributes:          ScpPreviewShareParametersSchema(),
+		},
+		"target": schema.ListAttribute{
+			MarkdownDescription: "Components of the profile to preview, such as `BIOS`, `IDRAC`, `NIC` or `RAID`. Defaults to `ALL`.",
+			Description:         "Components of the profile to preview, such as BIOS, IDRAC, NIC or RAID. Defaults to ALL.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(scpTargets...)),
+			},
+		},
+		"fail_on_error": schema.BoolAttribute{
+			MarkdownDescription: "Whether reading the data source fails when the preview finds errors in the profile," +
+				" so that `terraform plan` fails on a profile which would not import on the server. Defaults to `false`.",
+			Description: "Whether reading the data source fails when the preview finds errors in the profile," +
+				" so that terraform plan fails on a profile which would not import on the server. Defaults to false.",
+			Optional: true,
+		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the preview job.",
+			Description:         "ID of the preview job.",
+			Computed:            true,
+		},
+		"job_state": schema.StringAttribute{
+			MarkdownDescription: "State of the preview job, such as `Completed` or `Failed`.",
+			Description:         "State of the preview job, such as Completed or Failed.",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Status message of the preview job.",
+			Description:         "Status message of the preview job.",
+			Computed:            true,
+		},
+		"error_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of attributes of the profile which would fail to import.",
+			Description:         "Number of attributes of the profile which would fail to import.",
+			Computed:            true,
+		},
+		"results": schema.ListNestedAttribute{
+			MarkdownDescription: "Validation results of the attributes of each component of the profile.",
+			Description:         "Validation results of the attributes of each component of the profile.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ScpAttributeResultSchema(),
+			},
+		},
+	}
+}
+
+// ScpPreviewShareParametersSchema to define the schema of the network share of the profile to preview
+func ScpPreviewShareParametersSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"filename": schema.StringAttribute{
+			MarkdownDescription: "Name of the Server Configuration Profile file.",
+			Description:         "Name of the Server Configuration Profile file.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"share_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the network share. Accepted values: `NFS`, `CIFS`, `HTTP`, `HTTPS`.",
+			Description:         "Type of the network share. Accepted values: NFS, CIFS, HTTP, HTTPS.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("NFS", "CIFS", "HTTP", "HTTPS"),
+			},
+		},
+		"ip_address": schema.StringAttribute{
+			MarkdownDescription: "IP address of the network share.",
+			Description:         "IP address of the network share.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"share_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the directory or share which contains the file.",
+			Description:         "Name of the directory or share which contains the file.",
+			Optional:            true,
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "Username of the network share.",
+			Description:         "Username of the network share.",
+			Optional:            true,
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "Password of the network share.",
+			Description:         "Password of the network share.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"port_number": schema.Int64Attribute{
+			MarkdownDescription: "Port of the network share.",
+			Description:         "Port of the network share.",
+			Optional:            true,
+		},
+		"ignore_certificate_warning": schema.BoolAttribute{
+			MarkdownDescription: "Whether the certificate of an HTTPS share is not verified.",
+			Description:         "Whether the certificate of an HTTPS share is not verified.",
+			Optional:            true,
+		},
+	}
+}
+
+// ScpAttributeResultSchema to define the schema of the result of an attribute of a server configuration profile job
+func ScpAttributeResultSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"fqdd": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified device descriptor of the component of the attribute",
+			Description:         "Fully qualified device descriptor of the component of the attribute",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the attribute",
+			Description:         "Name of the attribute",
+			Computed:            true,
+		},
+		"old_value": schema.StringAttribute{
+			MarkdownDescription: "Current value of the attribute on the server",
+			Description:         "Current value of the attribute on the server",
+			Computed:            true,
+		},
+		"new_value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the profile",
+			Description:         "Value of the attribute in the profile",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the attribute, Success or Failure",
+			Description:         "Status of the attribute, Success or Failure",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Message of the result",
+			Description:         "Message of the result",
+			Computed:            true,
+		},
+		"message_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the message of the result",
+			Description:         "ID of the message of the result",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "Severity of the message of the result",
+			Description:         "Severity of the message of the result",
+			Computed:            true,
+		},
+		"error_code": schema.Int64Attribute{
+			MarkdownDescription: "Error code of the attribute",
+			Description:         "Error code of the attribute",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+)
+
+const scpPreviewBuffer = `<SystemConfiguration>
+<Component FQDD="iDRAC.Embedded.1">
+<Attribute Name="SNMP.1#AgentCommunity">public</Attribute>
+</Component>
+</SystemConfiguration>`
+
+// Test to preview the import of a server configuration profile - Positive
+func TestAccRedfishScpImportPreviewDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_idrac_server_configuration_profile_preview.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceScpImportPreviewConfig(creds, fmt.Sprintf(`
+				import_buffer = <<-EOT
+				%s
+				EOT
+				target        = ["IDRAC"]
+				fail_on_error = true
+				`, scpPreviewBuffer)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "job_id"),
+					resource.TestCheckResourceAttr(dsName, "job_state", "Completed"),
+					resource.TestCheckResourceAttr(dsName, "error_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Test to preview the import of an invalid server configuration profile - Negative
+func TestAccRedfishScpImportPreviewDataSource_invalidProfile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceScpImportPreviewConfig(creds, fmt.Sprintf(`
+				import_buffer = <<-EOT
+				%s
+				EOT
+				fail_on_error = true
+				`, strings.ReplaceAll(scpPreviewBuffer, "SNMP.1#AgentCommunity", "Invalid.1#Invalid"))),
+				ExpectError: regexp.MustCompile(`.*would not import on the server*.`),
+			},
+			{
+				Config: testAccRedfishDataSourceScpImportPreviewConfig(creds, `
+				target = ["IDRAC"]
+				`),
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+		},
+	})
+}
+
+func TestScpImportPreviewPayload(t *testing.T) {
+	d := models.ScpImportPreviewDatasource{
+		ImportBuffer: types.StringNull(),
+		ShareParameters: &models.ScpPreviewShareParameters{
+			FileName:                 types.StringValue("profile.xml"),
+			ShareType:                types.StringValue("HTTPS"),
+			IPAddress:                types.StringValue("10.0.0.1"),
+			ShareName:                types.StringValue("share"),
+			Username:                 types.StringNull(),
+			Password:                 types.StringNull(),
+			PortNumber:               types.Int64Value(8443),
+			IgnoreCertificateWarning: types.BoolValue(true),
+		},
+		Target: []types.String{types.StringValue("BIOS"), types.StringValue("NIC")},
+	}
+
+	payload := scpImportPreviewPayload(d, "7.00.00.00")
+	if payload.ImportBuffer != "" {
+		t.Fatalf("Expected no import buffer, got %q", payload.ImportBuffer)
+	}
+	sp := payload.ShareParameters
+	if sp.FileName != "profile.xml" || sp.ShareType != "HTTPS" || sp.IPAddress != "10.0.0.1" || sp.ShareName != "share" {
+		t.Fatalf("Unexpected share parameters %+v", sp)
+	}
+	if sp.PortNumber != "8443" || sp.IgnoreCertificateWarning != "Disabled" {
+		t.Fatalf("Expected port 8443 and certificate warnings disabled, got %q and %q", sp.PortNumber, sp.IgnoreCertificateWarning)
+	}
+	if target, ok := sp.Target.([]string); !ok || strings.Join(target, ",") != "BIOS,NIC" {
+		t.Fatalf("Expected the BIOS and NIC targets, got %v", sp.Target)
+	}
+
+	payload = scpImportPreviewPayload(models.ScpImportPreviewDatasource{ImportBuffer: types.StringValue("<SystemConfiguration/>")}, "5.10.00.00")
+	if payload.ImportBuffer != "<SystemConfiguration/>" {
+		t.Fatalf("Expected the import buffer, got %q", payload.ImportBuffer)
+	}
+	if target, ok := payload.ShareParameters.Target.(string); !ok || target != "ALL" {
+		t.Fatalf("Expected the ALL target as a string on 5.x firmwares, got %v", payload.ShareParameters.Target)
+	}
+}
+
+func TestNewScpAttributeResults(t *testing.T) {
+	task := &dell.ServerConfigurationProfileTask{
+		JobState: "Failed",
+		Message:  "Unable to complete application of configuration profile values.",
+		Results: []dell.ServerConfigurationProfileResult{
+			{Message: "Import of Server Configuration Profile operation completed with errors."},
+			{FQDD: "iDRAC.Embedded.1", Name: "SNMP.1#AgentCommunity", NewValue: "public", Status: "Success"},
+			{
+				FQDD: "BIOS.Setup.1-1", Name: "Invalid", NewValue: "x", Status: dell.ServerConfigurationProfileFailure,
+				Message: "The attribute is not valid.", ErrCode: 9,
+			},
+		},
+	}
+	task.ID = "JID_123"
+
+	results := newScpAttributeResults(task)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 attribute results, got %d", len(results))
+	}
+	if results[1].FQDD.ValueString() != "BIOS.Setup.1-1" || results[1].ErrorCode.ValueInt64() != 9 {
+		t.Fatalf("Unexpected attribute result %+v", results[1])
+	}
+
+	detail := scpFailuresDetail(task, task.Failures())
+	want := "The job JID_123 finished in the Failed state: Unable to complete application of configuration profile values.\n" +
+		"BIOS.Setup.1-1 Invalid: The attribute is not valid."
+	if detail != want {
+		t.Fatalf("Expected the detail %q, got %q", want, detail)
+	}
+}
+
+func testAccRedfishDataSourceScpImportPreviewConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_idrac_server_configuration_profile_preview" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
+
+func TestWaitForScpTask(t *testing.T) {
+	taskResponse := func(statusCode int, body string) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+	}
+	newService := func(responses ...interface{}) (*gofish.Service, *redfishcommon.TestClient) {
+		client := &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{http.MethodGet: responses}}
+		service := &gofish.Service{}
+		service.SetClient(client)
+		return service, client
+	}
+	taskURI := "/redfish/v1/TaskService/TaskMonitors/JID_1"
+
+	// server errors are retried until the task has finished, through the task of the task monitor
+	service, client := newService(
+		taskResponse(http.StatusServiceUnavailable, `{}`),
+		taskResponse(http.StatusOK, `{"Id": "JID_1", "TaskState": "Running"}`),
+		taskResponse(http.StatusOK, `{"Id": "JID_1", "TaskState": "Completed"}`),
+	)
+	task, err := waitForScpTask(context.Background(), service, taskURI, 1, 10, common.IsRetryableTaskError)
+	if err != nil || task.ID != "JID_1" || len(client.CapturedCalls()) != 3 {
+		t.Fatalf("Expected the completed task after 3 reads, got %v and %v after %d reads", task, err, len(client.CapturedCalls()))
+	}
+	if url := client.CapturedCalls()[0].URL; url != "/redfish/v1/TaskService/Tasks/JID_1" {
+		t.Fatalf("Expected the task to be read instead of its task monitor, got %s", url)
+	}
+
+	// a missing task is not retried by the preview
+	service, client = newService(taskResponse(http.StatusNotFound, `{}`))
+	_, err = waitForScpTask(context.Background(), service, taskURI, 1, 10, common.IsRetryableTaskError)
+	if err == nil || len(client.CapturedCalls()) != 1 {
+		t.Fatalf("Expected an error after a single read of a missing task, got %v after %d reads", err, len(client.CapturedCalls()))
+	}
+
+	// every error is retried without a retryable function
+	service, client = newService(
+		taskResponse(http.StatusNotFound, `{}`),
+		taskResponse(http.StatusOK, `{"Id": "JID_1", "TaskState": "Completed"}`),
+	)
+	if _, err := waitForScpTask(context.Background(), service, taskURI, 1, 10, nil); err != nil || len(client.CapturedCalls()) != 2 {
+		t.Fatalf("Expected the completed task after 2 reads, got %v after %d reads", err, len(client.CapturedCalls()))
+	}
+
+	// the wait stops when the context is done
+	service, client = newService()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := waitForScpTask(ctx, service, taskURI, 1, 10, nil); !errors.Is(err, context.Canceled) || len(client.CapturedCalls()) != 0 {
+		t.Fatalf("Expected the wait to be canceled, got %v after %d reads", err, len(client.CapturedCalls()))
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SecureBootDatasource{}
+	_ datasource.DataSourceWithConfigure = &SecureBootDatasource{}
+)
+
+// NewSecureBootDatasource is new datasource for the UEFI Secure Boot of the computer system
+func NewSecureBootDatasource() datasource.DataSource {
+	return &SecureBootDatasource{}
+}
+
+// SecureBootDatasource to construct datasource
+type SecureBootDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SecureBootDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SecureBootDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "secure_boot"
+}
+
+// Schema implements datasource.DataSource
+func (*SecureBootDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the UEFI Secure Boot state of the computer system" +
+			" and the certificates enrolled in its key databases." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the UEFI Secure Boot state of the computer system" +
+			" and the certificates enrolled in its key databases." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: SecureBootDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SecureBootDatasourceSchema to define the secure boot data-source schema
+func SecureBootDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Secure Boot data-source",
+			Description:         "ID of the Secure Boot data-source",
+			Computed:            true,
+		},
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the Secure Boot resource",
+			Description:         "OData ID of the Secure Boot resource",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"secure_boot_enable": schema.BoolAttribute{
+			MarkdownDescription: "Whether UEFI Secure Boot is enabled",
+			Description:         "Whether UEFI Secure Boot is enabled",
+			Computed:            true,
+		},
+		"secure_boot_mode": schema.StringAttribute{
+			MarkdownDescription: "The Secure Boot mode, as defined in the UEFI Specification",
+			Description:         "The Secure Boot mode, as defined in the UEFI Specification",
+			Computed:            true,
+		},
+		"secure_boot_current_boot": schema.StringAttribute{
+			MarkdownDescription: "The UEFI Secure Boot state during the current boot cycle",
+			Description:         "The UEFI Secure Boot state during the current boot cycle",
+			Computed:            true,
+		},
+		"databases": schema.ListNestedAttribute{
+			MarkdownDescription: "The UEFI Secure Boot key databases",
+			Description:         "The UEFI Secure Boot key databases",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: SecureBootDatabaseSchema(),
+			},
+		},
+	}
+}
+
+// SecureBootDatabaseSchema to define the schema of a secure boot database
+func SecureBootDatabaseSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the database",
+			Description:         "OData ID of the database",
+			Computed:            true,
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: "The name of the UEFI Secure Boot database, such as db, dbx, KEK or PK",
+			Description:         "The name of the UEFI Secure Boot database, such as db, dbx, KEK or PK",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the database",
+			Description:         "Description of the database",
+			Computed:            true,
+		},
+		"certificates": schema.ListNestedAttribute{
+			MarkdownDescription: "The certificates enrolled in the database",
+			Description:         "The certificates enrolled in the database",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: SecureBootDatabaseCertificateSchema(),
+			},
+		},
+	}
+}
+
+// SecureBootDatabaseCertificateSchema to define the schema of a certificate of a secure boot database
+func SecureBootDatabaseCertificateSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the certificate",
+			Description:         "OData ID of the certificate",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the certificate",
+			Description:         "ID of the certificate",
+			Computed:            true,
+		},
+		"certificate_type": schema.StringAttribute{
+			MarkdownDescription: "The format of the certificate",
+			Description:         "The format of the certificate",
+			Computed:            true,
+		},
+		"certificate_string": schema.StringAttribute{
+			MarkdownDescription: "The content of the certificate, when reported by the server",
+			Description:         "The content of the certificate, when reported by the server",
+			Computed:            true,
+		},
+		"subject": schema.SingleNestedAttribute{
+			MarkdownDescription: "The subject of the certificate",
+			Description:         "The subject of the certificate",
+			Computed:            true,
+			Attributes:          SubjectSchema(),
+		},
+		"issuer": schema.SingleNestedAttribute{
+			MarkdownDescription: "The issuer of the certificate",
+			Description:         "The issuer of the certificate",
+			Computed:            true,
+			Attributes:          SubjectSchema(),
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "The serial number of the certificate",
+			Description:         "The serial number of the certificate",
+			Computed:            true,
+		},
+		"fingerprint": schema.StringAttribute{
+			MarkdownDescription: "The fingerprint of the certificate",
+			Description:         "The fingerprint of the certificate",
+			Computed:            true,
+		},
+		"valid_not_before": schema.StringAttribute{
+			MarkdownDescription: "The date when the certificate becomes valid",
+			Description:         "The date when the certificate becomes valid",
+			Computed:            true,
+		},
+		"valid_not_after": schema.StringAttribute{
+			MarkdownDescription: "The date when the certificate is no longer valid",
+			Description:         "The date when the certificate is no longer valid",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SecureBootDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.SecureBootDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishSecureBoot(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishSecureBoot populates the Secure Boot state and its key databases in the datasource model
+func readDatasourceRedfishSecureBoot(service *gofish.Service, d models.SecureBootDatasource) (models.SecureBootDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	system, secureBoot, err := getSecureBoot(service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching Secure Boot", err.Error())
+		return d, diags
+	}
+
+	d.ID = types.StringValue(secureBoot.ID)
+	d.OdataID = types.StringValue(secureBoot.ODataID)
+	d.SystemID = types.StringValue(system.ID)
+	d.SecureBootEnable = types.BoolValue(secureBoot.SecureBootEnable)
+	d.SecureBootMode = types.StringValue(string(secureBoot.SecureBootMode))
+	d.SecureBootCurrentBoot = types.StringValue(string(secureBoot.SecureBootCurrentBoot))
+
+	databases, err := getSecureBootCertificates(secureBoot)
+	if err != nil {
+		diags.AddError("Error fetching the Secure Boot databases", err.Error())
+		return d, diags
+	}
+	databaseIDs := make([]string, 0, len(databases))
+	for databaseID := range databases {
+		databaseIDs = append(databaseIDs, databaseID)
+	}
+	sort.Strings(databaseIDs)
+
+	d.Databases = make([]models.SecureBootDatabase, 0, len(databases))
+	for _, databaseID := range databaseIDs {
+		database := databases[databaseID]
+		certificates := make([]models.SecureBootDatabaseCertificate, 0, len(database.certificates))
+		for _, certificate := range database.certificates {
+			certificates = append(certificates, newSecureBootDatabaseCertificate(certificate))
+		}
+		d.Databases = append(d.Databases, models.SecureBootDatabase{
+			OdataID:      types.StringValue(database.ODataID),
+			DatabaseID:   types.StringValue(databaseID),
+			Description:  types.StringValue(database.Description),
+			Certificates: certificates,
+		})
+	}
+	return d, diags
+}
+
+// newSecureBootDatabaseCertificate converts redfish.Certificate to models.SecureBootDatabaseCertificate
+func newSecureBootDatabaseCertificate(certificate *redfish.Certificate) models.SecureBootDatabaseCertificate {
+	return models.SecureBootDatabaseCertificate{
+		OdataID:           types.StringValue(certificate.ODataID),
+		ID:                types.StringValue(certificate.ID),
+		CertificateType:   types.StringValue(string(certificate.CertificateType)),
+		CertificateString: types.StringValue(certificate.CertificateString),
+		Subject:           newCertificateIdentifier(certificate.Subject),
+		Issuer:            newCertificateIdentifier(certificate.Issuer),
+		SerialNumber:      types.StringValue(certificate.SerialNumber),
+		Fingerprint:       types.StringValue(certificate.Fingerprint),
+		ValidNotBefore:    types.StringValue(certificate.ValidNotBefore),
+		ValidNotAfter:     types.StringValue(certificate.ValidNotAfter),
+	}
+}
+
+// newCertificateIdentifier converts redfish.CertificateIdentifier to models.Subject
+func newCertificateIdentifier(identifier redfish.CertificateIdentifier) models.Subject {
+	return models.Subject{
+		CommonName:         types.StringValue(identifier.CommonName),
+		Organization:       types.StringValue(identifier.Organization),
+		City:               types.StringValue(identifier.City),
+		Country:            types.StringValue(identifier.Country),
+		Email:              types.StringValue(identifier.Email),
+		OrganizationalUnit: types.StringValue(identifier.OrganizationalUnit),
+		State:              types.StringValue(identifier.State),
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to read the Secure Boot state and the key databases - Positive
+func TestAccRedfishSecureBootDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSecureBootConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_secure_boot.secure_boot", "secure_boot_enable"),
+					resource.TestCheckResourceAttrSet("data.redfish_secure_boot.secure_boot", "secure_boot_mode"),
+					resource.TestCheckResourceAttr("data.redfish_secure_boot.secure_boot", "system_id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet("data.redfish_secure_boot.secure_boot", "databases.0.database_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedfishDataSourceSecureBootConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+		data "redfish_secure_boot" "secure_boot" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  system_id = "System.Embedded.1"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
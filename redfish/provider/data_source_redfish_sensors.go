@@ -0,0 +1,887 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SensorsDatasource{}
+	_ datasource.DataSourceWithConfigure = &SensorsDatasource{}
+)
+
+// NewSensorsDatasource is new datasource for the thermal and power sensors of a chassis
+func NewSensorsDatasource() datasource.DataSource {
+	return &SensorsDatasource{}
+}
+
+// SensorsDatasource to construct datasource
+type SensorsDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SensorsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SensorsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "sensors"
+}
+
+// Schema implements datasource.DataSource
+func (*SensorsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the temperatures, fans, power supplies, power consumption" +
+			" and redundancy of a chassis. It reads the `Thermal` and `Power` resources and, on servers which no longer" +
+			" provide them, such as 17G servers, the `ThermalSubsystem`, `PowerSubsystem` and `Sensors` resources.",
+		Description: "This Terraform datasource is used to query the temperatures, fans, power supplies, power consumption" +
+			" and redundancy of a chassis. It reads the Thermal and Power resources and, on servers which no longer" +
+			" provide them, such as 17G servers, the ThermalSubsystem, PowerSubsystem and Sensors resources.",
+		Attributes: SensorsDatasourceSchema(),
+		Blocks: map[string]schema.Block{
+			"redfish_server": schema.ListNestedBlock{
+				MarkdownDescription: redfishServerMD,
+				Description:         redfishServerMD,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: RedfishServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SensorsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.SensorsDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishSensors(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishSensors populates the thermal and power readings of the chassis in the datasource model
+func readDatasourceRedfishSensors(service *gofish.Service, d models.SensorsDatasource) (models.SensorsDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+	d.Temperatures = []models.Temperature{}
+	d.Fans = []models.Fan{}
+	d.PowerSupplies = []models.PowerSupply{}
+	d.PowerControls = []models.PowerControl{}
+	d.Redundancy = []models.Redundancy{}
+	d.Sensors = []models.Sensor{}
+
+	chassis, err := getChassisResource(service, d.ChassisID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching chassis", err.Error())
+		return d, diags
+	}
+	d.ChassisID = types.StringValue(chassis.ID)
+
+	sensors, err := chassis.Sensors()
+	if err != nil {
+		diags.AddError("Error fetching sensors collection", err.Error())
+		return d, diags
+	}
+	for _, sensor := range sensors {
+		d.Sensors = append(d.Sensors, newSensor(sensor))
+	}
+
+	thermal, err := chassis.Thermal()
+	if err != nil {
+		diags.AddError("Error fetching thermal data", err.Error())
+		return d, diags
+	}
+	if thermal != nil {
+		for i := range thermal.Temperatures {
+			d.Temperatures = append(d.Temperatures, newTemperature(&thermal.Temperatures[i]))
+		}
+		for i := range thermal.Fans {
+			d.Fans = append(d.Fans, newFan(&thermal.Fans[i]))
+		}
+	} else {
+		// the Thermal resource is replaced by the ThermalSubsystem and Sensors resources on newer servers
+		d.Temperatures, d.Fans = thermalFromSensors(sensors)
+		thermalSubsystem, err := chassis.ThermalSubsystem()
+		if err != nil {
+			diags.AddError("Error fetching thermal subsystem", err.Error())
+			return d, diags
+		}
+		if thermalSubsystem != nil {
+			d.Redundancy = append(d.Redundancy, newRedundantGroups("FanRedundancy", thermalSubsystem.FanRedundancy)...)
+		}
+	}
+
+	power, err := chassis.Power()
+	if err != nil {
+		diags.AddError("Error fetching power data", err.Error())
+		return d, diags
+	}
+	if power != nil {
+		for i := range power.PowerSupplies {
+			d.PowerSupplies = append(d.PowerSupplies, newPowerSupply(&power.PowerSupplies[i]))
+		}
+		for i := range power.PowerControl {
+			d.PowerControls = append(d.PowerControls, newPowerControl(&power.PowerControl[i]))
+		}
+		for i := range power.Redundancy {
+			d.Redundancy = append(d.Redundancy, newRedundancy(&power.Redundancy[i]))
+		}
+		return d, diags
+	}
+
+	// the Power resource is replaced by the PowerSubsystem and EnvironmentMetrics resources on newer servers
+	powerSubsystem, err := chassis.PowerSubsystem()
+	if err != nil {
+		diags.AddError("Error fetching power subsystem", err.Error())
+		return d, diags
+	}
+	if powerSubsystem == nil {
+		return d, diags
+	}
+	dellPowerSubsystem, err := dell.PowerSubsystem(powerSubsystem)
+	if err != nil {
+		diags.AddError("Error fetching power subsystem", err.Error())
+		return d, diags
+	}
+	units, err := dellPowerSubsystem.PowerSupplyUnits()
+	if err != nil {
+		diags.AddError("Error fetching power supplies collection", err.Error())
+		return d, diags
+	}
+	for _, unit := range units {
+		metrics, err := unit.Metrics()
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error fetching metrics of the power supply %s", unit.ID), err.Error())
+			return d, diags
+		}
+		d.PowerSupplies = append(d.PowerSupplies, newPowerSupplyUnit(unit, metrics))
+	}
+	environmentMetrics, err := chassis.EnvironmentMetrics()
+	if err != nil {
+		diags.AddError("Error fetching environment metrics", err.Error())
+		return d, diags
+	}
+	d.PowerControls = append(d.PowerControls, newPowerSubsystemControl(powerSubsystem, environmentMetrics))
+	d.Redundancy = append(d.Redundancy, newRedundantGroups("PowerSupplyRedundancy", powerSubsystem.PowerSupplyRedundancy)...)
+	return d, diags
+}
+
+// thermalFromSensors returns the temperatures and fans of the temperature and rotational sensors
+func thermalFromSensors(sensors []*redfish.Sensor) ([]models.Temperature, []models.Fan) {
+	temperatures := []models.Temperature{}
+	fans := []models.Fan{}
+	for _, sensor := range sensors {
+		switch sensor.ReadingType {
+		case redfish.TemperatureReadingType:
+			temperatures = append(temperatures, models.Temperature{
+				ID:                        types.StringValue(sensor.ID),
+				Name:                      types.StringValue(sensor.Name),
+				PhysicalContext:           types.StringValue(string(sensor.PhysicalContext)),
+				ReadingCelsius:            float32Value(sensor.Reading),
+				UpperThresholdNonCritical: float32Value(sensor.Thresholds.UpperCaution.Reading),
+				UpperThresholdCritical:    float32Value(sensor.Thresholds.UpperCritical.Reading),
+				UpperThresholdFatal:       float32Value(sensor.Thresholds.UpperFatal.Reading),
+				LowerThresholdNonCritical: float32Value(sensor.Thresholds.LowerCaution.Reading),
+				LowerThresholdCritical:    float32Value(sensor.Thresholds.LowerCritical.Reading),
+				LowerThresholdFatal:       float32Value(sensor.Thresholds.LowerFatal.Reading),
+				Status:                    newStatus(sensor.Status),
+			})
+		case redfish.RotationalReadingType:
+			fans = append(fans, models.Fan{
+				ID:                        types.StringValue(sensor.ID),
+				Name:                      types.StringValue(sensor.Name),
+				PhysicalContext:           types.StringValue(string(sensor.PhysicalContext)),
+				Reading:                   float32Value(sensor.Reading),
+				ReadingUnits:              types.StringValue(sensor.ReadingUnits),
+				UpperThresholdNonCritical: float32Value(sensor.Thresholds.UpperCaution.Reading),
+				UpperThresholdCritical:    float32Value(sensor.Thresholds.UpperCritical.Reading),
+				UpperThresholdFatal:       float32Value(sensor.Thresholds.UpperFatal.Reading),
+				LowerThresholdNonCritical: float32Value(sensor.Thresholds.LowerCaution.Reading),
+				LowerThresholdCritical:    float32Value(sensor.Thresholds.LowerCritical.Reading),
+				LowerThresholdFatal:       float32Value(sensor.Thresholds.LowerFatal.Reading),
+				Status:                    newStatus(sensor.Status),
+			})
+		}
+	}
+	return temperatures, fans
+}
+
+// newSensor converts redfish.Sensor to models.Sensor
+func newSensor(sensor *redfish.Sensor) models.Sensor {
+	return models.Sensor{
+		ID:              types.StringValue(sensor.ID),
+		Name:            types.StringValue(sensor.Name),
+		PhysicalContext: types.StringValue(string(sensor.PhysicalContext)),
+		ReadingType:     types.StringValue(string(sensor.ReadingType)),
+		Reading:         float32Value(sensor.Reading),
+		ReadingUnits:    types.StringValue(sensor.ReadingUnits),
+		Thresholds: models.SensorThresholds{
+			UpperCaution:  float32Value(sensor.Thresholds.UpperCaution.Reading),
+			UpperCritical: float32Value(sensor.Thresholds.UpperCritical.Reading),
+			UpperFatal:    float32Value(sensor.Thresholds.UpperFatal.Reading),
+			LowerCaution:  float32Value(sensor.Thresholds.LowerCaution.Reading),
+			LowerCritical: float32Value(sensor.Thresholds.LowerCritical.Reading),
+			LowerFatal:    float32Value(sensor.Thresholds.LowerFatal.Reading),
+		},
+		Status: newStatus(sensor.Status),
+	}
+}
+
+// newTemperature converts redfish.Temperature to models.Temperature
+func newTemperature(temperature *redfish.Temperature) models.Temperature {
+	return models.Temperature{
+		ID:                        types.StringValue(temperature.MemberID),
+		Name:                      types.StringValue(temperature.Name),
+		PhysicalContext:           types.StringValue(string(temperature.PhysicalContext)),
+		ReadingCelsius:            float32Value(temperature.ReadingCelsius),
+		UpperThresholdNonCritical: float32Value(temperature.UpperThresholdNonCritical),
+		UpperThresholdCritical:    float32Value(temperature.UpperThresholdCritical),
+		UpperThresholdFatal:       float32Value(temperature.UpperThresholdFatal),
+		LowerThresholdNonCritical: float32Value(temperature.LowerThresholdNonCritical),
+		LowerThresholdCritical:    float32Value(temperature.LowerThresholdCritical),
+		LowerThresholdFatal:       float32Value(temperature.LowerThresholdFatal),
+		Status:                    newStatus(temperature.Status),
+	}
+}
+
+// newFan converts redfish.ThermalFan to models.Fan
+func newFan(fan *redfish.ThermalFan) models.Fan {
+	return models.Fan{
+		ID:                        types.StringValue(fan.MemberID),
+		Name:                      types.StringValue(fan.Name),
+		PhysicalContext:           types.StringValue(string(fan.PhysicalContext)),
+		Reading:                   types.Float64Value(float64(fan.Reading)),
+		ReadingUnits:              types.StringValue(string(fan.ReadingUnits)),
+		UpperThresholdNonCritical: types.Float64Value(float64(fan.UpperThresholdNonCritical)),
+		UpperThresholdCritical:    types.Float64Value(float64(fan.UpperThresholdCritical)),
+		UpperThresholdFatal:       types.Float64Value(float64(fan.UpperThresholdFatal)),
+		LowerThresholdNonCritical: types.Float64Value(float64(fan.LowerThresholdNonCritical)),
+		LowerThresholdCritical:    types.Float64Value(float64(fan.LowerThresholdCritical)),
+		LowerThresholdFatal:       types.Float64Value(float64(fan.LowerThresholdFatal)),
+		Status:                    newStatus(fan.Status),
+	}
+}
+
+// newPowerSupply converts redfish.PowerSupply to models.PowerSupply
+func newPowerSupply(powerSupply *redfish.PowerSupply) models.PowerSupply {
+	return models.PowerSupply{
+		ID:                   types.StringValue(powerSupply.MemberID),
+		Name:                 types.StringValue(powerSupply.Name),
+		Manufacturer:         types.StringValue(powerSupply.Manufacturer),
+		Model:                types.StringValue(powerSupply.Model),
+		SerialNumber:         types.StringValue(powerSupply.SerialNumber),
+		PartNumber:           types.StringValue(powerSupply.PartNumber),
+		FirmwareVersion:      types.StringValue(powerSupply.FirmwareVersion),
+		PowerCapacityWatts:   float32Value(powerSupply.PowerCapacityWatts),
+		PowerInputWatts:      float32Value(powerSupply.PowerInputWatts),
+		PowerOutputWatts:     float32Value(powerSupply.PowerOutputWatts),
+		LastPowerOutputWatts: float32Value(powerSupply.LastPowerOutputWatts),
+		LineInputVoltage:     float32Value(powerSupply.LineInputVoltage),
+		Status:               newStatus(powerSupply.Status),
+	}
+}
+
+// newPowerSupplyUnit converts redfish.PowerSupplyUnit of a power subsystem and its metrics to models.PowerSupply
+func newPowerSupplyUnit(unit *redfish.PowerSupplyUnit, metrics *redfish.PowerSupplyUnitMetrics) models.PowerSupply {
+	if metrics == nil {
+		metrics = &redfish.PowerSupplyUnitMetrics{}
+	}
+	return models.PowerSupply{
+		ID:                   types.StringValue(unit.ID),
+		Name:                 types.StringValue(unit.Name),
+		Manufacturer:         types.StringValue(unit.Manufacturer),
+		Model:                types.StringValue(unit.Model),
+		SerialNumber:         types.StringValue(unit.SerialNumber),
+		PartNumber:           types.StringValue(unit.PartNumber),
+		FirmwareVersion:      types.StringValue(unit.FirmwareVersion),
+		PowerCapacityWatts:   float32Value(unit.PowerCapacityWatts),
+		PowerInputWatts:      float32Value(metrics.InputPowerWatts.Reading),
+		PowerOutputWatts:     float32Value(metrics.OutputPowerWatts.Reading),
+		LastPowerOutputWatts: float32Value(metrics.OutputPowerWatts.Reading),
+		LineInputVoltage:     float32Value(metrics.InputVoltage.Reading),
+		Status:               newStatus(unit.Status),
+	}
+}
+
+// newPowerControl converts redfish.PowerControl to models.PowerControl
+func newPowerControl(control *redfish.PowerControl) models.PowerControl {
+	return models.PowerControl{
+		ID:                   types.StringValue(control.MemberID),
+		Name:                 types.StringValue(control.Name),
+		PowerConsumedWatts:   float32Value(control.PowerConsumedWatts),
+		PowerCapacityWatts:   float32Value(control.PowerCapacityWatts),
+		PowerAllocatedWatts:  float32Value(control.PowerAllocatedWatts),
+		PowerAvailableWatts:  float32Value(control.PowerAvailableWatts),
+		PowerRequestedWatts:  float32Value(control.PowerRequestedWatts),
+		AverageConsumedWatts: float32Value(control.PowerMetrics.AverageConsumedWatts),
+		MinConsumedWatts:     float32Value(control.PowerMetrics.MinConsumedWatts),
+		MaxConsumedWatts:     float32Value(control.PowerMetrics.MaxConsumedWatts),
+		Status:               newStatus(control.Status),
+	}
+}
+
+// newPowerSubsystemControl returns the power control of a power subsystem, with the power consumption
+// of the environment metrics of the chassis
+func newPowerSubsystemControl(powerSubsystem *redfish.PowerSubsystem, environmentMetrics *redfish.EnvironmentMetrics) models.PowerControl {
+	if environmentMetrics == nil {
+		environmentMetrics = &redfish.EnvironmentMetrics{}
+	}
+	return models.PowerControl{
+		ID:                   types.StringValue(powerSubsystem.ID),
+		Name:                 types.StringValue(powerSubsystem.Name),
+		PowerConsumedWatts:   float32Value(environmentMetrics.PowerWatts.Reading),
+		PowerCapacityWatts:   types.Float64Value(powerSubsystem.CapacityWatts),
+		PowerAllocatedWatts:  types.Float64Value(powerSubsystem.Allocation.AllocatedWatts),
+		PowerAvailableWatts:  types.Float64Value(max(powerSubsystem.CapacityWatts-powerSubsystem.Allocation.AllocatedWatts, 0)),
+		PowerRequestedWatts:  types.Float64Value(powerSubsystem.Allocation.RequestedWatts),
+		AverageConsumedWatts: types.Float64Null(),
+		MinConsumedWatts:     types.Float64Null(),
+		MaxConsumedWatts:     types.Float64Null(),
+		Status:               newStatus(powerSubsystem.Status),
+	}
+}
+
+// newRedundancy converts redfish.Redundancy to models.Redundancy
+func newRedundancy(redundancy *redfish.Redundancy) models.Redundancy {
+	return models.Redundancy{
+		ID:              types.StringValue(redundancy.MemberID),
+		Name:            types.StringValue(redundancy.Name),
+		Mode:            types.StringValue(string(redundancy.Mode)),
+		MinNumNeeded:    types.Int64Value(int64(redundancy.MinNumNeeded)),
+		MaxNumSupported: types.Int64Value(int64(redundancy.MaxNumSupported)),
+		Status:          newStatus(redundancy.Status),
+	}
+}
+
+// newRedundantGroups converts the redfish.RedundantGroup of a subsystem to models.Redundancy
+func newRedundantGroups(name string, groups []redfish.RedundantGroup) []models.Redundancy {
+	redundancy := []models.Redundancy{}
+	for i, group := range groups {
+		redundancy = append(redundancy, models.Redundancy{
+			ID:              types.StringValue(strconv.Itoa(i)),
+			Name:            types.StringValue(name),
+			Mode:            types.StringValue(string(group.RedundancyType)),
+			MinNumNeeded:    types.Int64Value(group.MinNeededInGroup),
+			MaxNumSupported: types.Int64Value(group.MaxSupportedInGroup),
+			Status:          newStatus(group.Status),
+		})
+	}
+	return redundancy
+}
+
+// float32Value converts a reading to types.Float64 without the float32 rounding noise, so 12.2 stays 12.2
+func float32Value(value float32) types.Float64 {
+	converted, _ := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	return types.Float64Value(converted)
+}
+
+// SensorsDatasourceSchema to define the sensors data-source schema
+func SensorsDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the sensors data-source",
+			Description:         "ID of the sensors data-source",
+			Computed:            true,
+		},
+		"chassis_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis whose sensors are read, for example `System.Embedded.1`. Defaults to the first chassis.",
+			Description:         "ID of the chassis whose sensors are read, for example System.Embedded.1. Defaults to the first chassis.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"temperatures": schema.ListNestedAttribute{
+			MarkdownDescription: "Temperatures of the chassis.",
+			Description:         "Temperatures of the chassis.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: TemperatureSchema(),
+			},
+		},
+		"fans": schema.ListNestedAttribute{
+			MarkdownDescription: "Fans of the chassis.",
+			Description:         "Fans of the chassis.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: FanSchema(),
+			},
+		},
+		"power_supplies": schema.ListNestedAttribute{
+			MarkdownDescription: "Power supplies of the chassis, with their capacity and their input and output power.",
+			Description:         "Power supplies of the chassis, with their capacity and their input and output power.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: PowerSupplySchema(),
+			},
+		},
+		"power_controls": schema.ListNestedAttribute{
+			MarkdownDescription: "Power consumption and capacity of the chassis.",
+			Description:         "Power consumption and capacity of the chassis.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: PowerControlSchema(),
+			},
+		},
+		"redundancy": schema.ListNestedAttribute{
+			MarkdownDescription: "Redundancy groups of the power supplies and fans of the chassis.",
+			Description:         "Redundancy groups of the power supplies and fans of the chassis.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: RedundancySchema(),
+			},
+		},
+		"sensors": schema.ListNestedAttribute{
+			MarkdownDescription: "Sensors of the `Sensors` collection of the chassis, when the server provides it.",
+			Description:         "Sensors of the Sensors collection of the chassis, when the server provides it.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: SensorSchema(),
+			},
+		},
+	}
+}
+
+// TemperatureSchema to define the temperature schema
+func TemperatureSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the temperature sensor",
+			Description:         "ID of the temperature sensor",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the temperature sensor",
+			Description:         "Name of the temperature sensor",
+			Computed:            true,
+		},
+		"physical_context": schema.StringAttribute{
+			MarkdownDescription: "Area or device the temperature sensor measures, such as CPU or Intake",
+			Description:         "Area or device the temperature sensor measures, such as CPU or Intake",
+			Computed:            true,
+		},
+		"reading_celsius": schema.Float64Attribute{
+			MarkdownDescription: "Temperature in degrees Celsius",
+			Description:         "Temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"upper_threshold_non_critical": schema.Float64Attribute{
+			MarkdownDescription: "Upper non-critical threshold of the temperature in degrees Celsius",
+			Description:         "Upper non-critical threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"upper_threshold_critical": schema.Float64Attribute{
+			MarkdownDescription: "Upper critical threshold of the temperature in degrees Celsius",
+			Description:         "Upper critical threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"upper_threshold_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Upper fatal threshold of the temperature in degrees Celsius",
+			Description:         "Upper fatal threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"lower_threshold_non_critical": schema.Float64Attribute{
+			MarkdownDescription: "Lower non-critical threshold of the temperature in degrees Celsius",
+			Description:         "Lower non-critical threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"lower_threshold_critical": schema.Float64Attribute{
+			MarkdownDescription: "Lower critical threshold of the temperature in degrees Celsius",
+			Description:         "Lower critical threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"lower_threshold_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Lower fatal threshold of the temperature in degrees Celsius",
+			Description:         "Lower fatal threshold of the temperature in degrees Celsius",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the temperature sensor",
+			Description:         "The status and health of the temperature sensor",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// FanSchema to define the fan schema
+func FanSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the fan",
+			Description:         "ID of the fan",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the fan",
+			Description:         "Name of the fan",
+			Computed:            true,
+		},
+		"physical_context": schema.StringAttribute{
+			MarkdownDescription: "Area or device the fan cools",
+			Description:         "Area or device the fan cools",
+			Computed:            true,
+		},
+		"reading": schema.Float64Attribute{
+			MarkdownDescription: "Speed of the fan, in the unit of reading_units",
+			Description:         "Speed of the fan, in the unit of reading_units",
+			Computed:            true,
+		},
+		"reading_units": schema.StringAttribute{
+			MarkdownDescription: "Unit of the fan speed, such as RPM or Percent",
+			Description:         "Unit of the fan speed, such as RPM or Percent",
+			Computed:            true,
+		},
+		"upper_threshold_non_critical": schema.Float64Attribute{
+			MarkdownDescription: "Upper non-critical threshold of the fan speed",
+			Description:         "Upper non-critical threshold of the fan speed",
+			Computed:            true,
+		},
+		"upper_threshold_critical": schema.Float64Attribute{
+			MarkdownDescription: "Upper critical threshold of the fan speed",
+			Description:         "Upper critical threshold of the fan speed",
+			Computed:            true,
+		},
+		"upper_threshold_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Upper fatal threshold of the fan speed",
+			Description:         "Upper fatal threshold of the fan speed",
+			Computed:            true,
+		},
+		"lower_threshold_non_critical": schema.Float64Attribute{
+			MarkdownDescription: "Lower non-critical threshold of the fan speed",
+			Description:         "Lower non-critical threshold of the fan speed",
+			Computed:            true,
+		},
+		"lower_threshold_critical": schema.Float64Attribute{
+			MarkdownDescription: "Lower critical threshold of the fan speed",
+			Description:         "Lower critical threshold of the fan speed",
+			Computed:            true,
+		},
+		"lower_threshold_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Lower fatal threshold of the fan speed",
+			Description:         "Lower fatal threshold of the fan speed",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the fan",
+			Description:         "The status and health of the fan",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// PowerSupplySchema to define the power supply schema
+func PowerSupplySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the power supply",
+			Description:         "ID of the power supply",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the power supply",
+			Description:         "Name of the power supply",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the power supply",
+			Description:         "Manufacturer of the power supply",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the power supply",
+			Description:         "Model of the power supply",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the power supply",
+			Description:         "Serial number of the power supply",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the power supply",
+			Description:         "Part number of the power supply",
+			Computed:            true,
+		},
+		"firmware_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version of the power supply",
+			Description:         "Firmware version of the power supply",
+			Computed:            true,
+		},
+		"power_capacity_watts": schema.Float64Attribute{
+			MarkdownDescription: "Maximum output power of the power supply in watts",
+			Description:         "Maximum output power of the power supply in watts",
+			Computed:            true,
+		},
+		"power_input_watts": schema.Float64Attribute{
+			MarkdownDescription: "Input power of the power supply in watts",
+			Description:         "Input power of the power supply in watts",
+			Computed:            true,
+		},
+		"power_output_watts": schema.Float64Attribute{
+			MarkdownDescription: "Output power of the power supply in watts",
+			Description:         "Output power of the power supply in watts",
+			Computed:            true,
+		},
+		"last_power_output_watts": schema.Float64Attribute{
+			MarkdownDescription: "Average output power of the power supply over the last sampling interval in watts",
+			Description:         "Average output power of the power supply over the last sampling interval in watts",
+			Computed:            true,
+		},
+		"line_input_voltage": schema.Float64Attribute{
+			MarkdownDescription: "Line input voltage of the power supply in volts",
+			Description:         "Line input voltage of the power supply in volts",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the power supply",
+			Description:         "The status and health of the power supply",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// PowerControlSchema to define the power control schema
+func PowerControlSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the power control",
+			Description:         "ID of the power control",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the power control",
+			Description:         "Name of the power control",
+			Computed:            true,
+		},
+		"power_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Power consumed by the chassis in watts",
+			Description:         "Power consumed by the chassis in watts",
+			Computed:            true,
+		},
+		"power_capacity_watts": schema.Float64Attribute{
+			MarkdownDescription: "Total power capacity available for allocation in watts",
+			Description:         "Total power capacity available for allocation in watts",
+			Computed:            true,
+		},
+		"power_allocated_watts": schema.Float64Attribute{
+			MarkdownDescription: "Power allocated to the chassis in watts",
+			Description:         "Power allocated to the chassis in watts",
+			Computed:            true,
+		},
+		"power_available_watts": schema.Float64Attribute{
+			MarkdownDescription: "Power available for allocation in watts",
+			Description:         "Power available for allocation in watts",
+			Computed:            true,
+		},
+		"power_requested_watts": schema.Float64Attribute{
+			MarkdownDescription: "Power requested by the chassis in watts",
+			Description:         "Power requested by the chassis in watts",
+			Computed:            true,
+		},
+		"average_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Average power consumption over the metrics interval in watts",
+			Description:         "Average power consumption over the metrics interval in watts",
+			Computed:            true,
+		},
+		"min_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Minimum power consumption over the metrics interval in watts",
+			Description:         "Minimum power consumption over the metrics interval in watts",
+			Computed:            true,
+		},
+		"max_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Maximum power consumption over the metrics interval in watts",
+			Description:         "Maximum power consumption over the metrics interval in watts",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the power control",
+			Description:         "The status and health of the power control",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// RedundancySchema to define the redundancy schema
+func RedundancySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the redundancy group",
+			Description:         "ID of the redundancy group",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the redundancy group",
+			Description:         "Name of the redundancy group",
+			Computed:            true,
+		},
+		"mode": schema.StringAttribute{
+			MarkdownDescription: "Redundancy mode of the group, such as N+m or Sparing",
+			Description:         "Redundancy mode of the group, such as N+m or Sparing",
+			Computed:            true,
+		},
+		"min_num_needed": schema.Int64Attribute{
+			MarkdownDescription: "Minimum number of members needed for the group to be redundant",
+			Description:         "Minimum number of members needed for the group to be redundant",
+			Computed:            true,
+		},
+		"max_num_supported": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of members supported in the group",
+			Description:         "Maximum number of members supported in the group",
+			Computed:            true,
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the redundancy group",
+			Description:         "The status and health of the redundancy group",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// SensorSchema to define the sensor schema
+func SensorSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the sensor",
+			Description:         "ID of the sensor",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the sensor",
+			Description:         "Name of the sensor",
+			Computed:            true,
+		},
+		"physical_context": schema.StringAttribute{
+			MarkdownDescription: "Area or device the sensor measures",
+			Description:         "Area or device the sensor measures",
+			Computed:            true,
+		},
+		"reading_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the reading of the sensor, such as Temperature, Rotational or Power",
+			Description:         "Type of the reading of the sensor, such as Temperature, Rotational or Power",
+			Computed:            true,
+		},
+		"reading": schema.Float64Attribute{
+			MarkdownDescription: "Reading of the sensor, in the unit of reading_units",
+			Description:         "Reading of the sensor, in the unit of reading_units",
+			Computed:            true,
+		},
+		"reading_units": schema.StringAttribute{
+			MarkdownDescription: "Unit of the reading of the sensor",
+			Description:         "Unit of the reading of the sensor",
+			Computed:            true,
+		},
+		"thresholds": schema.SingleNestedAttribute{
+			MarkdownDescription: "Thresholds of the reading of the sensor",
+			Description:         "Thresholds of the reading of the sensor",
+			Computed:            true,
+			Attributes:          SensorThresholdsSchema(),
+		},
+		"status": schema.SingleNestedAttribute{
+			MarkdownDescription: "The status and health of the sensor",
+			Description:         "The status and health of the sensor",
+			Computed:            true,
+			Attributes:          StatusSchema(),
+		},
+	}
+}
+
+// SensorThresholdsSchema to define the sensor thresholds schema
+func SensorThresholdsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"upper_caution": schema.Float64Attribute{
+			MarkdownDescription: "Upper caution threshold of the reading",
+			Description:         "Upper caution threshold of the reading",
+			Computed:            true,
+		},
+		"upper_critical": schema.Float64Attribute{
+			MarkdownDescription: "Upper critical threshold of the reading",
+			Description:         "Upper critical threshold of the reading",
+			Computed:            true,
+		},
+		"upper_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Upper fatal threshold of the reading",
+			Description:         "Upper fatal threshold of the reading",
+			Computed:            true,
+		},
+		"lower_caution": schema.Float64Attribute{
+			MarkdownDescription: "Lower caution threshold of the reading",
+			Description:         "Lower caution threshold of the reading",
+			Computed:            true,
+		},
+		"lower_critical": schema.Float64Attribute{
+			MarkdownDescription: "Lower critical threshold of the reading",
+			Description:         "Lower critical threshold of the reading",
+			Computed:            true,
+		},
+		"lower_fatal": schema.Float64Attribute{
+			MarkdownDescription: "Lower fatal threshold of the reading",
+			Description:         "Lower fatal threshold of the reading",
+			Computed:            true,
+		},
+	}
+}
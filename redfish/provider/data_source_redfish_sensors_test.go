@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to fetch the sensors of the chassis - Positive
+func TestAccRedfishSensorsDataSource_fetch(t *testing.T) {
+	dsName := "data.redfish_sensors.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSensorsConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "chassis_id"),
+					resource.TestCheckResourceAttrSet(dsName, "temperatures.#"),
+					resource.TestCheckResourceAttrSet(dsName, "power_supplies.#"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceSensorsConfig(creds, `chassis_id = "System.Embedded.1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "chassis_id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet(dsName, "temperatures.0.reading_celsius"),
+					resource.TestCheckResourceAttrSet(dsName, "fans.0.reading"),
+					resource.TestCheckResourceAttrSet(dsName, "power_supplies.0.power_capacity_watts"),
+					resource.TestCheckResourceAttrSet(dsName, "power_controls.0.power_consumed_watts"),
+				),
+			},
+		},
+	})
+}
+
+// Test to fetch the sensors of an invalid chassis - Negative
+func TestAccRedfishSensorsDataSource_invalidChassis(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceSensorsConfig(creds, `chassis_id = "System.Invalid.1"`),
+				ExpectError: regexp.MustCompile(`.*Error fetching chassis*.`),
+			},
+		},
+	})
+}
+
+func TestThermalFromSensors(t *testing.T) {
+	sensors := []*redfish.Sensor{
+		{
+			Entity:          common.Entity{ID: "CPU1Temp", Name: "CPU1 Temp"},
+			PhysicalContext: common.CPUPhysicalContext,
+			ReadingType:     redfish.TemperatureReadingType,
+			Reading:         45,
+			ReadingUnits:    "Cel",
+			Thresholds: redfish.Thresholds{
+				UpperCaution:  redfish.Threshold{Reading: 95},
+				UpperCritical: redfish.Threshold{Reading: 100},
+			},
+		},
+		{
+			Entity:       common.Entity{ID: "Fan1A", Name: "Fan 1A"},
+			ReadingType:  redfish.RotationalReadingType,
+			Reading:      7560,
+			ReadingUnits: "RPM",
+		},
+		{
+			Entity:      common.Entity{ID: "PS1Voltage", Name: "PS1 Voltage"},
+			ReadingType: redfish.VoltageReadingType,
+			Reading:     12.2,
+		},
+	}
+
+	temperatures, fans := thermalFromSensors(sensors)
+	if len(temperatures) != 1 || len(fans) != 1 {
+		t.Fatalf("Expected one temperature and one fan, got %d and %d", len(temperatures), len(fans))
+	}
+	if temperatures[0].ID.ValueString() != "CPU1Temp" || temperatures[0].ReadingCelsius.ValueFloat64() != 45 {
+		t.Fatalf("Unexpected temperature %v", temperatures[0])
+	}
+	if temperatures[0].UpperThresholdNonCritical.ValueFloat64() != 95 || temperatures[0].UpperThresholdCritical.ValueFloat64() != 100 {
+		t.Fatalf("Expected the caution and critical thresholds, got %v", temperatures[0])
+	}
+	if fans[0].Reading.ValueFloat64() != 7560 || fans[0].ReadingUnits.ValueString() != "RPM" {
+		t.Fatalf("Unexpected fan %v", fans[0])
+	}
+}
+
+func TestFloat32Value(t *testing.T) {
+	if got := float32Value(12.2).ValueFloat64(); got != 12.2 {
+		t.Fatalf("Expected 12.2, got %v", got)
+	}
+	if got := float32Value(750).ValueFloat64(); got != 750 {
+		t.Fatalf("Expected 750, got %v", got)
+	}
+}
+
+func TestNewPowerSubsystemControl(t *testing.T) {
+	powerSubsystem := &redfish.PowerSubsystem{
+		Entity:        common.Entity{ID: "PowerSubsystem", Name: "Power Subsystem"},
+		CapacityWatts: 1400,
+		Allocation:    redfish.PowerAllocation{AllocatedWatts: 600, RequestedWatts: 650},
+	}
+	environmentMetrics := &redfish.EnvironmentMetrics{PowerWatts: redfish.SensorPowerExcerpt{Reading: 312}}
+
+	control := newPowerSubsystemControl(powerSubsystem, environmentMetrics)
+	if control.PowerConsumedWatts.ValueFloat64() != 312 || control.PowerCapacityWatts.ValueFloat64() != 1400 {
+		t.Fatalf("Unexpected power control %v", control)
+	}
+	if control.PowerAvailableWatts.ValueFloat64() != 800 {
+		t.Fatalf("Expected 800 available watts, got %v", control.PowerAvailableWatts)
+	}
+	if !control.AverageConsumedWatts.IsNull() {
+		t.Fatalf("Expected no average consumption, got %v", control.AverageConsumedWatts)
+	}
+
+	// a chassis without environment metrics reports no consumption
+	if got := newPowerSubsystemControl(powerSubsystem, nil).PowerConsumedWatts.ValueFloat64(); got != 0 {
+		t.Fatalf("Expected no consumption, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceSensorsConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+	data "redfish_sensors" "test" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
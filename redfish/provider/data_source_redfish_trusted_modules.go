@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &TrustedModulesDatasource{}
+	_ datasource.DataSourceWithConfigure = &TrustedModulesDatasource{}
+)
+
+// NewTrustedModulesDatasource is new datasource for the trusted modules of the computer system
+func NewTrustedModulesDatasource() datasource.DataSource {
+	return &TrustedModulesDatasource{}
+}
+
+// TrustedModulesDatasource to construct datasource
+type TrustedModulesDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *TrustedModulesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*TrustedModulesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "trusted_modules"
+}
+
+// Schema implements datasource.DataSource
+func (*TrustedModulesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the trusted modules, such as the TPM, of the computer system." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the trusted modules, such as the TPM, of the computer system." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: TrustedModulesDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// TrustedModulesDatasourceSchema to define the trusted modules data-source schema
+func TrustedModulesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the trusted modules data-source",
+			Description:         "ID of the trusted modules data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"trusted_modules": schema.ListNestedAttribute{
+			MarkdownDescription: "The trusted modules of the system",
+			Description:         "The trusted modules of the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: TrustedModuleSchema(),
+			},
+		},
+	}
+}
+
+// TrustedModuleSchema to define the schema of a trusted module
+func TrustedModuleSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"interface_type": schema.StringAttribute{
+			MarkdownDescription: "The interface type of the trusted module, such as TPM1_2 or TPM2_0",
+			Description:         "The interface type of the trusted module, such as TPM1_2 or TPM2_0",
+			Computed:            true,
+		},
+		"interface_type_selection": schema.StringAttribute{
+			MarkdownDescription: "The method used to switch the interface type of the trusted module",
+			Description:         "The method used to switch the interface type of the trusted module",
+			Computed:            true,
+		},
+		"firmware_version": schema.StringAttribute{
+			MarkdownDescription: "The firmware version of the trusted module",
+			Description:         "The firmware version of the trusted module",
+			Computed:            true,
+		},
+		"firmware_version2": schema.StringAttribute{
+			MarkdownDescription: "The second firmware version of the trusted module, when it has one",
+			Description:         "The second firmware version of the trusted module, when it has one",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "The state of the trusted module, such as Enabled or Disabled",
+			Description:         "The state of the trusted module, such as Enabled or Disabled",
+			Computed:            true,
+		},
+		"health": schema.StringAttribute{
+			MarkdownDescription: "The health of the trusted module",
+			Description:         "The health of the trusted module",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *TrustedModulesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.TrustedModulesDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unlock, err := rLockRedfishServer(ctx, g.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	state, diags := readDatasourceRedfishTrustedModules(api.Service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readDatasourceRedfishTrustedModules populates the trusted modules of the system in the datasource model
+func readDatasourceRedfishTrustedModules(service *gofish.Service, d models.TrustedModulesDatasource,
+) (models.TrustedModulesDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	system, err := getSystemResource(service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching the computer system", err.Error())
+		return d, diags
+	}
+
+	d.ID = types.StringValue(system.ODataID)
+	d.SystemID = types.StringValue(system.ID)
+	d.TrustedModules = make([]models.TrustedModule, 0, len(system.TrustedModules))
+	for _, module := range system.TrustedModules {
+		d.TrustedModules = append(d.TrustedModules, newTrustedModule(module))
+	}
+	return d, diags
+}
+
+// newTrustedModule converts redfish.TrustedModules to models.TrustedModule
+func newTrustedModule(module redfish.TrustedModules) models.TrustedModule {
+	return models.TrustedModule{
+		InterfaceType:          types.StringValue(string(module.InterfaceType)),
+		InterfaceTypeSelection: types.StringValue(string(module.InterfaceTypeSelection)),
+		FirmwareVersion:        types.StringValue(module.FirmwareVersion),
+		FirmwareVersion2:       types.StringValue(module.FirmwareVersion2),
+		State:                  types.StringValue(string(module.Status.State)),
+		Health:                 types.StringValue(string(module.Status.Health)),
+	}
+}
@@ -102,6 +102,13 @@ func (g *DellVirtualMediaDatasource) Read(ctx context.Context, req datasource.Re
 	if state.ID.IsUnknown() {
 		state.ID = types.StringValue("placeholder")
 	}
+	unlock, err := rLockRedfishServer(ctx, g.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	api, err := NewConfig(g.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
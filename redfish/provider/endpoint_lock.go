@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+)
+
+const (
+	// lockServerErrorMsg is the summary of the diagnostics raised when a server lock cannot be acquired
+	lockServerErrorMsg = "Unable to lock the server"
+)
+
+// defaultPorts maps the endpoint schemes to the port used when none is given
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// normalizeEndpoint returns the canonical form of a BMC endpoint, so that every way of
+// writing the address of a server (case, default port, trailing slash, IPv6 brackets)
+// identifies it the same way.
+func normalizeEndpoint(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint is empty")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return "", fmt.Errorf("invalid endpoint %s: no host", endpoint)
+	}
+	port := parsed.Port()
+	if port == "" {
+		port = defaultPorts[scheme]
+	}
+	if port == "" {
+		return scheme + "://" + host, nil
+	}
+	// JoinHostPort brackets IPv6 addresses
+	return scheme + "://" + net.JoinHostPort(host, port), nil
+}
+
+// resolveEndpoint returns the normalized endpoint of the server, looking it up in the
+// provider's `redfish_servers` when `redfish_alias` is set.
+func resolveEndpoint(pconfig *redfishProvider, rserver []models.RedfishServer) (string, error) {
+	if len(rserver) == 0 {
+		return "", fmt.Errorf("no provider block was found")
+	}
+	server := rserver[0]
+	if err := getActiveAliasRedfishServer(pconfig, &server); err != nil {
+		return "", err
+	}
+	return normalizeEndpoint(server.Endpoint.ValueString())
+}
+
+// lockRedfishServer waits for exclusive access to the server, for operations changing it,
+// and returns the function releasing the lock.
+func lockRedfishServer(ctx context.Context, pconfig *redfishProvider, rserver []models.RedfishServer) (func(), error) {
+	endpoint, err := resolveEndpoint(pconfig, rserver)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := pconfig.lockContext(ctx)
+	defer cancel()
+	if err := redfishMutexKV.LockContext(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return func() { redfishMutexKV.Unlock(endpoint) }, nil
+}
+
+// rLockRedfishServer waits for shared access to the server, for read-only operations which can
+// run in parallel with each other but not with a change, and returns the function releasing the lock.
+func rLockRedfishServer(ctx context.Context, pconfig *redfishProvider, rserver []models.RedfishServer) (func(), error) {
+	endpoint, err := resolveEndpoint(pconfig, rserver)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := pconfig.lockContext(ctx)
+	defer cancel()
+	if err := redfishMutexKV.RLockContext(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return func() { redfishMutexKV.RUnlock(endpoint) }, nil
+}
+
+// lockContext bounds the wait for a server lock by the provider's `lock_timeout`
+func (p *redfishProvider) lockContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.lockTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, p.lockTimeout)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{"https://10.0.0.1", "https://10.0.0.1:443", false},
+		{"https://10.0.0.1:443/", "https://10.0.0.1:443", false},
+		{"HTTPS://iDRAC.Example.com", "https://idrac.example.com:443", false},
+		{"http://10.0.0.1", "http://10.0.0.1:80", false},
+		{"https://10.0.0.1:8443", "https://10.0.0.1:8443", false},
+		{"10.0.0.1", "https://10.0.0.1:443", false},
+		{"https://[FE80::1]", "https://[fe80::1]:443", false},
+		{"https://[fe80::1]:443", "https://[fe80::1]:443", false},
+		{"", "", true},
+		{"https://", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeEndpoint(tt.endpoint)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("normalizeEndpoint(%q) error = %v, wantErr %v", tt.endpoint, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeEndpoint(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func testAliasProvider(t *testing.T, endpoints map[string]string) *redfishProvider {
+	t.Helper()
+	p := &redfishProvider{}
+	servers := make(map[string]attr.Value)
+	for alias, endpoint := range endpoints {
+		server, diags := types.ObjectValue(p.getProviderServersModelType(), map[string]attr.Value{
+			fieldNameUser:          types.StringValue("root"),
+			fieldNamePass:          types.StringValue("calvin"),
+			"endpoint":             types.StringValue(endpoint),
+			"ssl_insecure":         types.BoolValue(true),
+			fieldNameCABundle:      types.StringNull(),
+			fieldNameFingerprints:  types.ListNull(types.StringType),
+			fieldNameTLSMinVersion: types.StringNull(),
+			fieldNameRetry:         types.ObjectNull(retryModelType()),
+		})
+		if diags.HasError() {
+			t.Fatalf("unable to build redfish_servers: %v", diags)
+		}
+		servers[alias] = server
+	}
+	serversMap, diags := types.MapValue(types.ObjectType{AttrTypes: p.getProviderServersModelType()}, servers)
+	if diags.HasError() {
+		t.Fatalf("unable to build redfish_servers: %v", diags)
+	}
+	p.Servers = serversMap
+	return p
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	p := testAliasProvider(t, map[string]string{
+		"server1": "https://10.0.0.1",
+		"server2": "https://10.0.0.2:443",
+	})
+
+	aliased, err := resolveEndpoint(p, []models.RedfishServer{{RedfishAlias: types.StringValue("server1")}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	direct, err := resolveEndpoint(p, []models.RedfishServer{{Endpoint: types.StringValue("https://10.0.0.1:443/")}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if aliased != direct {
+		t.Fatalf("Expected the alias and the endpoint of the same server to match, got %q and %q", aliased, direct)
+	}
+
+	other, err := resolveEndpoint(p, []models.RedfishServer{{RedfishAlias: types.StringValue("server2")}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if other == aliased {
+		t.Fatalf("Expected different servers to have different endpoints, got %q", other)
+	}
+
+	if _, err := resolveEndpoint(p, []models.RedfishServer{{RedfishAlias: types.StringValue("unknown")}}); err == nil {
+		t.Fatal("Expected an error for an unknown alias, got nil")
+	}
+}
+
+func TestLockRedfishServer_Timeout(t *testing.T) {
+	p := testAliasProvider(t, map[string]string{"server1": "https://10.0.0.10"})
+	p.lockTimeout = 50 * time.Millisecond
+
+	unlock, err := lockRedfishServer(context.Background(), p, []models.RedfishServer{{RedfishAlias: types.StringValue("server1")}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// the same BMC referenced by its endpoint waits for the aliased lock
+	direct := []models.RedfishServer{{Endpoint: types.StringValue("https://10.0.0.10:443")}}
+	if _, err := lockRedfishServer(context.Background(), p, direct); err == nil {
+		t.Fatal("Expected a lock timeout, got nil")
+	}
+	if _, err := rLockRedfishServer(context.Background(), p, direct); err == nil {
+		t.Fatal("Expected a read lock timeout, got nil")
+	}
+	unlock()
+
+	runlock, err := rLockRedfishServer(context.Background(), p, direct)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	runlock()
+}
@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-redfish/gofish/dell"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	// biosConfigurationJobType is the type of the Dell jobs applying the BIOS settings
+	biosConfigurationJobType = "BIOSConfiguration"
+	// nicConfigurationJobType is the type of the Dell jobs applying the NIC settings
+	nicConfigurationJobType = "NICConfiguration"
+	// raidConfigurationJobType is the type of the Dell jobs applying the storage controller settings
+	raidConfigurationJobType = "RAIDConfiguration"
+)
+
+// pendingJobStates are the states of the Dell jobs which have not applied their settings yet
+var pendingJobStates = []string{"New", "Scheduled", "Scheduling", "Running", "Waiting"}
+
+// pendingSettingsIgnoredProperties are the properties of the settings objects which are not settings
+var pendingSettingsIgnoredProperties = []string{"Id", "Name", "Description", "Actions", "Links"}
+
+// pendingSettings holds the settings of a resource which are staged in its settings object but not applied yet
+type pendingSettings struct {
+	attributes map[string]string
+	jobID      string
+}
+
+// pendingAttributesSchema is the schema of the settings which are staged but not applied yet
+func pendingAttributesSchema() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Settings which are staged on the server but not applied yet, such as the settings waiting for a reboot" +
+			" or a maintenance window. The staged settings are reported with their pending value instead of a drift.",
+		Description: "Settings which are staged on the server but not applied yet, such as the settings waiting for a reboot" +
+			" or a maintenance window. The staged settings are reported with their pending value instead of a drift.",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// pendingJobIDSchema is the schema of the ID of the job which applies the pending settings
+func pendingJobIDSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the job which applies the pending settings.",
+		Description:         "ID of the job which applies the pending settings.",
+		Computed:            true,
+	}
+}
+
+// getPendingSettings reads the settings object advertised by the @Redfish.Settings annotation of the resource,
+// and returns its values which differ from the current values of the resource, with the Dell job applying them
+func getPendingSettings(service *gofish.Service, resourceURI, jobType string) (*pendingSettings, error) {
+	pending := &pendingSettings{attributes: make(map[string]string)}
+	resource, err := getRawResource(service.GetClient(), resourceURI)
+	if err != nil {
+		return nil, err
+	}
+	settingsURI, err := settingsObjectURI(resource)
+	if err != nil || settingsURI == "" {
+		return pending, err
+	}
+	settings, err := getRawResource(service.GetClient(), settingsURI)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending.attributes, err = diffPendingSettings(resource, settings); err != nil {
+		return nil, err
+	}
+	if len(pending.attributes) > 0 {
+		pending.jobID, err = getPendingJobID(service, settingsURI, jobType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pending, nil
+}
+
+// settingsObjectURI returns the URI of the settings object advertised by the @Redfish.Settings annotation of the resource
+func settingsObjectURI(resource []byte) (string, error) {
+	var annotation struct {
+		Settings struct {
+			SettingsObject common.Link
+		} `json:"@Redfish.Settings"`
+	}
+	if err := json.Unmarshal(resource, &annotation); err != nil {
+		return "", err
+	}
+	return annotation.Settings.SettingsObject.String(), nil
+}
+
+// decodeWithPendingSettings decodes the resource of the URI into value with the values staged in its settings object
+// applied, so that the settings which are not applied yet are read with their pending value instead of being reported
+// as a drift. Failures to read the settings object are logged, and the applied values are decoded instead.
+func decodeWithPendingSettings(ctx context.Context, client common.Client, resourceURI string, value interface{}) error {
+	resource, err := getRawResource(client, resourceURI)
+	if err != nil {
+		return err
+	}
+	if merged, err := applyPendingSettings(client, resource); err != nil {
+		tflog.Warn(ctx, "unable to read the pending settings of "+resourceURI+": "+err.Error())
+	} else {
+		resource = merged
+	}
+	return json.Unmarshal(resource, value)
+}
+
+// applyPendingSettings returns the resource with the values of its settings object applied
+func applyPendingSettings(client common.Client, resource []byte) ([]byte, error) {
+	settingsURI, err := settingsObjectURI(resource)
+	if err != nil || settingsURI == "" {
+		return resource, err
+	}
+	settings, err := getRawResource(client, settingsURI)
+	if err != nil {
+		return nil, err
+	}
+	return mergePendingSettings(resource, settings)
+}
+
+// mergePendingSettings overlays the values of the settings object on the values of the resource
+func mergePendingSettings(resource, settings []byte) ([]byte, error) {
+	var resourceValues, settingsValues map[string]interface{}
+	if err := json.Unmarshal(resource, &resourceValues); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(settings, &settingsValues); err != nil {
+		return nil, err
+	}
+	for _, key := range pendingSettingsIgnoredProperties {
+		delete(settingsValues, key)
+	}
+	overlayPendingSettings(resourceValues, settingsValues)
+	return json.Marshal(resourceValues)
+}
+
+// overlayPendingSettings recursively copies the values of the settings into the values of the resource
+func overlayPendingSettings(values, settings map[string]interface{}) {
+	for key, value := range settings {
+		if strings.HasPrefix(key, "@") || value == nil {
+			continue
+		}
+		setting, isObject := value.(map[string]interface{})
+		current, hasObject := values[key].(map[string]interface{})
+		if isObject && hasObject {
+			overlayPendingSettings(current, setting)
+			continue
+		}
+		values[key] = value
+	}
+}
+
+// merge adds the pending settings of another settings object of the same resource
+func (p *pendingSettings) merge(other *pendingSettings) {
+	for name, value := range other.attributes {
+		p.attributes[name] = value
+	}
+	if p.jobID == "" {
+		p.jobID = other.jobID
+	}
+}
+
+// values returns the terraform values of the pending attributes and of the pending job ID
+func (p *pendingSettings) values() (types.Map, types.String) {
+	attributes := make(map[string]attr.Value)
+	jobID := types.StringNull()
+	if p != nil {
+		for name, value := range p.attributes {
+			attributes[name] = types.StringValue(value)
+		}
+		if p.jobID != "" {
+			jobID = types.StringValue(p.jobID)
+		}
+	}
+	return types.MapValueMust(types.StringType, attributes), jobID
+}
+
+// readPendingSettings reads the pending settings of the resources, which must be of the same component.
+// Failures are logged, since the pending settings are informational.
+func readPendingSettings(ctx context.Context, service *gofish.Service, jobType string, resourceURIs ...string) *pendingSettings {
+	pending := &pendingSettings{attributes: make(map[string]string)}
+	for _, uri := range resourceURIs {
+		settings, err := getPendingSettings(service, uri, jobType)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read the pending settings of "+uri+": "+err.Error())
+			continue
+		}
+		pending.merge(settings)
+	}
+	return pending
+}
+
+// diffPendingSettings returns the flattened values of the settings object which differ from the values of the resource
+func diffPendingSettings(resource, settings []byte) (map[string]string, error) {
+	var resourceValues, settingsValues map[string]interface{}
+	if err := json.Unmarshal(resource, &resourceValues); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(settings, &settingsValues); err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]string)
+	flattenPendingSettings("", resourceValues, current)
+	staged := make(map[string]string)
+	flattenPendingSettings("", settingsValues, staged)
+
+	pending := make(map[string]string)
+	for name, value := range staged {
+		if currentValue, ok := current[name]; !ok || currentValue != value {
+			pending[name] = value
+		}
+	}
+	return pending, nil
+}
+
+// flattenPendingSettings flattens the values of a settings object into dotted names.
+// The attributes of the Attributes property keep their own name, as they are named in the attributes of the resources.
+func flattenPendingSettings(prefix string, values map[string]interface{}, flattened map[string]string) {
+	for key, value := range values {
+		if strings.HasPrefix(key, "@") || (prefix == "" && slices.Contains(pendingSettingsIgnoredProperties, key)) {
+			continue
+		}
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			if prefix == "" && key == "Attributes" {
+				name = ""
+			}
+			flattenPendingSettings(name, v, flattened)
+		case []interface{}:
+			data, err := json.Marshal(v)
+			if err == nil {
+				flattened[name] = string(data)
+			}
+		default:
+			flattened[name] = biosAttributeValueString(v)
+		}
+	}
+}
+
+// getPendingJobID returns the ID of the Dell job which applies the settings object, matched by its target settings
+// or by its type when the job does not report its target
+func getPendingJobID(service *gofish.Service, settingsURI, jobType string) (string, error) {
+	manager, err := getManagerResource(service, "")
+	if err != nil {
+		return "", err
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		return "", err
+	}
+	jobs, err := dellManager.Jobs()
+	if err != nil {
+		return "", err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	for _, job := range jobs {
+		if !slices.Contains(pendingJobStates, job.JobState) {
+			continue
+		}
+		if job.TargetSettingsURI == settingsURI || (job.TargetSettingsURI == "" && job.JobType == jobType) {
+			return job.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// getRawResource returns the raw JSON of the resource of the URI
+func getRawResource(client common.Client, uri string) ([]byte, error) {
+	resp, err := client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	redfishcommon "github.com/stmcginnis/gofish/common"
+)
+
+// testPendingSettingsClient returns a client which answers the GET of a resource and then of its settings object
+func testPendingSettingsClient(resource, settings string) *redfishcommon.TestClient {
+	get := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}
+	return &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{
+		http.MethodGet: {get(resource), get(settings)},
+	}}
+}
+
+func TestDiffPendingSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		settings string
+		want     map[string]string
+	}{
+		{
+			name: "bios attributes",
+			resource: `{"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios", "Id": "BIOS", "AttributeRegistry": "BiosAttributeRegistry.v1_0_3",
+				"Attributes": {"NumLock": "On", "MemTest": "Disabled", "AcPwrRcvryUserDelay": 60}}`,
+			settings: `{"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios/Settings", "Id": "Settings", "AttributeRegistry": "BiosAttributeRegistry.v1_0_3",
+				"@Redfish.SettingsApplyTime": {"ApplyTime": "OnReset"}, "Attributes": {"NumLock": "Off", "AcPwrRcvryUserDelay": 120}}`,
+			want: map[string]string{"NumLock": "Off", "AcPwrRcvryUserDelay": "120"},
+		},
+		{
+			name:     "nothing pending",
+			resource: `{"Id": "BIOS", "Attributes": {"NumLock": "On"}}`,
+			settings: `{"Id": "Settings", "Attributes": {}}`,
+			want:     map[string]string{},
+		},
+		{
+			name: "nested settings",
+			resource: `{"Id": "NIC.Integrated.1-1-1", "Ethernet": {"MTUSize": 1500, "VLAN": {"VLANEnable": false, "VLANId": null}},
+				"Oem": {"Dell": {"DellStorageController": {"ControllerMode": "RAID"}}}, "Links": {"PhysicalPortAssignment": {}}}`,
+			settings: `{"Id": "Settings", "Ethernet": {"MTUSize": 9000, "VLAN": {"VLANEnable": false}},
+				"Oem": {"Dell": {"DellStorageController": {"ControllerMode": "HBA"}}}, "Links": {"Other": "ignored"},
+				"iSCSIBoot": {"TargetInfoViaDHCP": true, "IPAddressType": "IPv4"}, "Capabilities": ["A", "B"]}`,
+			want: map[string]string{
+				"Ethernet.MTUSize": "9000",
+				"Oem.Dell.DellStorageController.ControllerMode": "HBA",
+				"iSCSIBoot.TargetInfoViaDHCP":                   "true",
+				"iSCSIBoot.IPAddressType":                       "IPv4",
+				"Capabilities":                                  `["A","B"]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := diffPendingSettings([]byte(tt.resource), []byte(tt.settings))
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: diffPendingSettings() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := diffPendingSettings([]byte(`{}`), []byte(`not json`)); err == nil {
+		t.Error("Expected an error for an invalid settings object, got nil")
+	}
+}
+
+func TestPendingSettingsValues(t *testing.T) {
+	var none *pendingSettings
+	attributes, jobID := none.values()
+	if len(attributes.Elements()) != 0 || !jobID.IsNull() {
+		t.Fatalf("Expected no pending settings, got %v and %v", attributes, jobID)
+	}
+
+	pending := &pendingSettings{attributes: map[string]string{"Ethernet.MTUSize": "9000"}}
+	pending.merge(&pendingSettings{attributes: map[string]string{"LnkSpeed": "10Gbps"}, jobID: "JID_123456789012"})
+	pending.merge(&pendingSettings{attributes: map[string]string{}, jobID: "JID_000000000000"})
+	attributes, jobID = pending.values()
+	if len(attributes.Elements()) != 2 || jobID.ValueString() != "JID_123456789012" {
+		t.Fatalf("Unexpected pending settings %v and %v", attributes, jobID)
+	}
+}
+
+func TestMergePendingSettings(t *testing.T) {
+	resource := `{"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios", "Id": "BIOS",
+		"Attributes": {"NumLock": "On", "MemTest": "Disabled"}, "Ethernet": {"MTUSize": 1500, "VLAN": {"VLANEnable": false}}}`
+	settings := `{"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios/Settings", "Id": "Settings",
+		"@Redfish.SettingsApplyTime": {"ApplyTime": "OnReset"}, "Attributes": {"NumLock": "Off"},
+		"Ethernet": {"VLAN": {"VLANEnable": true, "VLANId": null}}}`
+
+	merged, err := mergePendingSettings([]byte(resource), []byte(settings))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(merged, &got); err != nil {
+		t.Fatalf("Expected a JSON object, got %v", err)
+	}
+	want := map[string]interface{}{
+		"@odata.id":  "/redfish/v1/Systems/System.Embedded.1/Bios",
+		"Id":         "BIOS",
+		"Attributes": map[string]interface{}{"NumLock": "Off", "MemTest": "Disabled"},
+		"Ethernet":   map[string]interface{}{"MTUSize": float64(1500), "VLAN": map[string]interface{}{"VLANEnable": true}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergePendingSettings() = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeWithPendingSettings(t *testing.T) {
+	resource := `{"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios", "Id": "BIOS",
+		"@Redfish.Settings": {"SettingsObject": {"@odata.id": "/redfish/v1/Systems/System.Embedded.1/Bios/Settings"}},
+		"Attributes": {"NumLock": "On"}}`
+	var value struct {
+		ID         string `json:"Id"`
+		Attributes map[string]string
+	}
+
+	client := testPendingSettingsClient(resource, `{"Id": "Settings", "Attributes": {"NumLock": "Off"}}`)
+	if err := decodeWithPendingSettings(context.Background(), client, "/redfish/v1/Systems/System.Embedded.1/Bios", &value); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if value.ID != "BIOS" || value.Attributes["NumLock"] != "Off" {
+		t.Errorf("Expected the pending value of the attribute, got %v", value)
+	}
+	calls := client.CapturedCalls()
+	if len(calls) != 2 || calls[1].URL != "/redfish/v1/Systems/System.Embedded.1/Bios/Settings" {
+		t.Errorf("Unexpected calls %v", calls)
+	}
+
+	// the applied values are read when the settings object cannot be read
+	client = testPendingSettingsClient(resource, `not json`)
+	if err := decodeWithPendingSettings(context.Background(), client, "/redfish/v1/Systems/System.Embedded.1/Bios", &value); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if value.Attributes["NumLock"] != "On" {
+		t.Errorf("Expected the applied value of the attribute, got %v", value)
+	}
+}
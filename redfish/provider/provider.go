@@ -19,28 +19,52 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
+	"regexp"
+	"sync"
 	"terraform-provider-redfish/mutexkv"
 	"terraform-provider-redfish/redfish/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
-	fieldNameUser = "user"
-	fieldNamePass = "password"
+	fieldNameUser          = "user"
+	fieldNamePass          = "password"
+	fieldNameCABundle      = "ca_bundle"
+	fieldNameFingerprints  = "certificate_fingerprints"
+	fieldNameTLSMinVersion = "tls_min_version"
+	fieldNameRetry         = "retry"
+	fieldNameLockTimeout   = "lock_timeout"
+	fieldNameMaxReads      = "max_concurrent_reads"
+	caBundleMD             = "PEM encoded CA certificates, or the path to a PEM file, used to verify the BMC certificates " +
+		"instead of the system trust store"
+	tlsMinVersionMD = "Minimum TLS version accepted when connecting to the BMCs. Accepted values: `1.0`, `1.1`, `1.2`, `1.3`. " +
+		"Defaults to `1.2`"
+	retryMD = "Retry behavior for failed requests to the BMCs. Requests answered with a retryable status code, " +
+		"or failing at the network level, are retried. A `Retry-After` header sent by the BMC is honored"
+)
+
+var (
+	// tlsMinVersionValues are the accepted values of `tls_min_version`
+	tlsMinVersionValues = []string{"1.0", "1.1", "1.2", "1.3"}
+	// fingerprintRegex matches a SHA-256 fingerprint, optionally colon separated
+	fingerprintRegex = regexp.MustCompile(`^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$`)
 )
 
 // This is a global MutexKV for use within this plugin
@@ -51,13 +75,29 @@ var _ provider.Provider = &redfishProvider{}
 
 // New - returns new provider struct definition.
 func New() provider.Provider {
-	return &redfishProvider{}
+	return &redfishProvider{
+		sessions: newSessionCache(),
+	}
+}
+
+// Shutdown logs out every Redfish session opened by the given provider.
+// It must be called once the provider server has stopped serving requests.
+func Shutdown(ctx context.Context, p provider.Provider) {
+	if rp, ok := p.(*redfishProvider); ok && rp.sessions != nil {
+		rp.sessions.logoutAll(ctx)
+	}
 }
 
 type redfishProvider struct {
 	models.ProviderConfig
-	RetryConfig    RetryConfig
-	HTTPTransport  http.RoundTripper
+	RetryConfig RetryConfig
+	// lockTimeout bounds the wait for the lock of a server, zero means no limit
+	lockTimeout time.Duration
+	// sessions caches X-Auth-Token sessions per endpoint and user for the whole run
+	sessions *sessionCache
+	// transports holds one retry-enabled transport per distinct TLS configuration
+	transports    map[string]http.RoundTripper
+	transportLock sync.Mutex
 }
 
 // Metadata - provider metadata AKA name.
@@ -81,6 +121,41 @@ func (*redfishProvider) Schema(ctx context.Context, _ provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			fieldNameCABundle: schema.StringAttribute{
+				MarkdownDescription: caBundleMD + ". Applies to every server unless overridden in `redfish_servers`.",
+				Description:         caBundleMD + ". Applies to every server unless overridden in `redfish_servers`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			fieldNameTLSMinVersion: schema.StringAttribute{
+				MarkdownDescription: tlsMinVersionMD + ". Applies to every server unless overridden in `redfish_servers`.",
+				Description:         tlsMinVersionMD + ". Applies to every server unless overridden in `redfish_servers`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tlsMinVersionValues...),
+				},
+			},
+			fieldNameRetry: retrySchema(retryMD + ". Applies to every server unless overridden in `redfish_servers`."),
+			fieldNameLockTimeout: schema.Int64Attribute{
+				MarkdownDescription: "Maximum seconds an operation waits for another one on the same server to finish. " +
+					"Changes to a server are serialized, while reads run in parallel. Defaults to `0`, waiting without limit",
+				Description: "Maximum seconds an operation waits for another one on the same server to finish. " +
+					"Changes to a server are serialized, while reads run in parallel. Defaults to `0`, waiting without limit",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			fieldNameMaxReads: schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of data sources reading the same server in parallel. Defaults to `4`",
+				Description:         "Maximum number of data sources reading the same server in parallel. Defaults to `4`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
 			"redfish_servers": schema.MapNestedAttribute{
 				MarkdownDescription: "Map of server BMCs with their alias keys and respective user credentials. " +
 					"This is required when resource/datasource's `redfish_alias` is not null",
@@ -106,6 +181,34 @@ func (*redfishProvider) Schema(ctx context.Context, _ provider.SchemaRequest, re
 							Optional:    true,
 							Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 						},
+						fieldNameCABundle: schema.StringAttribute{
+							Optional:    true,
+							Description: caBundleMD,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						fieldNameFingerprints: schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "SHA-256 fingerprints of the BMC certificate, in hexadecimal with or without colons. " +
+								"When set, the certificate is accepted only if it matches one of the fingerprints, " +
+								"which allows self-signed certificates to be verified. `ssl_insecure` and `ca_bundle` are then ignored",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(fingerprintRegex, "must be a SHA-256 fingerprint in hexadecimal"),
+								),
+							},
+						},
+						fieldNameTLSMinVersion: schema.StringAttribute{
+							Optional:    true,
+							Description: tlsMinVersionMD,
+							Validators: []validator.String{
+								stringvalidator.OneOf(tlsMinVersionValues...),
+							},
+						},
+						fieldNameRetry: retrySchema(retryMD + ". Attributes set here override the provider level `retry` ones."),
 					},
 				},
 				Validators: []validator.Map{
@@ -147,27 +250,53 @@ func (p *redfishProvider) Configure(ctx context.Context, req provider.ConfigureR
 	p.Username = config.Username
 	p.Password = config.Password
 	p.Servers = config.Servers
+	p.CABundle = config.CABundle
+	p.TLSMinVersion = config.TLSMinVersion
+	if p.sessions == nil {
+		p.sessions = newSessionCache()
+	}
 
-	// Initialize retry configuration with default values
-	p.RetryConfig = DefaultRetryConfig()
+	// Fail early on a provider level CA bundle that cannot be loaded
+	if caBundle := config.CABundle.ValueString(); caBundle != "" {
+		if _, err := loadCABundle(caBundle); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(fieldNameCABundle), "Invalid CA bundle", err.Error())
+			return
+		}
+	}
+
+	// Initialize retry configuration with default values, overridden by the `retry` block
+	retryConfig, diags := mergeRetrySettings(ctx, DefaultRetryConfig(), config.Retry)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := retryConfig.Validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(fieldNameRetry), "Invalid retry configuration", err.Error())
+		return
+	}
+	p.Retry = config.Retry
+	p.LockTimeout = config.LockTimeout
+	p.RetryConfig = retryConfig
 	tflog.Info(ctx, "Retry logic enabled", map[string]any{
-		"max_retries":    p.RetryConfig.MaxRetries,
-		"retry_interval": p.RetryConfig.RetryInterval.String(),
-		"retryable_codes": p.RetryConfig.RetryableStatusCodes,
+		"max_retries":          p.RetryConfig.MaxRetries,
+		"retry_interval":       p.RetryConfig.RetryInterval.String(),
+		"exponential_backoff":  p.RetryConfig.ExponentialBackoff,
+		"retryable_codes":      p.RetryConfig.RetryableStatusCodes,
+		"retry_non_idempotent": p.RetryConfig.RetryNonIdempotent,
 	})
 
-	// Create base HTTP transport with TLS configuration
-	// Note: Individual resources/data sources will wrap this with retry transport
-	// when creating their gofish clients
-	baseTransport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: true, // TODO: Make this configurable via provider schema
-		},
+	// Operations are serialized per resolved server endpoint
+	p.lockTimeout = time.Duration(config.LockTimeout.ValueInt64()) * time.Second
+	p.MaxConcurrentReads = config.MaxConcurrentReads
+	if !config.MaxConcurrentReads.IsNull() && !config.MaxConcurrentReads.IsUnknown() {
+		redfishMutexKV.SetConcurrencyLimit(int(config.MaxConcurrentReads.ValueInt64()))
 	}
 
-	// Wrap with retry transport
-	p.HTTPTransport = NewRetryableTransport(baseTransport, p.RetryConfig)
-	tflog.Info(ctx, "HTTP transport configured with retry logic")
+	// Transports are built per TLS and retry configuration when the gofish clients are created,
+	// drop the ones of a previous configuration
+	p.transportLock.Lock()
+	p.transports = make(map[string]http.RoundTripper)
+	p.transportLock.Unlock()
 
 	resp.ResourceData = p
 	resp.DataSourceData = p
@@ -200,6 +329,18 @@ func (*redfishProvider) Resources(_ context.Context) []func() resource.Resource
 		NewRedfishStorageControllerResource,
 		NewRedfishDirectoryServiceAuthProviderResource,
 		NewRedfishDirectoryServiceAuthProviderCertificateResource,
+		NewEventSubscriptionResource,
+		NewLogServiceClearResource,
+		NewDellLicenseResource,
+		NewDellJobQueueResource,
+		NewManagerTimeResource,
+		NewManagerNetworkProtocolResource,
+		NewManagerEthernetInterfaceResource,
+		NewChassisResource,
+		NewManagerResetToDefaultsResource,
+		NewBiosPasswordResource,
+		NewSecureBootResource,
+		NewTPMResource,
 	}
 }
 
@@ -216,24 +357,66 @@ func (*redfishProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewStorageControllerDatasource,
 		NewDirectoryServiceAuthProviderDatasource,
 		NewDirectoryServiceAuthProviderCertificateDatasource,
+		NewLogEntriesDatasource,
+		NewDellLicenseDatasource,
+		NewDellJobsDatasource,
+		NewProcessorsDatasource,
+		NewMemoryDatasource,
+		NewPCIeDevicesDatasource,
+		NewChassisDatasource,
+		NewSensorsDatasource,
+		NewScpImportPreviewDatasource,
+		NewScpDiffDatasource,
+		NewSecureBootDatasource,
+		NewTrustedModulesDatasource,
 	}
 }
 
-// GetHTTPClient returns an HTTP client configured with retry logic
+// GetHTTPClient returns an HTTP client configured with the given retry logic and TLS settings
 // This method should be used by resources and data sources when creating gofish clients
-func (p *redfishProvider) GetHTTPClient() *http.Client {
+func (p *redfishProvider) GetHTTPClient(settings TLSSettings, retryConfig RetryConfig) (*http.Client, error) {
+	transport, err := p.getTransport(settings, retryConfig)
+	if err != nil {
+		return nil, err
+	}
 	return &http.Client{
-		Transport: p.HTTPTransport,
-		Timeout:   p.RetryConfig.TotalTimeout() + 60*time.Second,
+		Transport: transport,
+		Timeout:   retryConfig.TotalTimeout() + 60*time.Second,
+	}, nil
+}
+
+// getTransport returns the retry-enabled transport for the TLS settings and retry configuration, building it on first use
+func (p *redfishProvider) getTransport(settings TLSSettings, retryConfig RetryConfig) (http.RoundTripper, error) {
+	key := settings.key() + "|" + retryConfig.key()
+
+	p.transportLock.Lock()
+	defer p.transportLock.Unlock()
+	if p.transports == nil {
+		p.transports = make(map[string]http.RoundTripper)
+	}
+	if transport, ok := p.transports[key]; ok {
+		return transport, nil
 	}
+
+	tlsConfig, err := settings.Build()
+	if err != nil {
+		return nil, err
+	}
+	transport := NewRetryableTransport(&http.Transport{TLSClientConfig: tlsConfig}, retryConfig)
+	p.transports[key] = transport
+	return transport, nil
 }
 
 func (*redfishProvider) getProviderServersModelType() map[string]attr.Type {
 	return map[string]attr.Type{
-		fieldNameUser:  types.StringType,
-		fieldNamePass:  types.StringType,
-		"endpoint":     types.StringType,
-		"ssl_insecure": types.BoolType,
+		fieldNameUser:          types.StringType,
+		fieldNamePass:          types.StringType,
+		"endpoint":             types.StringType,
+		"ssl_insecure":         types.BoolType,
+		fieldNameCABundle:      types.StringType,
+		fieldNameFingerprints:  types.ListType{ElemType: types.StringType},
+		fieldNameTLSMinVersion: types.StringType,
+		fieldNameRetry:         types.ObjectType{AttrTypes: retryModelType()},
 	}
 }
 
@@ -250,10 +433,14 @@ func (p *redfishProvider) updateProviderServersByAlias(ctx context.Context, alia
 	}
 	for key, value := range serversMap {
 		serverItemMap := map[string]attr.Value{
-			fieldNameUser:  types.StringValue(value.User.ValueString()),
-			fieldNamePass:  types.StringValue(value.Password.ValueString()),
-			"endpoint":     types.StringValue(value.Endpoint.ValueString()),
-			"ssl_insecure": types.BoolValue(value.SslInsecure.ValueBool()),
+			fieldNameUser:          types.StringValue(value.User.ValueString()),
+			fieldNamePass:          types.StringValue(value.Password.ValueString()),
+			"endpoint":             types.StringValue(value.Endpoint.ValueString()),
+			"ssl_insecure":         types.BoolValue(value.SslInsecure.ValueBool()),
+			fieldNameCABundle:      value.CABundle,
+			fieldNameFingerprints:  value.CertificateFingerprints,
+			fieldNameTLSMinVersion: value.TLSMinVersion,
+			fieldNameRetry:         value.Retry,
 		}
 		if alias == key {
 			if newPassword != "" {
@@ -277,3 +464,114 @@ func (p *redfishProvider) updateProviderServersByAlias(ctx context.Context, alia
 	p.Servers = newServerMap
 	return
 }
+
+// retrySchema returns the schema of the `retry` block, shared by the provider and the `redfish_servers` entries
+func retrySchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries of a failed request. Defaults to `15`",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"retry_interval": schema.Int64Attribute{
+				Optional: true,
+				Description: "Seconds to wait between two attempts, or before the first retry when `exponential_backoff` is enabled. " +
+					"Defaults to `90`",
+				Validators: []validator.Int64{
+					int64validator.Between(0, int64(maxRetryIntervalLimit/time.Second)),
+				},
+			},
+			"max_retry_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum seconds to wait between two attempts when `exponential_backoff` is enabled. Defaults to `300`",
+				Validators: []validator.Int64{
+					int64validator.Between(0, int64(maxRetryIntervalLimit/time.Second)),
+				},
+			},
+			"exponential_backoff": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Double the wait after every failed attempt. Defaults to `false`",
+			},
+			"jitter": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Randomize every wait between half and the full interval, to spread the load on the BMC. Defaults to `false`",
+			},
+			"retryable_status_codes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "HTTP status codes that trigger a retry. Defaults to `[429, 500, 503]`",
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"retry_non_idempotent": schema.BoolAttribute{
+				Optional: true,
+				Description: "Retry POST and PATCH requests, such as Redfish actions and attribute updates, too. They are sent only once by default " +
+					"since the BMC might have processed them even when they failed. Defaults to `false`",
+			},
+		},
+	}
+}
+
+// retryModelType returns the attribute types of the `retry` block
+func retryModelType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"max_retries":            types.Int64Type,
+		"retry_interval":         types.Int64Type,
+		"max_retry_interval":     types.Int64Type,
+		"exponential_backoff":    types.BoolType,
+		"jitter":                 types.BoolType,
+		"retryable_status_codes": types.ListType{ElemType: types.Int64Type},
+		"retry_non_idempotent":   types.BoolType,
+	}
+}
+
+// mergeRetrySettings returns the base configuration with the attributes set in the `retry` block applied
+func mergeRetrySettings(ctx context.Context, base RetryConfig, retry types.Object) (RetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if retry.IsNull() || retry.IsUnknown() {
+		return base, diags
+	}
+
+	var settings models.RetrySettings
+	if diags = retry.As(ctx, &settings, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return base, diags
+	}
+
+	config := base
+	if !settings.MaxRetries.IsNull() && !settings.MaxRetries.IsUnknown() {
+		config.MaxRetries = int(settings.MaxRetries.ValueInt64())
+	}
+	if !settings.RetryInterval.IsNull() && !settings.RetryInterval.IsUnknown() {
+		config.RetryInterval = time.Duration(settings.RetryInterval.ValueInt64()) * time.Second
+	}
+	if !settings.MaxRetryInterval.IsNull() && !settings.MaxRetryInterval.IsUnknown() {
+		config.MaxRetryInterval = time.Duration(settings.MaxRetryInterval.ValueInt64()) * time.Second
+	}
+	if !settings.ExponentialBackoff.IsNull() && !settings.ExponentialBackoff.IsUnknown() {
+		config.ExponentialBackoff = settings.ExponentialBackoff.ValueBool()
+	}
+	if !settings.Jitter.IsNull() && !settings.Jitter.IsUnknown() {
+		config.Jitter = settings.Jitter.ValueBool()
+	}
+	if !settings.RetryNonIdempotent.IsNull() && !settings.RetryNonIdempotent.IsUnknown() {
+		config.RetryNonIdempotent = settings.RetryNonIdempotent.ValueBool()
+	}
+	if !settings.RetryableStatusCodes.IsNull() && !settings.RetryableStatusCodes.IsUnknown() {
+		var codes []int64
+		if diags = settings.RetryableStatusCodes.ElementsAs(ctx, &codes, false); diags.HasError() {
+			return base, diags
+		}
+		config.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			config.RetryableStatusCodes = append(config.RetryableStatusCodes, int(code))
+		}
+	}
+	return config, diags
+}
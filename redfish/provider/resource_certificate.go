@@ -151,8 +151,12 @@ func (r *certificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	payload := models.SSLCertificate{
 		CertificateType:    plan.CertificateType.ValueString(),
@@ -208,8 +212,12 @@ func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	payload := strings.NewReader(`{}`)
 
@@ -21,19 +21,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"path"
 	"strconv"
 	"strings"
 	"terraform-provider-redfish/common"
 	"terraform-provider-redfish/redfish/models"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
@@ -57,7 +56,9 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &BiosResource{}
+	_ resource.Resource                   = &BiosResource{}
+	_ resource.ResourceWithModifyPlan     = &BiosResource{}
+	_ resource.ResourceWithValidateConfig = &BiosResource{}
 )
 
 // NewBiosResource is a helper function to simplify the provider implementation.
@@ -67,8 +68,7 @@ func NewBiosResource() resource.Resource {
 
 // BiosResource is the resource implementation.
 type BiosResource struct {
-	p   *redfishProvider
-	ctx context.Context
+	p *redfishProvider
 }
 
 // Configure implements resource.ResourceWithConfigure
@@ -102,27 +102,78 @@ func (*BiosResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 				},
 			},
 			"attributes": schema.MapAttribute{
-				MarkdownDescription: "The Bios attribute map.",
-				Description:         "The Bios attribute map.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "The Bios attribute map. The attributes are validated at plan time against the BIOS attribute registry" +
+					" of the server, including the dependencies between the attributes.",
+				Description: "The Bios attribute map. The attributes are validated at plan time against the BIOS attribute registry" +
+					" of the server, including the dependencies between the attributes.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"settings_apply_time": schema.StringAttribute{
 				Optional: true,
-				Description: "The time when the BIOS settings can be applied. Applicable value is 'OnReset' only. " +
-					"In upcoming releases other apply time values will be supported. Default is \"OnReset\".",
+				MarkdownDescription: "The time when the BIOS settings are applied. " +
+					"Accepted values: `Immediate`, `OnReset`, `AtMaintenanceWindowStart`, `InMaintenanceWindowOnReset`. " +
+					"Immediate: the server is rebooted by the iDRAC right away to apply the settings. " +
+					"OnReset: the server is rebooted with `reset_type` to apply the settings, unless `stage_only` is set. " +
+					"AtMaintenanceWindowStart: the settings are applied at the start of the maintenance window specified in `maintenance_window`. " +
+					"InMaintenanceWindowOnReset: the settings are applied on a reset of the server within the maintenance window " +
+					"specified in `maintenance_window`. Default is `OnReset`.",
+				Description: "The time when the BIOS settings are applied. " +
+					"Accepted values: Immediate, OnReset, AtMaintenanceWindowStart, InMaintenanceWindowOnReset. " +
+					"Immediate: the server is rebooted by the iDRAC right away to apply the settings. " +
+					"OnReset: the server is rebooted with reset_type to apply the settings, unless stage_only is set. " +
+					"AtMaintenanceWindowStart: the settings are applied at the start of the maintenance window specified in maintenance_window. " +
+					"InMaintenanceWindowOnReset: the settings are applied on a reset of the server within the maintenance window " +
+					"specified in maintenance_window. Default is OnReset.",
 				Validators: []validator.String{
 					stringvalidator.OneOf([]string{
+						string(redfishcommon.ImmediateApplyTime),
 						string(redfishcommon.OnResetApplyTime),
+						string(redfishcommon.AtMaintenanceWindowStartApplyTime),
+						string(redfishcommon.InMaintenanceWindowOnResetApplyTime),
 					}...),
 				},
 				Default:  stringdefault.StaticString(string(redfishcommon.OnResetApplyTime)),
 				Computed: true,
 			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "The maintenance window in which the BIOS settings are applied. " +
+					"This is required when `settings_apply_time` is `AtMaintenanceWindowStart` or `InMaintenanceWindowOnReset`.",
+				Description: "The maintenance window in which the BIOS settings are applied. " +
+					"This is required when settings_apply_time is AtMaintenanceWindowStart or InMaintenanceWindowOnReset.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"start_time": schema.StringAttribute{
+						MarkdownDescription: "The start time of the maintenance window. The format is YYYY-MM-DDThh:mm:ss<offset>, " +
+							"where <offset> is the offset from UTC of the timezone of the iDRAC, such as +05:30.",
+						Description: "The start time of the maintenance window. The format is YYYY-MM-DDThh:mm:ss<offset>, " +
+							"where <offset> is the offset from UTC of the timezone of the iDRAC, such as +05:30.",
+						Required:   true,
+						Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"duration": schema.Int64Attribute{
+						MarkdownDescription: "The duration of the maintenance window in seconds.",
+						Description:         "The duration of the maintenance window in seconds.",
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+				},
+			},
+			"stage_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to only stage the BIOS settings when `settings_apply_time` is `OnReset`, " +
+					"leaving the pending job to be applied on the next reboot of the server instead of rebooting it with `reset_type`. " +
+					"The settings of the maintenance window apply times are always staged. Default is `false`.",
+				Description: "Whether to only stage the BIOS settings when settings_apply_time is OnReset, " +
+					"leaving the pending job to be applied on the next reboot of the server instead of rebooting it with reset_type. " +
+					"The settings of the maintenance window apply times are always staged. Default is false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"reset_type": schema.StringAttribute{
 				Optional: true,
 				Description: "Reset type to apply on the computer system after the BIOS settings are applied. " +
@@ -161,14 +212,83 @@ func (*BiosResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"pending_attributes": pendingAttributesSchema(),
+			"pending_job_id":     pendingJobIDSchema(),
 		},
 		Blocks: RedfishServerResourceBlockMap(),
 	}
 }
 
+// ValidateConfig validates the apply time of the settings
+func (*BiosResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.Bios
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SettingsApplyTime.IsUnknown() {
+		return
+	}
+
+	applyTime := config.SettingsApplyTime.ValueString()
+	if isMaintenanceWindowApplyTime(applyTime) && config.MaintenanceWindow == nil {
+		resp.Diagnostics.AddAttributeError(tfpath.Root("maintenance_window"), "Invalid Attribute Combination",
+			"maintenance_window is required when settings_apply_time is AtMaintenanceWindowStart or InMaintenanceWindowOnReset")
+	}
+	if !isMaintenanceWindowApplyTime(applyTime) && config.MaintenanceWindow != nil {
+		resp.Diagnostics.AddAttributeError(tfpath.Root("maintenance_window"), "Invalid Attribute Combination",
+			"maintenance_window is only applicable when settings_apply_time is AtMaintenanceWindowStart or InMaintenanceWindowOnReset")
+	}
+	if config.StageOnly.ValueBool() && !config.SettingsApplyTime.IsNull() && applyTime != string(redfishcommon.OnResetApplyTime) {
+		resp.Diagnostics.AddAttributeError(tfpath.Root("stage_only"), "Invalid Attribute Combination",
+			"stage_only is only applicable when settings_apply_time is OnReset")
+	}
+}
+
+// ModifyPlan validates the attributes of the plan against the BIOS attribute registry of the server,
+// so that invalid values and unmet dependencies are reported at plan time.
+func (r *BiosResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.p == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.Bios
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !biosPlanKnown(plan) {
+		return
+	}
+
+	unlock, err := rLockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	// the server may not be reachable yet, the attributes are then validated when they are applied
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate the BIOS attributes", err.Error())
+		return
+	}
+	defer api.Logout()
+
+	_, _, diags := getBiosAttrsPatch(ctx, api.Service, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// biosPlanKnown returns whether the attributes and the server of the plan are known
+func biosPlanKnown(plan models.Bios) bool {
+	if plan.Attributes.IsUnknown() || plan.Attributes.IsNull() || len(plan.RedfishServer) == 0 {
+		return false
+	}
+	for _, value := range plan.Attributes.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+	server := plan.RedfishServer[0]
+	return !server.User.IsUnknown() && !server.Password.IsUnknown() && !server.Endpoint.IsUnknown() && !server.RedfishAlias.IsUnknown()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	r.ctx = ctx
 	tflog.Trace(ctx, "resource_Bios create : Started")
 	// Get Plan Data
 	var plan models.Bios
@@ -202,7 +322,6 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (r *BiosResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Trace(ctx, "resource_Bios read: started")
-	r.ctx = ctx
 	var state models.Bios
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -218,7 +337,7 @@ func (r *BiosResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	service := api.Service
 	defer api.Logout()
 
-	err = r.readRedfishDellBiosAttributes(service, &state)
+	err = r.readRedfishDellBiosAttributes(ctx, service, &state)
 	if err != nil {
 		diags.AddError("Error running job", err.Error())
 	}
@@ -233,7 +352,6 @@ func (r *BiosResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	r.ctx = ctx
 
 	// Get state Data
 	tflog.Trace(ctx, "resource_Bios update: started")
@@ -318,37 +436,14 @@ func (r *BiosResource) updateRedfishDellBiosAttributes(ctx context.Context, serv
 	state := plan
 
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
-
-	system, err := getSystemResource(service, state.SystemID.ValueString())
-	if err != nil {
-		diags.AddError("error fetching system resource", err.Error())
-		return nil, diags
-	}
-
-	state.SystemID = types.StringValue(system.ID)
-
-	bios, err := system.Bios()
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
 	if err != nil {
-		diags.AddError("error fetching bios resource", err.Error())
+		diags.AddError(lockServerErrorMsg, err.Error())
 		return nil, diags
 	}
+	defer unlock()
 
-	attributes := make(map[string]string)
-	err = copyBiosAttributes(bios, attributes)
-	if err != nil {
-		diags.AddError("error fetching bios resource", err.Error())
-		return nil, diags
-	}
-
-	// check device is 17G or not
-	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
-	if err != nil {
-		diags.AddError("Error retrieving the server generation", err.Error())
-		return nil, diags
-	}
-	attrsPayload, diagsAttr := getBiosAttrsToPatch(ctx, plan, attributes, isGenerationSeventeenAndAbove)
+	bios, attrsPayload, diagsAttr := getBiosAttrsPatch(ctx, service, state)
 	diags.Append(diagsAttr...)
 	if diags.HasError() {
 		return nil, diags
@@ -360,44 +455,59 @@ func (r *BiosResource) updateRedfishDellBiosAttributes(ctx context.Context, serv
 
 	tflog.Debug(ctx, fmt.Sprintf("resetTimeout is set to %d and Bios Config Job timeout is set to %d", resetTimeout, biosConfigJobTimeout))
 
+	applyTime := plan.SettingsApplyTime.ValueString()
+	staged := isBiosSettingsStaged(plan)
+
 	var biosTaskURI string
 	if len(attrsPayload) != 0 {
 		tflog.Info(ctx, "Submitting patch request for bios attributes")
-		biosTaskURI, err = r.patchBiosAttributes(plan, bios, attrsPayload)
+		biosTaskURI, err = patchBiosAttributes(ctx, plan, bios, attrsPayload)
 		if err != nil {
 			diags.AddError("error updating bios attributes", err.Error())
 			return nil, diags
 		}
 
 		tflog.Info(ctx, "Submitting patch request for bios attributes completed successfully")
-		tflog.Info(ctx, "rebooting the server")
-		// reboot the server
-		pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
-		_, err := pOp.PowerOperation(resetType, resetTimeout, intervalBiosConfigJobCheckTime)
-		if err != nil {
-			// TODO: handle this scenario
-			diags.AddError("there was an issue restarting the server", err.Error())
-			return nil, diags
-		}
-
-		tflog.Info(ctx, "rebooting the server completed successfully")
-		tflog.Info(ctx, "Waiting for the bios config job to finish")
+		if staged {
+			tflog.Info(ctx, "BIOS attributes are staged, they are applied with the "+applyTime+" apply time")
+		} else {
+			if applyTime == string(redfishcommon.OnResetApplyTime) {
+				tflog.Info(ctx, "rebooting the server")
+				// reboot the server
+				pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
+				_, err := pOp.PowerOperation(resetType, resetTimeout, intervalBiosConfigJobCheckTime)
+				if err != nil {
+					// TODO: handle this scenario
+					diags.AddError("there was an issue restarting the server", err.Error())
+					return nil, diags
+				}
+				tflog.Info(ctx, "rebooting the server completed successfully")
+			}
 
-		// wait for the bios config job to finish
-		err = common.WaitForTaskToFinish(service, biosTaskURI, intervalBiosConfigJobCheckTime, biosConfigJobTimeout)
-		if err != nil {
-			diags.AddError("error waiting for Bios config monitor task to be completed", err.Error())
-			return nil, diags
+			tflog.Info(ctx, "Waiting for the bios config job to finish")
+			// wait for the bios config job to finish
+			if biosTaskURI != "" {
+				err = common.WaitForTaskToFinish(service, biosTaskURI, intervalBiosConfigJobCheckTime, biosConfigJobTimeout)
+				if err != nil {
+					diags.AddError("error waiting for Bios config monitor task to be completed", err.Error())
+					return nil, diags
+				}
+			}
+			// the attributes are applied once the settings object has no pending attributes left
+			err = waitForBiosSettingsApplied(ctx, bios, intervalBiosConfigJobCheckTime, biosConfigJobTimeout)
+			if err != nil {
+				diags.AddError("error waiting for the Bios settings to be applied", err.Error())
+				return nil, diags
+			}
+			tflog.Info(ctx, "Bios config job has completed successfully")
 		}
-		tflog.Info(ctx, "Bios config job has completed successfully")
-		time.Sleep(180 * time.Second)
 	} else {
 		tflog.Info(ctx, "BIOS attributes are already set")
 	}
 
 	state.ID = types.StringValue(bios.ODataID)
 
-	err = r.readRedfishDellBiosAttributes(service, state)
+	err = r.readRedfishDellBiosAttributes(ctx, service, state)
 	if err != nil {
 		diags.AddError("unable to fetch currrent bios values", err.Error())
 		return nil, diags
@@ -407,7 +517,9 @@ func (r *BiosResource) updateRedfishDellBiosAttributes(ctx context.Context, serv
 	return state, nil
 }
 
-func (*BiosResource) readRedfishDellBiosAttributes(service *gofish.Service, d *models.Bios) error {
+// readRedfishDellBiosAttributes reads the attributes of the BIOS. The attributes which are staged but not applied yet
+// are read with their pending value, so that they are not reported as a drift.
+func (*BiosResource) readRedfishDellBiosAttributes(ctx context.Context, service *gofish.Service, d *models.Bios) error {
 	system, err := getSystemResource(service, d.SystemID.ValueString())
 	if err != nil {
 		return fmt.Errorf("error fetching BIOS resource: %w", err)
@@ -425,6 +537,13 @@ func (*BiosResource) readRedfishDellBiosAttributes(service *gofish.Service, d *m
 	if err != nil {
 		return fmt.Errorf("error fetching BIOS attributes: %w", err)
 	}
+	pending := readPendingSettings(ctx, service, biosConfigurationJobType, bios.ODataID)
+	for key, value := range pending.attributes {
+		if _, ok := attributes[key]; ok {
+			attributes[key] = value
+		}
+	}
+	d.PendingAttributes, d.PendingJobID = pending.values()
 
 	attributesTF := make(map[string]attr.Value)
 	if !d.Attributes.IsNull() {
@@ -462,13 +581,69 @@ func copyBiosAttributes(bios *redfish.Bios, attributes map[string]string) error
 	return nil
 }
 
+// getBiosAttrsPatch fetches the BIOS of the system and returns the attributes of the plan to patch
+func getBiosAttrsPatch(ctx context.Context, service *gofish.Service, plan *models.Bios) (*redfish.Bios, map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	system, err := getSystemResource(service, plan.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("error fetching system resource", err.Error())
+		return nil, nil, diags
+	}
+
+	plan.SystemID = types.StringValue(system.ID)
+
+	bios, err := system.Bios()
+	if err != nil {
+		diags.AddError("error fetching bios resource", err.Error())
+		return nil, nil, diags
+	}
+
+	attributes := make(map[string]string)
+	err = copyBiosAttributes(bios, attributes)
+	if err != nil {
+		diags.AddError("error fetching bios resource", err.Error())
+		return nil, nil, diags
+	}
+
+	// the attributes are validated against the registry when it is available
+	registry, err := getBiosAttributeRegistry(service, bios)
+	if err != nil {
+		tflog.Warn(ctx, "unable to fetch the BIOS attribute registry, the attributes are not validated: "+err.Error())
+	}
+
+	// check device is 17G or not
+	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
+	if err != nil {
+		diags.AddError("Error retrieving the server generation", err.Error())
+		return nil, nil, diags
+	}
+	attrsPayload, diagsAttr := getBiosAttrsToPatch(ctx, plan, attributes, registry, isGenerationSeventeenAndAbove)
+	diags.Append(diagsAttr...)
+	return bios, attrsPayload, diags
+}
+
+// getBiosAttrsToPatch returns the attributes whose value changes, with the type of the attribute registry when it is available.
+// The changed attributes are validated against the registry, including its dependencies on the other attributes.
 // nolint: revive
-func getBiosAttrsToPatch(ctx context.Context, d *models.Bios, attributes map[string]string, isSeventeenGen bool) (map[string]interface{}, diag.Diagnostics) {
+func getBiosAttrsToPatch(ctx context.Context, d *models.Bios, attributes map[string]string, registry *biosAttributeRegistry,
+	isSeventeenGen bool,
+) (map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	attrs := make(map[string]string)
 	attrsToPatch := make(map[string]interface{})
 	diags.Append(d.Attributes.ElementsAs(ctx, &attrs, true)...)
 
+	// values of the attributes after the update, to evaluate the dependencies of the registry
+	values := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		values[key] = value
+	}
+	for key, newVal := range attrs {
+		if _, ok := attributes[key]; ok {
+			values[key] = newVal
+		}
+	}
+
 	for key, newVal := range attrs {
 		if isSeventeenGen && strings.Contains(key, "AcPwrRcvry") {
 			diags.AddError(fmt.Sprintf("%s Configuration is not supported by 17G device", key), fmt.Sprintf("BIOS attribute %s not found", key))
@@ -479,6 +654,25 @@ func getBiosAttrsToPatch(ctx context.Context, d *models.Bios, attributes map[str
 			diags.AddError("There was an issue while creating/updating bios attriutes", fmt.Sprintf("BIOS attribute %s not found", key))
 			continue
 		}
+		if registry != nil {
+			value, found, err := registry.value(key, newVal)
+			if err != nil {
+				diags.AddAttributeError(tfpath.Root("attributes").AtMapKey(key), "Invalid BIOS attribute", err.Error())
+				continue
+			}
+			if found {
+				// Skip the attribute if its value has not changed
+				if oldValue, _, err := registry.value(key, oldVal); err == nil && value == oldValue {
+					continue
+				}
+				if err := registry.check(key, newVal, values); err != nil {
+					diags.AddAttributeError(tfpath.Root("attributes").AtMapKey(key), "Invalid BIOS attribute", err.Error())
+					continue
+				}
+				attrsToPatch[key] = value
+				continue
+			}
+		}
 		// check if the original value is an integer
 		// if yes, then we need to convert accordingly
 		if intOldVal, err := strconv.Atoi(attributes[key]); err == nil {
@@ -502,7 +696,9 @@ func getBiosAttrsToPatch(ctx context.Context, d *models.Bios, attributes map[str
 	return attrsToPatch, diags
 }
 
-func (r *BiosResource) patchBiosAttributes(d *models.Bios, bios *redfish.Bios, attributes map[string]interface{}) (biosTaskURI string, err error) {
+func patchBiosAttributes(ctx context.Context, d *models.Bios, bios *redfish.Bios,
+	attributes map[string]interface{},
+) (biosTaskURI string, err error) {
 	payload := make(map[string]interface{})
 	payload["Attributes"] = attributes
 
@@ -522,27 +718,32 @@ func (r *BiosResource) patchBiosAttributes(d *models.Bios, bios *redfish.Bios, a
 		return "", err
 	}
 
-	payload["@Redfish.SettingsApplyTime"] = map[string]interface{}{
-		"ApplyTime": settingsApplyTime,
+	payload[patchBodySettingsApplyTime] = map[string]interface{}{
+		patchBodyApplyTime: settingsApplyTime,
+	}
+	if isMaintenanceWindowApplyTime(settingsApplyTime) {
+		payload[patchBodySettingsApplyTime] = map[string]interface{}{
+			patchBodyApplyTime:                   settingsApplyTime,
+			"MaintenanceWindowStartTime":         d.MaintenanceWindow.StartTime.ValueString(),
+			"MaintenanceWindowDurationInSeconds": d.MaintenanceWindow.Duration.ValueInt64(),
+		}
 	}
 
-	oDataURI, err := url.Parse(bios.ODataID)
+	settingsObjectURI, err := biosSettingsURI(bios)
 	if err != nil {
-		tflog.Trace(r.ctx, "error fetching data: "+err.Error())
+		tflog.Trace(ctx, "error fetching data: "+err.Error())
 		return "", err
 	}
-	oDataURI.Path = path.Join(oDataURI.Path, "Settings")
-	settingsObjectURI := oDataURI.String()
 
 	resp, err := bios.GetClient().Patch(settingsObjectURI, payload)
 	if err != nil {
-		tflog.Trace(r.ctx, "[DEBUG] error sending the patch request:"+err.Error())
+		tflog.Trace(ctx, "[DEBUG] error sending the patch request:"+err.Error())
 		return "", err
 	}
 
 	// check if location is present in the response header
 	if location, err := resp.Location(); err == nil {
-		tflog.Trace(r.ctx, "[DEBUG] BIOS configuration job uri: "+location.String())
+		tflog.Trace(ctx, "[DEBUG] BIOS configuration job uri: "+location.String())
 		taskURI := location.EscapedPath()
 		return taskURI, nil
 	}
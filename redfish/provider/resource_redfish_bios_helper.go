@@ -0,0 +1,440 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	biosAttributeRegistryID = "BiosAttributeRegistry"
+	// dellTaskURIPrefix is the URI of the tasks of the Dell jobs
+	dellTaskURIPrefix = "/redfish/v1/TaskService/Tasks/"
+)
+
+// biosMapFromConditions are the symbols of the conditions of the dependencies of the BIOS attribute registry
+var biosMapFromConditions = map[redfish.MapFromCondition]string{
+	redfish.EqualCondition:              "=",
+	redfish.NotEqualCondition:           "!=",
+	redfish.GreaterThanCondition:        ">",
+	redfish.GreaterThanOrEqualCondition: ">=",
+	redfish.LessThanCondition:           "<",
+	redfish.LessThanOrEqualCondition:    "<=",
+}
+
+// biosAttributeRegistry indexes the attributes and the dependencies of the BIOS attribute registry
+type biosAttributeRegistry struct {
+	attributes   map[string]*redfish.Attribute
+	dependencies []redfish.Dependency
+}
+
+func newBiosAttributeRegistry(registry *redfish.AttributeRegistry) *biosAttributeRegistry {
+	r := &biosAttributeRegistry{
+		attributes:   make(map[string]*redfish.Attribute, len(registry.RegistryEntries.Attributes)),
+		dependencies: registry.RegistryEntries.Dependencies,
+	}
+	for i := range registry.RegistryEntries.Attributes {
+		attribute := &registry.RegistryEntries.Attributes[i]
+		r.attributes[attribute.AttributeName] = attribute
+	}
+	return r
+}
+
+// getBiosAttributeRegistry fetches the attribute registry of the BIOS
+func getBiosAttributeRegistry(service *gofish.Service, bios *redfish.Bios) (*biosAttributeRegistry, error) {
+	registries, err := service.Registries()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range registries {
+		if r.ID != bios.AttributeRegistry && r.Registry != bios.AttributeRegistry && r.ID != biosAttributeRegistryID {
+			continue
+		}
+		for _, location := range r.Location {
+			if location.URI == "" {
+				continue
+			}
+			registry, err := redfish.GetAttributeRegistry(service.GetClient(), location.URI)
+			if err != nil {
+				return nil, err
+			}
+			return newBiosAttributeRegistry(registry), nil
+		}
+	}
+
+	return nil, fmt.Errorf("error. Couldn't retrieve %s", biosAttributeRegistryID)
+}
+
+// value converts the value of an attribute to the type of the attribute in the registry, to be used for PATCH
+func (r *biosAttributeRegistry) value(name, value string) (interface{}, bool, error) {
+	attribute, ok := r.attributes[name]
+	if !ok {
+		return nil, false, nil
+	}
+	switch attribute.Type {
+	case redfish.IntegerAttributeType:
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, true, fmt.Errorf("BIOS attribute %s is expected to be an integer: %w", name, err)
+		}
+		return intValue, true, nil
+	case redfish.BooleanAttributeType:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, true, fmt.Errorf("BIOS attribute %s is expected to be a boolean: %w", name, err)
+		}
+		return boolValue, true, nil
+	default:
+		return value, true, nil
+	}
+}
+
+// check validates the new value of an attribute against the registry, where values are the values of all the attributes
+// of the BIOS after the change, used to evaluate the dependencies of the attribute
+func (r *biosAttributeRegistry) check(name, value string, values map[string]string) error {
+	attribute, ok := r.attributes[name]
+	if !ok {
+		return nil
+	}
+	if err := checkBiosAttributeValue(attribute, value); err != nil {
+		return err
+	}
+
+	readOnly, hidden := attribute.ReadOnly || attribute.Immutable, attribute.Hidden
+	var readOnlyCondition, hiddenCondition string
+	for _, dependency := range r.dependencies {
+		expression := dependency.Dependency
+		if dependency.Type != redfish.MapDependencyType || expression.MapToAttribute != name {
+			continue
+		}
+		applies, condition := r.evaluate(expression.MapFrom, values)
+		if !applies {
+			continue
+		}
+		mapToValue, _ := expression.MapToValue.(bool)
+		switch expression.MapToProperty {
+		case redfish.ReadOnlyMapToProperty:
+			readOnly, readOnlyCondition = mapToValue, condition
+		case redfish.HiddenMapToProperty:
+			hidden, hiddenCondition = mapToValue, condition
+		case redfish.CurrentValueMapToProperty:
+			if required := biosAttributeValueString(expression.MapToValue); required != value {
+				return fmt.Errorf("BIOS attribute %s must be %s when %s", name, required, condition)
+			}
+		}
+	}
+
+	if readOnly {
+		return fmt.Errorf("BIOS attribute %s is read only%s", name, biosDependencyCondition(readOnlyCondition))
+	}
+	if hidden {
+		return fmt.Errorf("BIOS attribute %s is hidden%s", name, biosDependencyCondition(hiddenCondition))
+	}
+	return nil
+}
+
+// evaluate evaluates the map-from conditions of a dependency and returns whether they are met, with their description
+func (r *biosAttributeRegistry) evaluate(mapFrom []redfish.MapFrom, values map[string]string) (bool, string) {
+	result := false
+	terms := make([]string, 0, 2*len(mapFrom))
+	for i, m := range mapFrom {
+		met := r.evaluateCondition(m, values)
+		term := fmt.Sprintf("%s %s %s", m.MapFromAttribute, biosMapFromConditions[m.MapFromCondition], biosAttributeValueString(m.MapFromValue))
+		if m.MapFromProperty != "" && m.MapFromProperty != redfish.CurrentValueMapFromProperty {
+			term = fmt.Sprintf("%s.%s %s %s", m.MapFromAttribute, m.MapFromProperty, biosMapFromConditions[m.MapFromCondition],
+				biosAttributeValueString(m.MapFromValue))
+		}
+		switch {
+		case i == 0:
+			result = met
+		case m.MapTerms == redfish.OrLogicalTerm:
+			result = result || met
+			terms = append(terms, "or")
+		default:
+			result = result && met
+			terms = append(terms, "and")
+		}
+		terms = append(terms, term)
+	}
+	return result, strings.Join(terms, " ")
+}
+
+func (r *biosAttributeRegistry) evaluateCondition(m redfish.MapFrom, values map[string]string) bool {
+	var actual string
+	switch m.MapFromProperty {
+	case "", redfish.CurrentValueMapFromProperty:
+		value, ok := values[m.MapFromAttribute]
+		if !ok {
+			return false
+		}
+		actual = value
+	case redfish.ReadOnlyMapFromProperty, redfish.HiddenMapFromProperty:
+		attribute, ok := r.attributes[m.MapFromAttribute]
+		if !ok {
+			return false
+		}
+		actual = strconv.FormatBool(attribute.ReadOnly)
+		if m.MapFromProperty == redfish.HiddenMapFromProperty {
+			actual = strconv.FormatBool(attribute.Hidden)
+		}
+	default:
+		return false
+	}
+
+	expected := biosAttributeValueString(m.MapFromValue)
+	switch m.MapFromCondition {
+	case redfish.EqualCondition:
+		return actual == expected
+	case redfish.NotEqualCondition:
+		return actual != expected
+	}
+
+	actualNumber, err := strconv.ParseFloat(actual, 64)
+	if err != nil {
+		return false
+	}
+	expectedNumber, err := strconv.ParseFloat(expected, 64)
+	if err != nil {
+		return false
+	}
+	switch m.MapFromCondition {
+	case redfish.GreaterThanCondition:
+		return actualNumber > expectedNumber
+	case redfish.GreaterThanOrEqualCondition:
+		return actualNumber >= expectedNumber
+	case redfish.LessThanCondition:
+		return actualNumber < expectedNumber
+	case redfish.LessThanOrEqualCondition:
+		return actualNumber <= expectedNumber
+	}
+	return false
+}
+
+// checkBiosAttributeValue checks the value of an attribute against its type, enumeration values, bounds and regular expression
+func checkBiosAttributeValue(attribute *redfish.Attribute, value string) error {
+	name := attribute.AttributeName
+	switch attribute.Type {
+	case redfish.EnumerationAttributeType:
+		allowed := make([]string, 0, len(attribute.Value))
+		for _, v := range attribute.Value {
+			allowed = append(allowed, v.ValueName)
+		}
+		if len(allowed) > 0 && !slices.Contains(allowed, value) {
+			return fmt.Errorf("BIOS attribute %s value %s is not allowed, allowed values: %s", name, value, strings.Join(allowed, ", "))
+		}
+	case redfish.IntegerAttributeType:
+		intValue, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return fmt.Errorf("BIOS attribute %s is expected to be an integer, got %s", name, value)
+		}
+		lowerBound := big.NewInt(attribute.LowerBound)
+		if attribute.UpperBound.Cmp(lowerBound) > 0 && (intValue.Cmp(lowerBound) < 0 || intValue.Cmp(&attribute.UpperBound) > 0) {
+			return fmt.Errorf("BIOS attribute %s value %s is out of range, it must be between %d and %s",
+				name, value, attribute.LowerBound, attribute.UpperBound.String())
+		}
+		if attribute.ScalarIncrement > 1 {
+			increment := new(big.Int).Sub(intValue, lowerBound)
+			if increment.Mod(increment, big.NewInt(attribute.ScalarIncrement)).Sign() != 0 {
+				return fmt.Errorf("BIOS attribute %s value %s must be a multiple of %d from %d",
+					name, value, attribute.ScalarIncrement, attribute.LowerBound)
+			}
+		}
+	case redfish.BooleanAttributeType:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("BIOS attribute %s is expected to be a boolean, got %s", name, value)
+		}
+	case redfish.StringAttributeType, redfish.PasswordAttributeType:
+		if length := int64(len(value)); length < attribute.MinLength || (attribute.MaxLength > 0 && length > attribute.MaxLength) {
+			return fmt.Errorf("BIOS attribute %s length %d is out of range, it must be between %d and %d",
+				name, length, attribute.MinLength, attribute.MaxLength)
+		}
+		if attribute.ValueExpression == "" {
+			return nil
+		}
+		// the registry uses the Perl dialect, expressions which are not supported by Go are not checked
+		expression, err := regexp.Compile(attribute.ValueExpression)
+		if err == nil && !expression.MatchString(value) {
+			return fmt.Errorf("BIOS attribute %s value does not match the expression %s", name, attribute.ValueExpression)
+		}
+	}
+	return nil
+}
+
+// biosAttributeValueString returns the string representation of a value of the registry, as the attributes of the resource
+func biosAttributeValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func biosDependencyCondition(condition string) string {
+	if condition == "" {
+		return ""
+	}
+	return " when " + condition
+}
+
+// isMaintenanceWindowApplyTime returns whether the apply time requires a maintenance window
+func isMaintenanceWindowApplyTime(applyTime string) bool {
+	return applyTime == string(redfishcommon.AtMaintenanceWindowStartApplyTime) ||
+		applyTime == string(redfishcommon.InMaintenanceWindowOnResetApplyTime)
+}
+
+// isBiosSettingsStaged returns whether the BIOS settings of the plan are left pending instead of being applied right away
+func isBiosSettingsStaged(plan *models.Bios) bool {
+	applyTime := plan.SettingsApplyTime.ValueString()
+	return isMaintenanceWindowApplyTime(applyTime) || (applyTime == string(redfishcommon.OnResetApplyTime) && plan.StageOnly.ValueBool())
+}
+
+// biosSettingsURI returns the URI of the settings object of the BIOS
+func biosSettingsURI(bios *redfish.Bios) (string, error) {
+	oDataURI, err := url.Parse(bios.ODataID)
+	if err != nil {
+		return "", err
+	}
+	oDataURI.Path = path.Join(oDataURI.Path, "Settings")
+	return oDataURI.String(), nil
+}
+
+// getBiosPendingAttributes returns the attributes of the settings object of the BIOS, which are not applied yet
+func getBiosPendingAttributes(bios *redfish.Bios) (map[string]interface{}, error) {
+	settingsURI, err := biosSettingsURI(bios)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := bios.GetClient().Get(settingsURI)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var settings struct {
+		Attributes map[string]interface{}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&settings); err != nil {
+		return nil, err
+	}
+	return settings.Attributes, nil
+}
+
+// waitForBiosSettingsApplied polls the settings object of the BIOS until it has no pending attributes.
+// The iDRAC may not answer while the server reboots, such errors are retried until the timeout or the
+// cancellation of the context.
+func waitForBiosSettingsApplied(ctx context.Context, bios *redfish.Bios, interval, timeout int64) error {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		pending, err := getBiosPendingAttributes(bios)
+		if err == nil && len(pending) == 0 {
+			return nil
+		}
+		if err != nil {
+			tflog.Debug(ctx, "unable to fetch the pending BIOS attributes: "+err.Error())
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("timed out waiting for the BIOS settings to be applied: %w", err)
+			}
+			names := make([]string, 0, len(pending))
+			for name := range pending {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return fmt.Errorf("timed out waiting for the BIOS settings to be applied, pending attributes: %s", strings.Join(names, ", "))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+// applyBiosConfigurationJob applies the changes staged in the settings of the BIOS by actions or by other resources
+// of the system. On Dell servers, a BIOS configuration job is created when none is pending and the server is rebooted
+// to run the job. Servers without a job queue apply the changes without a job.
+func applyBiosConfigurationJob(ctx context.Context, service *gofish.Service, bios *redfish.Bios, systemID, resetType string,
+	resetTimeout, jobTimeout int64,
+) error {
+	manager, err := getManagerResource(service, "")
+	if err != nil {
+		return err
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		return err
+	}
+	if !dellManager.HasJobQueue() {
+		tflog.Info(ctx, "The manager does not provide a job queue, the BIOS settings are applied without a job")
+		return nil
+	}
+
+	settingsURI, err := biosSettingsURI(bios)
+	if err != nil {
+		return err
+	}
+	jobID, err := getPendingJobID(service, settingsURI, biosConfigurationJobType)
+	if err != nil {
+		return err
+	}
+	if jobID == "" {
+		jobURI, err := dellManager.CreateJob(settingsURI)
+		if err != nil {
+			return fmt.Errorf("unable to create the BIOS configuration job: %w", err)
+		}
+		jobID = path.Base(jobURI)
+	}
+
+	tflog.Info(ctx, "Rebooting the server to run the BIOS configuration job "+jobID)
+	pOp := powerOperator{ctx, service, systemID}
+	if _, err := pOp.PowerOperation(resetType, resetTimeout, intervalBiosConfigJobCheckTime); err != nil {
+		return fmt.Errorf("there was an issue restarting the server: %w", err)
+	}
+
+	err = common.WaitForDellJobToFinish(service, dellTaskURIPrefix+jobID, intervalBiosConfigJobCheckTime, jobTimeout)
+	if err != nil {
+		return fmt.Errorf("error waiting for the BIOS configuration job to be completed: %w", err)
+	}
+	return waitForBiosSettingsApplied(ctx, bios, intervalBiosConfigJobCheckTime, jobTimeout)
+}
@@ -0,0 +1,426 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// adminPasswordName is the BIOS setup password, required to enter the BIOS setup
+	adminPasswordName = "AdminPassword"
+	// systemPasswordName is the BIOS system password, required to boot the server
+	systemPasswordName = "SystemPassword"
+)
+
+// biosPasswordAttribute holds the names of the BIOS attribute of a password and of the BIOS attributes
+// reporting whether the password is set. The standard names come first, followed by the Dell names.
+type biosPasswordAttribute struct {
+	names    []string
+	statuses []string
+}
+
+// biosPasswordAttributes maps the passwords of the resource to their BIOS attributes
+var biosPasswordAttributes = map[string]biosPasswordAttribute{
+	adminPasswordName: {
+		names:    []string{"AdminPassword", "SetupPassword"},
+		statuses: []string{"AdminPasswordStatus", "SetupPasswordStatus"},
+	},
+	systemPasswordName: {
+		names:    []string{"SystemPassword", "SysPassword"},
+		statuses: []string{"SystemPasswordStatus", "SysPasswordStatus"},
+	},
+}
+
+// biosPasswordNotSetValues are the values of the status attributes reporting that a password is not set
+var biosPasswordNotSetValues = []string{"", "None", "NotSet", "NotInstalled", "Disabled"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &BiosPasswordResource{}
+	_ resource.ResourceWithConfigure  = &BiosPasswordResource{}
+	_ resource.ResourceWithModifyPlan = &BiosPasswordResource{}
+)
+
+// NewBiosPasswordResource is a helper function to simplify the provider implementation.
+func NewBiosPasswordResource() resource.Resource {
+	return &BiosPasswordResource{}
+}
+
+// BiosPasswordResource is the resource implementation.
+type BiosPasswordResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *BiosPasswordResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata returns the resource type name.
+func (*BiosPasswordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "bios_password"
+}
+
+// Schema defines the schema for the resource.
+func (*BiosPasswordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to set, change or clear the BIOS setup and system passwords of the iDRAC Server" +
+			" with the ChangePassword action of the BIOS. The passwords are write-only, they are never stored in the Terraform state.",
+		Description: "This Terraform resource is used to set, change or clear the BIOS setup and system passwords of the iDRAC Server" +
+			" with the ChangePassword action of the BIOS. The passwords are write-only, they are never stored in the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resource.",
+				Description:         "The ID of the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System ID of the system",
+				Description:         "System ID of the system",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"password_name": schema.StringAttribute{
+				MarkdownDescription: "The BIOS password to manage. Accepted values: `AdminPassword`, `SystemPassword`." +
+					" AdminPassword is the setup password required to enter the BIOS setup," +
+					" SystemPassword is the password required to boot the server.",
+				Description: "The BIOS password to manage. Accepted values: AdminPassword, SystemPassword." +
+					" AdminPassword is the setup password required to enter the BIOS setup," +
+					" SystemPassword is the password required to boot the server.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(adminPasswordName, systemPasswordName),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The new value of the password. An empty value clears the password." +
+					" This attribute is write-only, it requires Terraform 1.11 or later." +
+					" Increment `password_wo_version` to apply a new value.",
+				Description: "The new value of the password. An empty value clears the password." +
+					" This attribute is write-only, it requires Terraform 1.11 or later." +
+					" Increment password_wo_version to apply a new value.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"old_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The current value of the password, required by the BIOS when the password is already set." +
+					" This attribute is write-only, it requires Terraform 1.11 or later.",
+				Description: "The current value of the password, required by the BIOS when the password is already set." +
+					" This attribute is write-only, it requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(tfpath.MatchRoot("new_password_wo")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `new_password_wo`. As write-only values are not stored in the state," +
+					" the password is changed on the server only when the resource is created or when this version changes.",
+				Description: "The version of new_password_wo. As write-only values are not stored in the state," +
+					" the password is changed on the server only when the resource is created or when this version changes.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(tfpath.MatchRoot("new_password_wo")),
+				},
+			},
+			"password_set": schema.BoolAttribute{
+				MarkdownDescription: "Whether the password is set, as reported by the BIOS attributes of the server." +
+					" When the BIOS does not report it, this is whether the last password applied by the resource is not empty.",
+				Description: "Whether the password is set, as reported by the BIOS attributes of the server." +
+					" When the BIOS does not report it, this is whether the last password applied by the resource is not empty.",
+				Computed: true,
+			},
+			"reset_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Reset type to apply on the computer system after the password is changed, when the change" +
+					" is applied by a BIOS configuration job. Applicable values are 'ForceRestart', 'GracefulRestart'," +
+					" and 'PowerCycle'. Default = \"GracefulRestart\".",
+				MarkdownDescription: "Reset type to apply on the computer system after the password is changed, when the change" +
+					" is applied by a BIOS configuration job. Applicable values are `ForceRestart`, `GracefulRestart`," +
+					" and `PowerCycle`. Default = `GracefulRestart`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						string(redfish.ForceRestartResetType),
+						string(redfish.GracefulRestartResetType),
+						string(redfish.PowerCycleResetType),
+					}...),
+				},
+				Computed: true,
+				Default:  stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			},
+			"reset_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "reset_timeout is the time in seconds that the provider waits for the server to be reset before timing out.",
+				Default:     int64default.StaticInt64(int64(defaultBiosConfigServerResetTimeout)),
+				Computed:    true,
+			},
+			"bios_job_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "bios_job_timeout is the time in seconds that the provider waits for the BIOS configuration job" +
+					" applying the password to be completed before timing out.",
+				Default:  int64default.StaticInt64(int64(defaultBiosConfigJobTimeout)),
+				Computed: true,
+			},
+		},
+		Blocks: RedfishServerResourceBlockMap(),
+	}
+}
+
+// ModifyPlan marks password_set as unknown when the password is changed
+func (*BiosPasswordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state models.BiosPassword
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if biosPasswordChanged(&plan, &state) {
+		plan.PasswordSet = types.BoolUnknown()
+	} else {
+		plan.PasswordSet = state.PasswordSet
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *BiosPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_bios_password create : Started")
+	var plan, config models.BiosPassword
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(r.updateBiosPassword(ctx, api.Service, &plan, &config, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_bios_password create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *BiosPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_bios_password read: started")
+	var state models.BiosPassword
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	if err := readBiosPassword(api.Service, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading the BIOS password status", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_bios_password read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *BiosPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_bios_password update: started")
+	var plan, state, config models.BiosPassword
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	if plan.PasswordSet.IsUnknown() {
+		plan.PasswordSet = state.PasswordSet
+	}
+	resp.Diagnostics.Append(r.updateBiosPassword(ctx, api.Service, &plan, &config, biosPasswordChanged(&plan, &state))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_bios_password update: finished")
+}
+
+// Delete removes the resource from the Terraform state. The password is left as is on the server.
+func (*BiosPasswordResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_bios_password delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_bios_password delete: finished")
+}
+
+// biosPasswordChanged returns whether the plan changes the password on the server
+func biosPasswordChanged(plan, state *models.BiosPassword) bool {
+	return !plan.PasswordWoVersion.Equal(state.PasswordWoVersion)
+}
+
+// updateBiosPassword changes the password when requested, applies the change and reads the status of the password
+func (r *BiosPasswordResource) updateBiosPassword(ctx context.Context, service *gofish.Service, plan, config *models.BiosPassword,
+	change bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Lock the mutex to serialize the password change with the other changes of the BIOS
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
+	system, err := getSystemResource(service, plan.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching the computer system", err.Error())
+		return diags
+	}
+	bios, err := system.Bios()
+	if err != nil {
+		diags.AddError("Error fetching the BIOS", err.Error())
+		return diags
+	}
+	plan.SystemID = types.StringValue(system.ID)
+	plan.ID = types.StringValue(bios.ODataID)
+
+	if change && !config.NewPassword.IsNull() {
+		name := getBiosPasswordAttribute(bios, plan.PasswordName.ValueString())
+		tflog.Info(ctx, "Changing the BIOS password "+name)
+		err = bios.ChangePassword(name, config.OldPassword.ValueString(), config.NewPassword.ValueString())
+		if err != nil {
+			diags.AddError("Error changing the BIOS password", err.Error())
+			return diags
+		}
+		if err := applyBiosPassword(ctx, service, bios, plan); err != nil {
+			diags.AddError("Error applying the BIOS password", err.Error())
+			return diags
+		}
+		plan.PasswordSet = types.BoolValue(config.NewPassword.ValueString() != "")
+	}
+
+	if err := readBiosPassword(service, plan); err != nil {
+		diags.AddError("Error reading the BIOS password status", err.Error())
+	}
+	return diags
+}
+
+// applyBiosPassword applies the password change with the settings of the BIOS
+func applyBiosPassword(ctx context.Context, service *gofish.Service, bios *redfish.Bios, plan *models.BiosPassword) error {
+	return applyBiosConfigurationJob(ctx, service, bios, plan.SystemID.ValueString(), plan.ResetType.ValueString(),
+		plan.ResetTimeout.ValueInt64(), plan.JobTimeout.ValueInt64())
+}
+
+// readBiosPassword reads whether the password is set from the BIOS attributes. The last known value is kept
+// when the BIOS does not report it.
+func readBiosPassword(service *gofish.Service, state *models.BiosPassword) error {
+	system, err := getSystemResource(service, state.SystemID.ValueString())
+	if err != nil {
+		return err
+	}
+	bios, err := system.Bios()
+	if err != nil {
+		return err
+	}
+	if set, ok := getBiosPasswordSet(bios, state.PasswordName.ValueString()); ok {
+		state.PasswordSet = types.BoolValue(set)
+	} else if state.PasswordSet.IsUnknown() {
+		state.PasswordSet = types.BoolNull()
+	}
+	return nil
+}
+
+// getBiosPasswordAttribute returns the name of the BIOS attribute of the password, as known by the BIOS
+func getBiosPasswordAttribute(bios *redfish.Bios, passwordName string) string {
+	names := biosPasswordAttributes[passwordName].names
+	for _, name := range names {
+		if _, ok := bios.Attributes[name]; ok {
+			return name
+		}
+	}
+	if len(names) == 0 {
+		return passwordName
+	}
+	return names[0]
+}
+
+// getBiosPasswordSet returns whether the password is set, and whether the BIOS attributes report it
+func getBiosPasswordSet(bios *redfish.Bios, passwordName string) (bool, bool) {
+	for _, name := range biosPasswordAttributes[passwordName].statuses {
+		if value, ok := bios.Attributes[name]; ok {
+			return !slices.Contains(biosPasswordNotSetValues, biosAttributeValueString(value)), true
+		}
+	}
+	return false, false
+}
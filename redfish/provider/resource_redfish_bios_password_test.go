@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to set, change and clear the BIOS setup password - Positive
+func TestAccRedfishBiosPassword_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name       = "AdminPassword"
+				new_password_wo     = "Setup@123"
+				password_wo_version = 1
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_bios_password.password", "password_set", "true"),
+					resource.TestCheckNoResourceAttr("redfish_bios_password.password", "new_password_wo"),
+					resource.TestCheckResourceAttrSet("redfish_bios_password.password", "system_id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name       = "AdminPassword"
+				old_password_wo     = "Setup@123"
+				new_password_wo     = "Setup@456"
+				password_wo_version = 2
+				`),
+				Check: resource.TestCheckResourceAttr("redfish_bios_password.password", "password_set", "true"),
+			},
+			{
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name       = "AdminPassword"
+				old_password_wo     = "Setup@456"
+				new_password_wo     = ""
+				password_wo_version = 3
+				`),
+				Check: resource.TestCheckResourceAttr("redfish_bios_password.password", "password_set", "false"),
+			},
+		},
+	})
+}
+
+// Test the BIOS password with an invalid configuration - Negative
+func TestAccRedfishBiosPassword_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name   = "UserPassword"
+				new_password_wo = "Setup@123"
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name       = "AdminPassword"
+				password_wo_version = 1
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+// Test to change the BIOS password with a mocked error - Negative
+func TestAccRedfishBiosPassword_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(applyBiosPassword).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config: testAccRedfishResourceBiosPasswordConfig(creds, `
+				password_name       = "SystemPassword"
+				new_password_wo     = "System@123"
+				password_wo_version = 1
+				`),
+				ExpectError: regexp.MustCompile("Error applying the BIOS password"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestBiosPasswordAttributes(t *testing.T) {
+	bios := &redfish.Bios{Attributes: redfish.SettingsAttributes{
+		"SetupPassword":     nil,
+		"SysPassword":       nil,
+		"SysPasswordStatus": "NotInstalled",
+		"PasswordStatus":    "Unlocked",
+	}}
+
+	if name := getBiosPasswordAttribute(bios, adminPasswordName); name != "SetupPassword" {
+		t.Errorf("Expected the Dell setup password attribute, got %s", name)
+	}
+	if name := getBiosPasswordAttribute(bios, systemPasswordName); name != "SysPassword" {
+		t.Errorf("Expected the Dell system password attribute, got %s", name)
+	}
+	if name := getBiosPasswordAttribute(&redfish.Bios{}, adminPasswordName); name != adminPasswordName {
+		t.Errorf("Expected the standard password attribute, got %s", name)
+	}
+
+	if set, ok := getBiosPasswordSet(bios, systemPasswordName); !ok || set {
+		t.Errorf("Expected the system password to be reported as not set, got %v, %v", set, ok)
+	}
+	if _, ok := getBiosPasswordSet(bios, adminPasswordName); ok {
+		t.Error("Expected the setup password status not to be reported")
+	}
+	bios.Attributes["SetupPasswordStatus"] = "Installed"
+	if set, ok := getBiosPasswordSet(bios, adminPasswordName); !ok || !set {
+		t.Errorf("Expected the setup password to be reported as set, got %v, %v", set, ok)
+	}
+}
+
+func TestBiosPasswordChanged(t *testing.T) {
+	state := &models.BiosPassword{PasswordWoVersion: types.Int64Value(1)}
+	if biosPasswordChanged(&models.BiosPassword{PasswordWoVersion: types.Int64Value(1)}, state) {
+		t.Error("Expected the password not to change with the same version")
+	}
+	if !biosPasswordChanged(&models.BiosPassword{PasswordWoVersion: types.Int64Value(2)}, state) {
+		t.Error("Expected the password to change with a new version")
+	}
+	if !biosPasswordChanged(&models.BiosPassword{PasswordWoVersion: types.Int64Null()}, state) {
+		t.Error("Expected the password to change when the version is removed")
+	}
+}
+
+func testAccRedfishResourceBiosPasswordConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_bios_password" "password" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -18,13 +18,25 @@ limitations under the License.
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"reflect"
 	"regexp"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
 	"testing"
 
 	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // test redfish bios settings
@@ -442,6 +454,289 @@ func TestAccRedfishBios_17GImportSystemID(t *testing.T) {
 	}
 }
 
+// Test to validate the attributes against the BIOS attribute registry at plan time - Negative
+func TestAccRedfishBios_AttributeRegistry(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceBiosConfigAttribute(creds, "NumLock", "Blinking"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("BIOS attribute NumLock value Blinking is not allowed"),
+			},
+			{
+				Config:      testAccRedfishResourceBiosConfigAttribute(creds, "SystemServiceTag", "ABCDEFG"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("BIOS attribute SystemServiceTag is read only"),
+			},
+		},
+	})
+}
+
+func TestBiosAttributeRegistryCheck(t *testing.T) {
+	var attributeRegistry redfish.AttributeRegistry
+	if err := json.Unmarshal([]byte(testBiosAttributeRegistry), &attributeRegistry); err != nil {
+		t.Fatalf("unable to decode the BIOS attribute registry: %v", err)
+	}
+	registry := newBiosAttributeRegistry(&attributeRegistry)
+	current := map[string]string{
+		"NumLock":             "On",
+		"ProcVirtualization":  "Enabled",
+		"ProcX2Apic":          "Enabled",
+		"AcPwrRcvryDelay":     "Immediate",
+		"AcPwrRcvryUserDelay": "60",
+		"SystemServiceTag":    "ABCDEFG",
+		"AssetTag":            "",
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		changes   map[string]string
+		wantError string
+	}{
+		{"NumLock", "Off", nil, ""},
+		{"NumLock", "Blinking", nil, "value Blinking is not allowed, allowed values: On, Off"},
+		{"SystemServiceTag", "1234567", nil, "SystemServiceTag is read only"},
+		{"AcPwrRcvryUserDelay", "90", nil, "AcPwrRcvryUserDelay is read only when AcPwrRcvryDelay != User"},
+		{"AcPwrRcvryUserDelay", "90", map[string]string{"AcPwrRcvryDelay": "User"}, ""},
+		{"AcPwrRcvryUserDelay", "500", map[string]string{"AcPwrRcvryDelay": "User"}, "out of range, it must be between 60 and 240"},
+		{"AcPwrRcvryUserDelay", "sixty", map[string]string{"AcPwrRcvryDelay": "User"}, "is expected to be an integer"},
+		{"ProcX2Apic", "Disabled", nil, ""},
+		{"ProcX2Apic", "Enabled", map[string]string{"ProcVirtualization": "Disabled"}, ""},
+		{"ProcX2Apic", "Disabled", map[string]string{"ProcVirtualization": "Enabled", "NumLock": "Off"}, ""},
+		{"AssetTag", "TAG-1", nil, ""},
+		{"AssetTag", "TAG 1", nil, "does not match the expression"},
+		{"AssetTag", "TAG-0123456789", nil, "length 14 is out of range"},
+		{"Unknown", "Value", nil, ""},
+	}
+
+	for _, tt := range tests {
+		values := make(map[string]string, len(current))
+		for key, value := range current {
+			values[key] = value
+		}
+		for key, value := range tt.changes {
+			values[key] = value
+		}
+		values[tt.name] = tt.value
+
+		err := registry.check(tt.name, tt.value, values)
+		if tt.wantError == "" && err != nil {
+			t.Errorf("check(%s=%s, %v) unexpected error: %v", tt.name, tt.value, tt.changes, err)
+		}
+		if tt.wantError != "" && (err == nil || !strings.Contains(err.Error(), tt.wantError)) {
+			t.Errorf("check(%s=%s, %v) error = %v, want %q", tt.name, tt.value, tt.changes, err, tt.wantError)
+		}
+	}
+
+	// the dependency requires ProcX2Apic to be disabled with the virtualization
+	values := map[string]string{"ProcVirtualization": "Disabled", "ProcX2Apic": "Enabled", "NumLock": "On"}
+	if err := registry.check("ProcX2Apic", "Enabled", values); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	values["NumLock"] = "Off"
+	err := registry.check("ProcX2Apic", "Enabled", values)
+	if err == nil || err.Error() != "BIOS attribute ProcX2Apic must be Disabled when ProcVirtualization = Disabled and NumLock = Off" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestGetBiosAttrsToPatch(t *testing.T) {
+	var attributeRegistry redfish.AttributeRegistry
+	if err := json.Unmarshal([]byte(testBiosAttributeRegistry), &attributeRegistry); err != nil {
+		t.Fatalf("unable to decode the BIOS attribute registry: %v", err)
+	}
+	registry := newBiosAttributeRegistry(&attributeRegistry)
+	current := map[string]string{"NumLock": "On", "AcPwrRcvryDelay": "Immediate", "AcPwrRcvryUserDelay": "60", "MemTest": "1"}
+	plan := &models.Bios{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+		"NumLock":             types.StringValue("Off"),
+		"AcPwrRcvryDelay":     types.StringValue("User"),
+		"AcPwrRcvryUserDelay": types.StringValue("120"),
+		"MemTest":             types.StringValue("2"),
+	})}
+
+	patch, diags := getBiosAttrsToPatch(context.Background(), plan, current, registry, false)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+	want := map[string]interface{}{"NumLock": "Off", "AcPwrRcvryDelay": "User", "AcPwrRcvryUserDelay": 120, "MemTest": 2}
+	if !reflect.DeepEqual(patch, want) {
+		t.Fatalf("getBiosAttrsToPatch() = %v, want %v", patch, want)
+	}
+
+	// the user delay is read only unless the delay is set to User
+	plan.Attributes = types.MapValueMust(types.StringType, map[string]attr.Value{"AcPwrRcvryUserDelay": types.StringValue("120")})
+	if _, diags := getBiosAttrsToPatch(context.Background(), plan, current, registry, false); !diags.HasError() {
+		t.Fatal("Expected an error for a read only attribute, got none")
+	}
+	// the attributes are not validated without the registry
+	if _, diags := getBiosAttrsToPatch(context.Background(), plan, current, nil, false); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+}
+
+// Test to set the apply time of the settings without the maintenance window and to stage an immediate apply - Negative
+func TestAccRedfishBios_InvalidApplyTime(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceBiosConfigApplyTime(creds, `settings_apply_time = "AtMaintenanceWindowStart"`),
+				ExpectError: regexp.MustCompile("maintenance_window is required"),
+			},
+			{
+				Config: testAccRedfishResourceBiosConfigApplyTime(creds, `
+				settings_apply_time = "OnReset"
+				maintenance_window = {
+					start_time = "2030-01-01T02:00:00-06:00"
+					duration   = 3600
+				}
+				`),
+				ExpectError: regexp.MustCompile("maintenance_window is only applicable"),
+			},
+			{
+				Config: testAccRedfishResourceBiosConfigApplyTime(creds, `
+				settings_apply_time = "Immediate"
+				stage_only          = true
+				`),
+				ExpectError: regexp.MustCompile("stage_only is only applicable"),
+			},
+		},
+	})
+}
+
+// Test to stage the bios settings in a maintenance window - Positive
+func TestAccRedfishBios_MaintenanceWindow(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping Bios Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBiosConfigApplyTime(creds, `
+				settings_apply_time = "AtMaintenanceWindowStart"
+				maintenance_window = {
+					start_time = "2030-01-01T02:00:00-06:00"
+					duration   = 3600
+				}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_bios.bios", "attributes.NumLock", "Off"),
+					resource.TestCheckResourceAttr("redfish_bios.bios", "settings_apply_time", "AtMaintenanceWindowStart"),
+					// the staged attributes are read with their pending value until the maintenance window
+					resource.TestCheckResourceAttr("redfish_bios.bios", "pending_attributes.NumLock", "Off"),
+					resource.TestCheckResourceAttrSet("redfish_bios.bios", "pending_job_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestIsBiosSettingsStaged(t *testing.T) {
+	tests := []struct {
+		applyTime string
+		stageOnly bool
+		want      bool
+	}{
+		{"Immediate", false, false},
+		{"OnReset", false, false},
+		{"OnReset", true, true},
+		{"AtMaintenanceWindowStart", false, true},
+		{"InMaintenanceWindowOnReset", false, true},
+	}
+	for _, tt := range tests {
+		plan := &models.Bios{SettingsApplyTime: types.StringValue(tt.applyTime), StageOnly: types.BoolValue(tt.stageOnly)}
+		if got := isBiosSettingsStaged(plan); got != tt.want {
+			t.Errorf("isBiosSettingsStaged(%s, %v) = %v, want %v", tt.applyTime, tt.stageOnly, got, tt.want)
+		}
+	}
+}
+
+func TestWaitForBiosSettingsApplied(t *testing.T) {
+	settings := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}
+	client := &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{
+		http.MethodGet: {
+			settings(`{"Attributes": {"NumLock": "Off"}}`),
+			&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))},
+			settings(`{"Attributes": {}}`),
+		},
+	}}
+	bios := &redfish.Bios{}
+	bios.ODataID = "/redfish/v1/Systems/System.Embedded.1/Bios"
+	bios.SetClient(client)
+
+	if err := waitForBiosSettingsApplied(context.Background(), bios, 0, 60); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	calls := client.CapturedCalls()
+	if len(calls) != 3 || calls[0].URL != "/redfish/v1/Systems/System.Embedded.1/Bios/Settings" {
+		t.Fatalf("Unexpected calls %v", calls)
+	}
+
+	client.Reset()
+	client.CustomReturnForActions[http.MethodGet] = []interface{}{settings(`{"Attributes": {"NumLock": "Off", "MemTest": "Enabled"}}`)}
+	err := waitForBiosSettingsApplied(context.Background(), bios, 0, 0)
+	if err == nil || !strings.Contains(err.Error(), "pending attributes: MemTest, NumLock") {
+		t.Fatalf("Expected a timeout with the pending attributes, got %v", err)
+	}
+
+	// the wait stops when the context is done
+	client.Reset()
+	client.CustomReturnForActions[http.MethodGet] = []interface{}{settings(`{"Attributes": {"NumLock": "Off"}}`)}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := waitForBiosSettingsApplied(ctx, bios, 30, 600); !errors.Is(err, context.Canceled) || len(client.CapturedCalls()) != 1 {
+		t.Fatalf("Expected the wait to be canceled after a single read, got %v after %d reads", err, len(client.CapturedCalls()))
+	}
+}
+
+func TestPatchBiosAttributes(t *testing.T) {
+	jobResponse := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header:     http.Header{"Location": []string{"/redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_001"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	client := &redfishcommon.TestClient{CustomReturnForActions: map[string][]interface{}{
+		http.MethodPatch: {jobResponse, &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}},
+	}}
+	bios := &redfish.Bios{}
+	bios.ODataID = "/redfish/v1/Systems/System.Embedded.1/Bios"
+	bios.SetClient(client)
+	plan := &models.Bios{SettingsApplyTime: types.StringValue("OnReset")}
+
+	taskURI, err := patchBiosAttributes(context.Background(), plan, bios, map[string]interface{}{"NumLock": "On"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if taskURI != "/redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_001" {
+		t.Fatalf("Expected the job URI of the Location header, got %q", taskURI)
+	}
+	calls := client.CapturedCalls()
+	if len(calls) != 1 || calls[0].URL != "/redfish/v1/Systems/System.Embedded.1/Bios/Settings" ||
+		!strings.Contains(calls[0].Payload, "NumLock") || !strings.Contains(calls[0].Payload, "OnReset") {
+		t.Fatalf("Unexpected calls %v", calls)
+	}
+
+	// a PATCH without a Location header does not create a job
+	taskURI, err = patchBiosAttributes(context.Background(), plan, bios, map[string]interface{}{"NumLock": "Off"})
+	if err != nil || taskURI != "" {
+		t.Fatalf("Expected no job URI and no error, got %q and %v", taskURI, err)
+	}
+
+	plan.SettingsApplyTime = types.StringValue("Never")
+	if _, err := patchBiosAttributes(context.Background(), plan, bios, map[string]interface{}{"NumLock": "On"}); err == nil {
+		t.Fatal("Expected an error for an apply time that is not allowed, got nil")
+	}
+}
+
 func testAccRedfishResourceBiosConfigOn(testingInfo TestingServerCredentials) string {
 	return fmt.Sprintf(`
 
@@ -566,3 +861,98 @@ func testAccRedfishResourceBiosConfigInvalidAttributes(testingInfo TestingServer
 		testingInfo.Endpoint,
 	)
 }
+
+func testAccRedfishResourceBiosConfigAttribute(testingInfo TestingServerCredentials, name, value string) string {
+	return fmt.Sprintf(`
+		resource "redfish_bios" "bios"  {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+
+		  attributes = {
+			"%s" = "%s"
+		  }
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		name,
+		value,
+	)
+}
+
+var testBiosAttributeRegistry = `{
+  "@odata.id": "/redfish/v1/Registries/BiosAttributeRegistry/BiosAttributeRegistry.v1_0_3.json",
+  "Id": "BiosAttributeRegistry.v1_0_3",
+  "Name": "BIOS Attribute Registry",
+  "RegistryEntries": {
+    "Attributes": [
+      {"AttributeName": "NumLock", "Type": "Enumeration", "ReadOnly": false, "Hidden": false,
+        "Value": [{"ValueName": "On", "ValueDisplayName": "On"}, {"ValueName": "Off", "ValueDisplayName": "Off"}]},
+      {"AttributeName": "ProcVirtualization", "Type": "Enumeration", "ReadOnly": false,
+        "Value": [{"ValueName": "Enabled"}, {"ValueName": "Disabled"}]},
+      {"AttributeName": "ProcX2Apic", "Type": "Enumeration", "ReadOnly": false,
+        "Value": [{"ValueName": "Enabled"}, {"ValueName": "Disabled"}]},
+      {"AttributeName": "AcPwrRcvryDelay", "Type": "Enumeration", "ReadOnly": false,
+        "Value": [{"ValueName": "Immediate"}, {"ValueName": "Random"}, {"ValueName": "User"}]},
+      {"AttributeName": "AcPwrRcvryUserDelay", "Type": "Integer", "ReadOnly": false, "LowerBound": 60, "UpperBound": 240},
+      {"AttributeName": "MemTest", "Type": "Integer", "ReadOnly": false, "LowerBound": 0, "UpperBound": 0},
+      {"AttributeName": "SystemServiceTag", "Type": "String", "ReadOnly": true, "MinLength": 0, "MaxLength": 7},
+      {"AttributeName": "AssetTag", "Type": "String", "ReadOnly": false, "MinLength": 0, "MaxLength": 10,
+        "ValueExpression": "^[A-Za-z0-9-]*$"}
+    ],
+    "Dependencies": [
+      {
+        "DependencyFor": "AcPwrRcvryUserDelay",
+        "Type": "Map",
+        "Dependency": {
+          "MapFrom": [{"MapFromAttribute": "AcPwrRcvryDelay", "MapFromCondition": "NEQ", "MapFromProperty": "CurrentValue", "MapFromValue": "User"}],
+          "MapToAttribute": "AcPwrRcvryUserDelay",
+          "MapToProperty": "ReadOnly",
+          "MapToValue": true
+        }
+      },
+      {
+        "DependencyFor": "ProcX2Apic",
+        "Type": "Map",
+        "Dependency": {
+          "MapFrom": [
+            {"MapFromAttribute": "ProcVirtualization", "MapFromCondition": "EQU", "MapFromProperty": "CurrentValue", "MapFromValue": "Disabled"},
+            {"MapFromAttribute": "NumLock", "MapFromCondition": "EQU", "MapFromProperty": "CurrentValue", "MapFromValue": "Off",
+              "MapTerms": "AND"}
+          ],
+          "MapToAttribute": "ProcX2Apic",
+          "MapToProperty": "CurrentValue",
+          "MapToValue": "Disabled"
+        }
+      }
+    ]
+  }
+}`
+
+func testAccRedfishResourceBiosConfigApplyTime(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_bios" "bios"  {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+
+		  attributes = {
+			"NumLock" = "Off"
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
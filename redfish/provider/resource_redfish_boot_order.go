@@ -330,8 +330,11 @@ func (*BootOrderResource) ImportState(ctx context.Context, req resource.ImportSt
 
 func (r *BootOrderResource) bootOperation(ctx context.Context, service *gofish.Service, plan *models.BootOrder) diag.Diagnostics {
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic(lockServerErrorMsg, err.Error())}
+	}
+	defer unlock()
 
 	resp, diags := r.updateRedfishDellBootAttributes(service, plan)
 	if diags.HasError() {
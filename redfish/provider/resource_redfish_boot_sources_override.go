@@ -292,14 +292,18 @@ func (*BootSourceOverrideResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *BootSourceOverrideResource) bootOperation(ctx context.Context, service *gofish.Service, plan *models.BootSourceOverride) diag.Diagnostics {
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
-
 	var resp *http.Response
 	var diags diag.Diagnostics
 	var uri string
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
 	system, err := getSystemResource(service, plan.SystemID.ValueString())
 	if err != nil {
 		diags.AddError("[ERROR]: Failed to get system resource", err.Error())
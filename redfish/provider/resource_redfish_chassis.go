@@ -0,0 +1,395 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &chassisResource{}
+	_ resource.ResourceWithConfigure   = &chassisResource{}
+	_ resource.ResourceWithImportState = &chassisResource{}
+)
+
+// NewChassisResource is a helper function to simplify the provider implementation.
+func NewChassisResource() resource.Resource {
+	return &chassisResource{}
+}
+
+// chassisResource is the resource implementation.
+type chassisResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *chassisResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_chassis configured")
+}
+
+// Metadata returns the resource type name.
+func (*chassisResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "chassis"
+}
+
+// ChassisResourceSchema to design the schema for the chassis resource.
+func ChassisResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis resource",
+			Description:         "ID of the chassis resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"chassis_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis, for example `System.Embedded.1`. Defaults to the first chassis.",
+			Description:         "ID of the chassis, for example System.Embedded.1. Defaults to the first chassis.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the computer system whose asset tag is `system_asset_tag`, for example `System.Embedded.1`." +
+				" Defaults to the first computer system.",
+			Description: "ID of the computer system whose asset tag is system_asset_tag, for example System.Embedded.1." +
+				" Defaults to the first computer system.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		// the settings below are not kept from the state when they are not configured, as changing one of them
+		// can change another, such as the indicator LED blinking when the location indicator is activated
+		"asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the chassis. It is left unchanged when not configured.",
+			Description:         "Asset tag of the chassis. It is left unchanged when not configured.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"system_asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the computer system. It is left unchanged when not configured." +
+				" On servers where the chassis and the computer system share the same asset tag, configure only one of" +
+				" `asset_tag` and `system_asset_tag`.",
+			Description: "Asset tag of the computer system. It is left unchanged when not configured." +
+				" On servers where the chassis and the computer system share the same asset tag, configure only one of" +
+				" asset_tag and system_asset_tag.",
+			Optional: true,
+			Computed: true,
+		},
+		"location_indicator_active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the location indicator of the chassis is active, to find the server in the rack." +
+				" It is left unchanged when not configured. Conflicts with `indicator_led`.",
+			Description: "Whether the location indicator of the chassis is active, to find the server in the rack." +
+				" It is left unchanged when not configured. Conflicts with indicator_led.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("indicator_led")),
+			},
+		},
+		"indicator_led": schema.StringAttribute{
+			MarkdownDescription: "State of the indicator LED of the chassis, for servers which do not support" +
+				" `location_indicator_active`. Accepted values: `Lit`, `Blinking`, `Off`. It is left unchanged when not configured.",
+			Description: "State of the indicator LED of the chassis, for servers which do not support" +
+				" location_indicator_active. Accepted values: Lit, Blinking, Off. It is left unchanged when not configured.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(common.LitIndicatorLED),
+					string(common.BlinkingIndicatorLED),
+					string(common.OffIndicatorLED),
+				),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*chassisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to set the asset tags of the chassis and of the computer system," +
+			" and to turn the location indicator LED of the chassis on or off.",
+		Description: "This Terraform resource is used to set the asset tags of the chassis and of the computer system," +
+			" and to turn the location indicator LED of the chassis on or off.",
+		Attributes: ChassisResourceSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *chassisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_chassis create : Started")
+	var plan models.ChassisResource
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "resource_chassis create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_chassis create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *chassisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_chassis read: started")
+	var state models.ChassisResource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	chassis, system, diags := getChassisAndSystem(api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateChassisState(chassis, system, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_chassis read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *chassisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_chassis update: started")
+	var plan models.ChassisResource
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_chassis update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*chassisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_chassis delete: started")
+	var state models.ChassisResource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the asset tags and the indicator are left as they are on the server
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_chassis delete: finished")
+}
+
+// ImportState import state for an existing chassis
+func (*chassisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ChassisID    string `json:"chassis_id"`
+		SystemID     string `json:"system_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("chassis_id"), c.ChassisID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("system_id"), c.SystemID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redfish_server"), []models.RedfishServer{server})...)
+}
+
+// apply patches the settings of the plan which differ from the chassis and the computer system and refreshes the plan
+func (r *chassisResource) apply(ctx context.Context, plan *models.ChassisResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	defer api.Logout()
+
+	chassis, system, d := getChassisAndSystem(api.Service, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if patch := chassisPatch(plan, chassis); len(patch) > 0 {
+		tflog.Info(ctx, "Updating the chassis", map[string]any{"chassis": chassis.ID, "patch": patch})
+		if err := patchChassisSettings(api.Service, chassis.ODataID, patch); err != nil {
+			diags.AddError("Error updating the chassis", err.Error())
+			return diags
+		}
+	}
+	if patch := systemAssetTagPatch(plan, system); len(patch) > 0 {
+		tflog.Info(ctx, "Updating the computer system", map[string]any{"system": system.ID, "patch": patch})
+		if err := patchChassisSettings(api.Service, system.ODataID, patch); err != nil {
+			diags.AddError("Error updating the computer system", err.Error())
+			return diags
+		}
+	}
+
+	chassis, system, d = getChassisAndSystem(api.Service, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	updateChassisState(chassis, system, plan)
+	return diags
+}
+
+// getChassisAndSystem returns the chassis and the computer system of the model
+func getChassisAndSystem(service *gofish.Service, m *models.ChassisResource) (*redfish.Chassis, *redfish.ComputerSystem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	chassis, err := getChassisResource(service, m.ChassisID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching chassis", err.Error())
+		return nil, nil, diags
+	}
+	system, err := getSystemResource(service, m.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching computer system", err.Error())
+		return nil, nil, diags
+	}
+	return chassis, system, diags
+}
+
+// patchChassisSettings sends the settings to the chassis or the computer system
+func patchChassisSettings(service *gofish.Service, uri string, patch map[string]any) error {
+	resp, err := service.GetClient().Patch(uri, patch)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// chassisPatch returns the chassis settings of the plan which differ from the current ones
+func chassisPatch(plan *models.ChassisResource, chassis *redfish.Chassis) map[string]any {
+	patch := make(map[string]any)
+	if !plan.AssetTag.IsNull() && !plan.AssetTag.IsUnknown() && plan.AssetTag.ValueString() != chassis.AssetTag {
+		patch["AssetTag"] = plan.AssetTag.ValueString()
+	}
+	active := plan.LocationIndicatorActive
+	if !active.IsNull() && !active.IsUnknown() && active.ValueBool() != chassis.LocationIndicatorActive {
+		patch["LocationIndicatorActive"] = active.ValueBool()
+	}
+	if !plan.IndicatorLED.IsNull() && !plan.IndicatorLED.IsUnknown() && plan.IndicatorLED.ValueString() != string(chassis.IndicatorLED) {
+		patch["IndicatorLED"] = plan.IndicatorLED.ValueString()
+	}
+	return patch
+}
+
+// systemAssetTagPatch returns the asset tag of the plan when it differs from the one of the computer system
+func systemAssetTagPatch(plan *models.ChassisResource, system *redfish.ComputerSystem) map[string]any {
+	patch := make(map[string]any)
+	if !plan.SystemAssetTag.IsNull() && !plan.SystemAssetTag.IsUnknown() && plan.SystemAssetTag.ValueString() != system.AssetTag {
+		patch["AssetTag"] = plan.SystemAssetTag.ValueString()
+	}
+	return patch
+}
+
+// updateChassisState copies the settings read from the chassis and the computer system into the state
+func updateChassisState(chassis *redfish.Chassis, system *redfish.ComputerSystem, state *models.ChassisResource) {
+	state.ID = types.StringValue(chassis.ID)
+	state.ChassisID = types.StringValue(chassis.ID)
+	state.SystemID = types.StringValue(system.ID)
+	state.AssetTag = types.StringValue(chassis.AssetTag)
+	state.SystemAssetTag = types.StringValue(system.AssetTag)
+	state.LocationIndicatorActive = types.BoolValue(chassis.LocationIndicatorActive)
+	state.IndicatorLED = types.StringValue(string(chassis.IndicatorLED))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to set the asset tags and the location indicator of the chassis and import it - Positive
+func TestAccRedfishChassis_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceChassisConfig(creds, `
+				asset_tag                 = "TF-0001"
+				location_indicator_active = true
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_chassis.chassis", "asset_tag", "TF-0001"),
+					resource.TestCheckResourceAttr("redfish_chassis.chassis", "location_indicator_active", "true"),
+					resource.TestCheckResourceAttrSet("redfish_chassis.chassis", "chassis_id"),
+					resource.TestCheckResourceAttrSet("redfish_chassis.chassis", "system_id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceChassisConfig(creds, `
+				asset_tag                 = "TF-0002"
+				location_indicator_active = false
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_chassis.chassis", "asset_tag", "TF-0002"),
+					resource.TestCheckResourceAttr("redfish_chassis.chassis", "location_indicator_active", "false"),
+				),
+			},
+			{
+				ResourceName:      "redfish_chassis.chassis",
+				ImportState:       true,
+				ImportStateId:     "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"redfish_server",
+				},
+			},
+		},
+	})
+}
+
+// Test to set both the location indicator and the indicator LED - Negative
+func TestAccRedfishChassis_ConflictingIndicators(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceChassisConfig(creds, `
+				location_indicator_active = true
+				indicator_led             = "Blinking"
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      testAccRedfishResourceChassisConfig(creds, `indicator_led = "On"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+// Test to set the asset tag with a mocked error - Negative
+func TestAccRedfishChassis_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(patchChassisSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceChassisConfig(creds, `asset_tag = "TF-MOCK"`),
+				ExpectError: regexp.MustCompile("Error updating the chassis"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestChassisPatch(t *testing.T) {
+	chassis := &redfish.Chassis{AssetTag: "OLD", IndicatorLED: common.OffIndicatorLED}
+	system := &redfish.ComputerSystem{AssetTag: "SYS"}
+	plan := &models.ChassisResource{
+		AssetTag:                types.StringValue("NEW"),
+		SystemAssetTag:          types.StringValue("SYS"),
+		LocationIndicatorActive: types.BoolValue(true),
+		IndicatorLED:            types.StringUnknown(),
+	}
+
+	patch := chassisPatch(plan, chassis)
+	if len(patch) != 2 || patch["AssetTag"] != "NEW" || patch["LocationIndicatorActive"] != true {
+		t.Fatalf("Unexpected chassis patch %v", patch)
+	}
+	if patch := systemAssetTagPatch(plan, system); len(patch) != 0 {
+		t.Fatalf("Expected no system patch for an unchanged asset tag, got %v", patch)
+	}
+
+	// the settings which are not configured are left unchanged
+	plan = &models.ChassisResource{
+		AssetTag:                types.StringNull(),
+		SystemAssetTag:          types.StringValue("NEW-SYS"),
+		LocationIndicatorActive: types.BoolUnknown(),
+		IndicatorLED:            types.StringValue("Lit"),
+	}
+	patch = chassisPatch(plan, chassis)
+	if len(patch) != 1 || patch["IndicatorLED"] != "Lit" {
+		t.Fatalf("Unexpected chassis patch %v", patch)
+	}
+	if patch := systemAssetTagPatch(plan, system); len(patch) != 1 || patch["AssetTag"] != "NEW-SYS" {
+		t.Fatalf("Unexpected system patch %v", patch)
+	}
+}
+
+func testAccRedfishResourceChassisConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_chassis" "chassis" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -0,0 +1,338 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// finishedDellJobStates are the states of the jobs deleted by clear_finished_jobs
+var finishedDellJobStates = []string{"Completed", "CompletedWithErrors", "Failed"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dellJobQueueResource{}
+	_ resource.ResourceWithConfigure      = &dellJobQueueResource{}
+	_ resource.ResourceWithValidateConfig = &dellJobQueueResource{}
+)
+
+// NewDellJobQueueResource is a helper function to simplify the provider implementation.
+func NewDellJobQueueResource() resource.Resource {
+	return &dellJobQueueResource{}
+}
+
+// dellJobQueueResource is the resource implementation.
+type dellJobQueueResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *dellJobQueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_dell_job_queue configured")
+}
+
+// Metadata returns the resource type name.
+func (*dellJobQueueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "dell_job_queue"
+}
+
+// DellJobQueueSchema to design the schema for the Dell job queue resource.
+func DellJobQueueSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the job queue resource",
+			Description:         "ID of the job queue resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager whose job queue is cleaned up. Defaults to the first manager.",
+			Description:         "ID of the manager whose job queue is cleaned up. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"job_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the jobs to delete, for example `JID_878682850779`.",
+			Description:         "IDs of the jobs to delete, for example JID_878682850779.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"clear_finished_jobs": schema.BoolAttribute{
+			MarkdownDescription: "Delete the jobs in the `Completed`, `CompletedWithErrors` or `Failed` state. Defaults to `false`.",
+			Description:         "Delete the jobs in the Completed, CompletedWithErrors or Failed state. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"clear_all_force": schema.BoolAttribute{
+			MarkdownDescription: "Delete every job of the queue, including the pending and running ones, with `" +
+				dell.ClearAllJobsForceID + "`. The Lifecycle Controller is restarted and the pending configuration " +
+				"changes are discarded. Defaults to `false`.",
+			Description: "Delete every job of the queue, including the pending and running ones, with " +
+				dell.ClearAllJobsForceID + ". The Lifecycle Controller is restarted and the pending configuration " +
+				"changes are discarded. Defaults to false.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"deleted_job_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the jobs that were deleted.",
+			Description:         "IDs of the jobs that were deleted.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*dellJobQueueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to clean up the Lifecycle Controller job queue of the iDRAC, " +
+			"deleting specific jobs, the finished jobs or every job of the queue.",
+		Description: "This resource is used to clean up the Lifecycle Controller job queue of the iDRAC, " +
+			"deleting specific jobs, the finished jobs or every job of the queue.",
+		Attributes: DellJobQueueSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*dellJobQueueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.DellJobQueue
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.JobIDs.IsUnknown() || config.ClearFinishedJobs.IsUnknown() || config.ClearAllForce.IsUnknown() {
+		return
+	}
+
+	deleteJobs := !config.JobIDs.IsNull() || config.ClearFinishedJobs.ValueBool()
+	if config.ClearAllForce.ValueBool() && deleteJobs {
+		resp.Diagnostics.AddError(
+			"Invalid job queue configuration",
+			"clear_all_force deletes every job of the queue and cannot be combined with job_ids or clear_finished_jobs.")
+		return
+	}
+	if !config.ClearAllForce.ValueBool() && !deleteJobs {
+		resp.Diagnostics.AddError(
+			"Invalid job queue configuration",
+			"One of job_ids, clear_finished_jobs or clear_all_force is required.")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dellJobQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_dell_job_queue create : Started")
+	var plan models.DellJobQueue
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jobIDs []string
+	resp.Diagnostics.Append(plan.JobIDs.ElementsAs(ctx, &jobIDs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	jobService, err := dellManager.JobService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the job service", err.Error())
+		return
+	}
+	jobs, err := dellManager.Jobs()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the jobs", err.Error())
+		return
+	}
+
+	var deleted []string
+	if plan.ClearAllForce.ValueBool() {
+		if err := jobService.DeleteJobQueue(dell.ClearAllJobsForceID); err != nil {
+			resp.Diagnostics.AddError("Error clearing the job queue", err.Error())
+			return
+		}
+		for _, job := range jobs {
+			deleted = append(deleted, job.ID)
+		}
+	} else {
+		toDelete, err := selectDellJobsToDelete(jobs, jobIDs, plan.ClearFinishedJobs.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Error selecting the jobs to delete", err.Error())
+			return
+		}
+		for _, jobID := range toDelete {
+			if err := jobService.DeleteJobQueue(jobID); err != nil {
+				// keep the jobs deleted so far in the error, the next apply deletes the remaining ones
+				resp.Diagnostics.AddError("Error deleting the job "+jobID,
+					fmt.Sprintf("%s. Jobs deleted before the error: %v", err.Error(), deleted))
+				return
+			}
+			deleted = append(deleted, jobID)
+		}
+	}
+	tflog.Info(ctx, "Deleted jobs", map[string]any{
+		"manager": dellManager.ID,
+		"jobs":    deleted,
+	})
+
+	if deleted == nil {
+		deleted = []string{}
+	}
+	deletedJobIDs, diags := types.ListValueFrom(ctx, types.StringType, deleted)
+	resp.Diagnostics.Append(diags...)
+	plan.ID = types.StringValue(dellManager.ID)
+	plan.ManagerID = types.StringValue(dellManager.ID)
+	plan.DeletedJobIDs = deletedJobIDs
+
+	tflog.Trace(ctx, "resource_dell_job_queue create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_dell_job_queue create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*dellJobQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_dell_job_queue read: started")
+	var state models.DellJobQueue
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting jobs is a one time action, new jobs are queued afterwards and there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_dell_job_queue read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*dellJobQueueResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating job queue.",
+		"An update plan of job queue should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*dellJobQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_dell_job_queue delete: started")
+	var state models.DellJobQueue
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_dell_job_queue delete: finished")
+}
+
+// selectDellJobsToDelete returns the IDs of the given jobs followed by the finished jobs when clearFinished is set.
+// Every given job must be in the queue.
+func selectDellJobsToDelete(jobs []*dell.Job, jobIDs []string, clearFinished bool) ([]string, error) {
+	queued := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		queued[job.ID] = true
+	}
+
+	toDelete := make([]string, 0, len(jobIDs))
+	for _, jobID := range jobIDs {
+		if !queued[jobID] {
+			return nil, fmt.Errorf("the job %s is not in the job queue", jobID)
+		}
+		toDelete = append(toDelete, jobID)
+	}
+	if clearFinished {
+		for _, job := range jobs {
+			if slices.Contains(finishedDellJobStates, job.JobState) && !slices.Contains(toDelete, job.ID) {
+				toDelete = append(toDelete, job.ID)
+			}
+		}
+	}
+	return toDelete, nil
+}
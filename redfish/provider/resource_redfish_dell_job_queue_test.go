@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"terraform-provider-redfish/gofish/dell"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// Test to delete the finished jobs of the job queue - Positive
+func TestAccRedfishDellJobQueue_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceDellJobQueueConfig(creds, `clear_finished_jobs = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_dell_job_queue.queue", "id"),
+					resource.TestCheckResourceAttrSet("redfish_dell_job_queue.queue", "deleted_job_ids.#"),
+					resource.TestCheckResourceAttr("redfish_dell_job_queue.queue", "clear_all_force", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Test to validate the job queue configuration - Negative
+func TestAccRedfishDellJobQueue_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceDellJobQueueConfig(creds, ""),
+				ExpectError: regexp.MustCompile("One of job_ids, clear_finished_jobs or clear_all_force is required"),
+			},
+			{
+				Config: testAccRedfishResourceDellJobQueueConfig(creds, `
+				clear_all_force     = true
+				clear_finished_jobs = true
+				`),
+				ExpectError: regexp.MustCompile("cannot be combined"),
+			},
+			{
+				Config:      testAccRedfishResourceDellJobQueueConfig(creds, `job_ids = ["JID_000000000000"]`),
+				ExpectError: regexp.MustCompile("is not in the job queue"),
+			},
+		},
+	})
+}
+
+// Test to clear the job queue with a mocked error - Negative
+func TestAccRedfishDellJobQueue_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock((*dell.JobService).DeleteJobQueue).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceDellJobQueueConfig(creds, `clear_all_force = true`),
+				ExpectError: regexp.MustCompile("Error clearing the job queue"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestSelectDellJobsToDelete(t *testing.T) {
+	job := func(id, state string) *dell.Job {
+		return &dell.Job{Entity: common.Entity{ID: id}, JobState: state}
+	}
+	jobs := []*dell.Job{
+		job("JID_1", "Completed"),
+		job("JID_2", "Scheduled"),
+		job("JID_3", "Failed"),
+		job("JID_4", "CompletedWithErrors"),
+	}
+
+	got, err := selectDellJobsToDelete(jobs, []string{"JID_2"}, false)
+	if err != nil || !slices.Equal(got, []string{"JID_2"}) {
+		t.Errorf("Expected [JID_2], got %v, %v", got, err)
+	}
+
+	got, err = selectDellJobsToDelete(jobs, []string{"JID_3"}, true)
+	if err != nil || !slices.Equal(got, []string{"JID_3", "JID_1", "JID_4"}) {
+		t.Errorf("Expected [JID_3 JID_1 JID_4], got %v, %v", got, err)
+	}
+
+	if _, err := selectDellJobsToDelete(jobs, []string{"JID_5"}, false); err == nil {
+		t.Error("Expected an error for a job which is not queued, got nil")
+	}
+}
+
+func testAccRedfishResourceDellJobQueueConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_dell_job_queue" "queue" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
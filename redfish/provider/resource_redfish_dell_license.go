@@ -0,0 +1,474 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultLicenseImportOptions replaces a license with the same entitlement ID
+	defaultLicenseImportOptions = "Force"
+	// licenseDeleteOptions deletes the license even when features use it
+	licenseDeleteOptions = "Force"
+)
+
+// licenseEntitlementIDRegex extracts the entitlement ID from a license file
+var licenseEntitlementIDRegex = regexp.MustCompile(`<EntitlementID>\s*([^<\s]+)\s*</EntitlementID>`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dellLicenseResource{}
+	_ resource.ResourceWithConfigure   = &dellLicenseResource{}
+	_ resource.ResourceWithImportState = &dellLicenseResource{}
+)
+
+// NewDellLicenseResource is a helper function to simplify the provider implementation.
+func NewDellLicenseResource() resource.Resource {
+	return &dellLicenseResource{}
+}
+
+// dellLicenseResource is the resource implementation.
+type dellLicenseResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *dellLicenseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata returns the resource type name.
+func (*dellLicenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "dell_license"
+}
+
+// Schema defines the schema for the resource.
+func (*dellLicenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to import a license on the iDRAC and delete it by its entitlement ID.",
+		Description:         "This Terraform resource is used to import a license on the iDRAC and delete it by its entitlement ID.",
+		Attributes:          DellLicenseSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// DellLicenseSchema defines the schema for the Dell license resource
+func DellLicenseSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the license resource, the entitlement ID of the license.",
+			Description:         "ID of the license resource, the entitlement ID of the license.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager the license is installed on. Defaults to the first manager.",
+			Description:         "ID of the manager the license is installed on. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"license_file": schema.StringAttribute{
+			MarkdownDescription: "Path of the local license file to import. Conflicts with `license_content`.",
+			Description:         "Path of the local license file to import. Conflicts with license_content.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("license_file"), path.MatchRoot("license_content")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"license_content": schema.StringAttribute{
+			MarkdownDescription: "Base64 encoded content of the license file to import. Conflicts with `license_file`.",
+			Description:         "Base64 encoded content of the license file to import. Conflicts with license_file.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"import_options": schema.StringAttribute{
+			MarkdownDescription: "Options of the license import. Defaults to `" + defaultLicenseImportOptions + "`, " +
+				"which replaces a license with the same entitlement ID.",
+			Description: "Options of the license import. Defaults to " + defaultLicenseImportOptions + ", " +
+				"which replaces a license with the same entitlement ID.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(defaultLicenseImportOptions),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"entitlement_id": schema.StringAttribute{
+			MarkdownDescription: "Entitlement ID of the imported license.",
+			Description:         "Entitlement ID of the imported license.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"license_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the license, such as `Perpetual`, `Evaluation` or `Subscription`.",
+			Description:         "Type of the license, such as Perpetual, Evaluation or Subscription.",
+			Computed:            true,
+		},
+		"license_description": schema.ListAttribute{
+			MarkdownDescription: "Description of the features of the license.",
+			Description:         "Description of the features of the license.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the license.",
+			Description:         "Status of the license.",
+			Computed:            true,
+		},
+		"install_date": schema.StringAttribute{
+			MarkdownDescription: "Date the license was installed.",
+			Description:         "Date the license was installed.",
+			Computed:            true,
+		},
+		"eval_time_remaining_days": schema.Int64Attribute{
+			MarkdownDescription: "Number of days before an evaluation license expires.",
+			Description:         "Number of days before an evaluation license expires.",
+			Computed:            true,
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dellLicenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_dell_license create : Started")
+	var plan models.DellLicenseResource
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenseContent, err := dellLicenseContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid license", err.Error())
+		return
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	licenseService, err := dellManager.LicenseManagementService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the license management service", err.Error())
+		return
+	}
+
+	before, err := dellManager.Licenses()
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+
+	if err := licenseService.ImportLicense(dellManager.ID, licenseContent, plan.ImportOptions.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error importing the license", err.Error())
+		return
+	}
+
+	after, err := dellManager.Licenses()
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	license, err := findImportedLicense(before, after, licenseContent)
+	if err != nil {
+		resp.Diagnostics.AddError("Error identifying the imported license", err.Error())
+		return
+	}
+
+	plan.ManagerID = types.StringValue(dellManager.ID)
+	resp.Diagnostics.Append(updateDellLicenseState(ctx, &plan, license)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_dell_license create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dellLicenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_dell_license read: started")
+	var state models.DellLicenseResource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, state.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	licenses, err := dellManager.Licenses()
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+
+	license := findLicenseByEntitlementID(licenses, state.ID.ValueString())
+	if license == nil {
+		// the license was deleted on the iDRAC, it needs to be imported again
+		tflog.Info(ctx, "License not found, removing it from the state", map[string]any{
+			"entitlement_id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ManagerID = types.StringValue(dellManager.ID)
+	resp.Diagnostics.Append(updateDellLicenseState(ctx, &state, license)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_dell_license read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*dellLicenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every argument of the license forces a replacement, only the connection details can change
+	var state, plan models.DellLicenseResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RedfishServer = plan.RedfishServer
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dellLicenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_dell_license delete: started")
+	var state models.DellLicenseResource
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service, state.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	licenses, err := dellManager.Licenses()
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	if findLicenseByEntitlementID(licenses, state.ID.ValueString()) == nil {
+		// already deleted on the iDRAC
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	licenseService, err := dellManager.LicenseManagementService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the license management service", err.Error())
+		return
+	}
+	if err := licenseService.DeleteLicense(state.ID.ValueString(), licenseDeleteOptions); err != nil {
+		resp.Diagnostics.AddError("Error deleting the license", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_dell_license delete: finished")
+}
+
+// ImportState import state for existing license
+func (*dellLicenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ID           string `json:"id"`
+		ManagerID    string `json:"manager_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+	if c.ID == "" {
+		resp.Diagnostics.AddError("Invalid import id", "the entitlement ID of the license is required as `id`")
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), c.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), c.ManagerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("import_options"), defaultLicenseImportOptions)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redfish_server"), []models.RedfishServer{server})...)
+}
+
+// dellLicenseContent returns the base64 encoded license from the license file or content
+func dellLicenseContent(plan *models.DellLicenseResource) (string, error) {
+	if file := plan.LicenseFile.ValueString(); file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("unable to read the license file: %w", err)
+		}
+		return base64.StdEncoding.EncodeToString(content), nil
+	}
+	content := plan.LicenseContent.ValueString()
+	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+		return "", fmt.Errorf("license_content is not valid base64: %w", err)
+	}
+	return content, nil
+}
+
+// findImportedLicense returns the license added by an import. When the import replaced a license with the
+// same entitlement ID, the entitlement ID is read from the license file instead.
+func findImportedLicense(before, after []*dell.License, licenseContent string) (*dell.License, error) {
+	existing := make(map[string]bool, len(before))
+	for _, license := range before {
+		existing[licenseEntitlementID(license)] = true
+	}
+	for _, license := range after {
+		if !existing[licenseEntitlementID(license)] {
+			return license, nil
+		}
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(licenseContent)
+	if err != nil {
+		return nil, err
+	}
+	match := licenseEntitlementIDRegex.FindSubmatch(decoded)
+	if match == nil {
+		return nil, fmt.Errorf("no new license was found after the import and the license file has no entitlement ID")
+	}
+	license := findLicenseByEntitlementID(after, string(match[1]))
+	if license == nil {
+		return nil, fmt.Errorf("the license %s was not found after the import", match[1])
+	}
+	return license, nil
+}
+
+// findLicenseByEntitlementID returns the license with the given entitlement ID, or nil when it is not installed
+func findLicenseByEntitlementID(licenses []*dell.License, entitlementID string) *dell.License {
+	for _, license := range licenses {
+		if licenseEntitlementID(license) == entitlementID {
+			return license
+		}
+	}
+	return nil
+}
+
+// updateDellLicenseState copies the license read from the iDRAC into the state
+func updateDellLicenseState(ctx context.Context, state *models.DellLicenseResource, license *dell.License) diag.Diagnostics {
+	licenseModel := newDellLicenseModel(license)
+	description, diags := types.ListValueFrom(ctx, types.StringType, licenseModel.LicenseDescription)
+	state.ID = licenseModel.EntitlementID
+	state.EntitlementID = licenseModel.EntitlementID
+	state.LicenseType = licenseModel.LicenseType
+	state.LicenseDescription = description
+	state.Status = licenseModel.Status
+	state.InstallDate = licenseModel.InstallDate
+	state.EvalTimeRemainingDays = licenseModel.EvalTimeRemainingDays
+	return diags
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// Test to import, re-import by ID and delete a license - Positive
+func TestAccRedfishDellLicense_basic(t *testing.T) {
+	licenseFile := os.Getenv("TF_TESTING_LICENSE_FILE")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if licenseFile == "" {
+				t.Skip("TF_TESTING_LICENSE_FILE must be set to import a license")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceDellLicenseConfig(creds, `license_file = "`+licenseFile+`"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_dell_license.license", "entitlement_id"),
+					resource.TestCheckResourceAttrSet("redfish_dell_license.license", "license_type"),
+					resource.TestCheckResourceAttr("redfish_dell_license.license", "import_options", "Force"),
+				),
+			},
+			{
+				ResourceName: "redfish_dell_license.license",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources["redfish_dell_license.license"].Primary.ID
+					return "{\"id\":\"" + id + "\",\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password +
+						"\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}", nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"license_file", "redfish_server"},
+			},
+		},
+	})
+}
+
+// Test to import a license with invalid content - Negative
+func TestAccRedfishDellLicense_InvalidContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceDellLicenseConfig(creds, `license_content = "not base64"`),
+				ExpectError: regexp.MustCompile("Invalid license"),
+			},
+			{
+				Config: testAccRedfishResourceDellLicenseConfig(creds, `
+				license_file = "license.xml"
+				license_content = "bGljZW5zZQ=="
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+// Test to import a license with a mocked error - Negative
+func TestAccRedfishDellLicense_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock((*dell.LicenseManagementService).ImportLicense).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceDellLicenseConfig(creds, `license_content = "bGljZW5zZQ=="`),
+				ExpectError: regexp.MustCompile("Error importing the license"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+// Test to import a license with an invalid id - Negative
+func TestAccRedfishDellLicense_ImportInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccRedfishResourceDellLicenseConfig(creds, `license_content = "bGljZW5zZQ=="`),
+				ResourceName:  "redfish_dell_license.license",
+				ImportState:   true,
+				ImportStateId: "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ExpectError:   regexp.MustCompile("Invalid import id"),
+			},
+		},
+	})
+}
+
+func TestFindImportedLicense(t *testing.T) {
+	license := func(id, entitlementID string) *dell.License {
+		return &dell.License{Entity: common.Entity{ID: id}, EntitlementID: entitlementID}
+	}
+	before := []*dell.License{license("A1", "A1")}
+
+	// a new license is found by comparing the licenses
+	imported, err := findImportedLicense(before, []*dell.License{license("A1", "A1"), license("B2", "")}, "")
+	if err != nil || licenseEntitlementID(imported) != "B2" {
+		t.Fatalf("Expected license B2, got %v, %v", imported, err)
+	}
+
+	// a replaced license is found by the entitlement ID of the license file
+	content := base64.StdEncoding.EncodeToString([]byte("<LicenseClass><EntitlementID> A1 </EntitlementID></LicenseClass>"))
+	imported, err = findImportedLicense(before, before, content)
+	if err != nil || licenseEntitlementID(imported) != "A1" {
+		t.Fatalf("Expected license A1, got %v, %v", imported, err)
+	}
+
+	content = base64.StdEncoding.EncodeToString([]byte("<LicenseClass></LicenseClass>"))
+	if _, err := findImportedLicense(before, before, content); err == nil {
+		t.Fatal("Expected an error for a license file without entitlement ID, got nil")
+	}
+}
+
+func testAccRedfishResourceDellLicenseConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_dell_license" "license" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
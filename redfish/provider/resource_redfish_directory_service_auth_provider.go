@@ -261,12 +261,16 @@ func (*RedfishDirectoryServiceAuthProviderResource) ImportState(ctx context.Cont
 }
 
 // nolint: gofumpt
-func (*RedfishDirectoryServiceAuthProviderResource) updateRedfishDirectoryServiceAuth(ctx context.Context, service *gofish.Service, plan,
+func (r *RedfishDirectoryServiceAuthProviderResource) updateRedfishDirectoryServiceAuth(ctx context.Context, service *gofish.Service, plan,
 	state *models.DirectoryServiceAuthProviderResource) diag.Diagnostics {
 	var diags diag.Diagnostics
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
 
 	activeServiceChanged := newActiveDirectoryChanged(ctx, plan, state)
 	ldapServiceChanged := newLDAPChanged(ctx, plan, state)
@@ -130,8 +130,12 @@ func (r *RedfishDirectoryServiceAuthProviderCertificateResource) Create(ctx cont
 		return
 	}
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 	if count == 0 && plan.CertificateType.ValueString() == pem {
 		diags = helper.CreateRedfishDirectoryServiceAuthCertificate(service, &plan)
 	} else {
@@ -199,8 +203,12 @@ func (r *RedfishDirectoryServiceAuthProviderCertificateResource) Update(ctx cont
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 	diags = helper.UpdateRedfishDirectoryServiceAuthCertificate(service, certURI, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,705 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// defaultTestEventMessageID is the message of the iDRAC test event registry
+	defaultTestEventMessageID = "TST100"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &eventSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &eventSubscriptionResource{}
+	_ resource.ResourceWithImportState = &eventSubscriptionResource{}
+)
+
+// NewEventSubscriptionResource is a helper function to simplify the provider implementation.
+func NewEventSubscriptionResource() resource.Resource {
+	return &eventSubscriptionResource{}
+}
+
+// eventSubscriptionResource is the resource implementation.
+type eventSubscriptionResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *eventSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata returns the resource type name.
+func (*eventSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "event_subscription"
+}
+
+// Schema defines the schema for the resource.
+func (*eventSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to manage the event subscriptions of the Redfish EventService, " +
+			"which forward the alerts of the server to an event listener.",
+		Description: "This Terraform resource is used to manage the event subscriptions of the Redfish EventService, " +
+			"which forward the alerts of the server to an event listener.",
+		Attributes: EventSubscriptionSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// EventSubscriptionSchema defines the schema for the event subscription resource
+func EventSubscriptionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the event subscription.",
+			Description:         "ID of the event subscription.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "URL of the event listener the events are sent to. Cannot be updated.",
+			Description:         "URL of the event listener the events are sent to. Cannot be updated.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol used to send the events. Accepted values: `Redfish`, `SNMPv1`, `SNMPv2c`, `SNMPv3`, " +
+				"`SMTP`, `SyslogUDP`, `SyslogTCP`, `SyslogTLS`, `SyslogRELP`. Defaults to `Redfish`. Cannot be updated.",
+			Description: "Protocol used to send the events. Accepted values: Redfish, SNMPv1, SNMPv2c, SNMPv3, " +
+				"SMTP, SyslogUDP, SyslogTCP, SyslogTLS, SyslogRELP. Defaults to Redfish. Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfish.RedfishEventDestinationProtocol)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					"Redfish", "SNMPv1", "SNMPv2c", "SNMPv3", "SMTP",
+					"SyslogUDP", "SyslogTCP", "SyslogTLS", "SyslogRELP",
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"subscription_type": schema.StringAttribute{
+			MarkdownDescription: "Style of the subscription. `RedfishEvent` pushes the events to the destination, " +
+				"`SSE` delivers them on the `server_sent_event_uri` stream. Defaults to `RedfishEvent`. Cannot be updated.",
+			Description: "Style of the subscription. RedfishEvent pushes the events to the destination, " +
+				"SSE delivers them on the server_sent_event_uri stream. Defaults to RedfishEvent. Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfish.RedfishEventSubscriptionType)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.RedfishEventSubscriptionType),
+					string(redfish.SSESubscriptionType),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"event_format_type": schema.StringAttribute{
+			MarkdownDescription: "Format of the payloads sent. Accepted values: `Event`, `MetricReport`. Cannot be updated.",
+			Description:         "Format of the payloads sent. Accepted values: Event, MetricReport. Cannot be updated.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(redfish.EventEventFormatType), string(redfish.MetricReportEventFormatType)),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"event_types": schema.ListAttribute{
+			MarkdownDescription: "Types of the events sent, for example `Alert`. Required by older iDRAC firmwares. " +
+				"Cannot be updated.",
+			Description: "Types of the events sent, for example Alert. Required by older iDRAC firmwares. " +
+				"Cannot be updated.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(
+					"Alert", "MetricReport", "Other", "ResourceAdded", "ResourceRemoved", "ResourceUpdated", "StatusChange",
+				)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"registry_prefixes": schema.ListAttribute{
+			MarkdownDescription: "Prefixes of the message registries whose messages are sent, for example `iDRAC`. " +
+				"All messages are sent when empty. Cannot be updated.",
+			Description: "Prefixes of the message registries whose messages are sent, for example iDRAC. " +
+				"All messages are sent when empty. Cannot be updated.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"resource_types": schema.ListAttribute{
+			MarkdownDescription: "Resource types whose events are sent, for example `Systems`. All resources are sent " +
+				"when empty. Cannot be updated.",
+			Description: "Resource types whose events are sent, for example Systems. All resources are sent " +
+				"when empty. Cannot be updated.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"message_ids": schema.ListAttribute{
+			MarkdownDescription: "Message IDs sent, for example `iDRAC.2.8.CPU0001`. All messages are sent when empty. " +
+				"Cannot be updated.",
+			Description: "Message IDs sent, for example iDRAC.2.8.CPU0001. All messages are sent when empty. " +
+				"Cannot be updated.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"context": schema.StringAttribute{
+			MarkdownDescription: "Client supplied string sent back with every event of the subscription.",
+			Description:         "Client supplied string sent back with every event of the subscription.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"http_headers": schema.MapAttribute{
+			MarkdownDescription: "HTTP headers, such as authorization headers, sent with every event. " +
+				"The BMC does not return them, so changes made outside of Terraform are not detected. Cannot be updated.",
+			Description: "HTTP headers, such as authorization headers, sent with every event. " +
+				"The BMC does not return them, so changes made outside of Terraform are not detected. Cannot be updated.",
+			Optional:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"delivery_retry_policy": schema.StringAttribute{
+			MarkdownDescription: "Behavior when the events cannot be delivered. Accepted values: `TerminateAfterRetries`, " +
+				"`SuspendRetries`, `RetryForever`, `RetryForeverWithBackoff`.",
+			Description: "Behavior when the events cannot be delivered. Accepted values: TerminateAfterRetries, " +
+				"SuspendRetries, RetryForever, RetryForeverWithBackoff.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.TerminateAfterRetriesDeliveryRetryPolicy),
+					string(redfish.SuspendRetriesDeliveryRetryPolicy),
+					string(redfish.RetryForeverDeliveryRetryPolicy),
+					string(redfish.RetryForeverWithBackoffDeliveryRetryPolicy),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"submit_test_event": schema.BoolAttribute{
+			MarkdownDescription: "Submit a test event to the destination after the subscription is created or updated. " +
+				"Defaults to `false`.",
+			Description: "Submit a test event to the destination after the subscription is created or updated. " +
+				"Defaults to false.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"test_event_message_id": schema.StringAttribute{
+			MarkdownDescription: "Message ID of the test event. Defaults to `" + defaultTestEventMessageID + "`.",
+			Description:         "Message ID of the test event. Defaults to " + defaultTestEventMessageID + ".",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultTestEventMessageID),
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"server_sent_event_uri": schema.StringAttribute{
+			MarkdownDescription: "URI of the server-sent events stream of the EventService, used by `SSE` subscriptions.",
+			Description:         "URI of the server-sent events stream of the EventService, used by SSE subscriptions.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *eventSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_event_subscription create : Started")
+	var plan models.EventSubscription
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	service := api.Service
+	defer api.Logout()
+
+	eventService, err := service.EventService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the event service", err.Error())
+		return
+	}
+
+	payload, diags := eventSubscriptionPayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := service.GetClient().Post(eventService.Subscriptions, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the event subscription", err.Error())
+		return
+	}
+	defer createResp.Body.Close()
+
+	subscriptionURI := createResp.Header.Get("Location")
+	if subscriptionURI == "" {
+		resp.Diagnostics.AddError("Error creating the event subscription", "the BMC did not return the subscription location")
+		return
+	}
+	plan.ID = types.StringValue(path.Base(strings.TrimRight(subscriptionURI, "/")))
+	// the subscription exists on the BMC from now on, it is kept in the state even if it cannot be read back,
+	// so that it is replaced rather than created twice
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("redfish_server"), plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := getEventSubscription(service, eventService, plan.ID.ValueString())
+	if err != nil || subscription == nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, fmt.Sprintf("unable to read the event subscription %s: %v", plan.ID.ValueString(), err))
+		return
+	}
+	resp.Diagnostics.Append(updateEventSubscriptionState(ctx, &plan, subscription, eventService)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubmitTestEvent.ValueBool() {
+		if err := submitTestEvent(service, eventService, &plan); err != nil {
+			resp.Diagnostics.AddWarning("Unable to submit the test event", err.Error())
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_event_subscription create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *eventSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_event_subscription read: started")
+	var state models.EventSubscription
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	service := api.Service
+	defer api.Logout()
+
+	eventService, err := service.EventService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the event service", err.Error())
+		return
+	}
+
+	subscription, err := getEventSubscription(service, eventService, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	if subscription == nil {
+		// the subscription was deleted on the BMC, it needs to be recreated
+		tflog.Info(ctx, "Event subscription not found, removing it from the state", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(updateEventSubscriptionState(ctx, &state, subscription, eventService)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_event_subscription read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *eventSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_event_subscription update: started")
+	var state, plan models.EventSubscription
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	service := api.Service
+	defer api.Logout()
+
+	eventService, err := service.EventService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the event service", err.Error())
+		return
+	}
+
+	subscription, err := getEventSubscription(service, eventService, state.ID.ValueString())
+	if err != nil || subscription == nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, fmt.Sprintf("unable to read the event subscription %s: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	patch := make(map[string]interface{})
+	if !plan.Context.IsUnknown() && plan.Context.ValueString() != subscription.Context {
+		patch["Context"] = plan.Context.ValueString()
+	}
+	if !plan.DeliveryRetryPolicy.IsUnknown() && plan.DeliveryRetryPolicy.ValueString() != string(subscription.DeliveryRetryPolicy) {
+		patch["DeliveryRetryPolicy"] = plan.DeliveryRetryPolicy.ValueString()
+	}
+	if len(patch) > 0 {
+		patchResp, err := service.GetClient().Patch(subscription.ODataID, patch)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating the event subscription", err.Error())
+			return
+		}
+		patchResp.Body.Close()
+
+		if subscription, err = getEventSubscription(service, eventService, state.ID.ValueString()); err != nil || subscription == nil {
+			resp.Diagnostics.AddError(RedfishFetchErrorMsg, fmt.Sprintf("unable to read the event subscription %s: %v", state.ID.ValueString(), err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(updateEventSubscriptionState(ctx, &plan, subscription, eventService)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubmitTestEvent.ValueBool() {
+		if err := submitTestEvent(service, eventService, &plan); err != nil {
+			resp.Diagnostics.AddWarning("Unable to submit the test event", err.Error())
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_event_subscription update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *eventSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_event_subscription delete: started")
+	var state models.EventSubscription
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	service := api.Service
+	defer api.Logout()
+
+	eventService, err := service.EventService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the event service", err.Error())
+		return
+	}
+
+	subscription, err := getEventSubscription(service, eventService, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	if subscription == nil {
+		// already deleted on the BMC
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := eventService.DeleteEventSubscription(subscription.ODataID); err != nil && !isRedfishNotFoundError(err) {
+		resp.Diagnostics.AddError("Error deleting the event subscription", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_event_subscription delete: finished")
+}
+
+// ImportState import state for existing event subscription
+func (*eventSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ID           string `json:"id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+	if c.ID == "" {
+		resp.Diagnostics.AddError("Invalid import id", "the `id` of the event subscription is required")
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("id"), c.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("redfish_server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("submit_test_event"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("test_event_message_id"), defaultTestEventMessageID)...)
+}
+
+// eventSubscriptionPayload builds the body of the subscription creation request
+func eventSubscriptionPayload(ctx context.Context, plan *models.EventSubscription) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := map[string]interface{}{
+		"Destination":      plan.Destination.ValueString(),
+		"Protocol":         plan.Protocol.ValueString(),
+		"SubscriptionType": plan.SubscriptionType.ValueString(),
+	}
+	if value := plan.EventFormatType.ValueString(); value != "" {
+		payload["EventFormatType"] = value
+	}
+	if value := plan.Context.ValueString(); value != "" {
+		payload["Context"] = value
+	}
+	if value := plan.DeliveryRetryPolicy.ValueString(); value != "" {
+		payload["DeliveryRetryPolicy"] = value
+	}
+
+	lists := map[string]types.List{
+		"EventTypes":       plan.EventTypes,
+		"RegistryPrefixes": plan.RegistryPrefixes,
+		"ResourceTypes":    plan.ResourceTypes,
+		"MessageIds":       plan.MessageIDs,
+	}
+	for key, list := range lists {
+		if list.IsNull() || list.IsUnknown() {
+			continue
+		}
+		var values []string
+		diags.Append(list.ElementsAs(ctx, &values, false)...)
+		payload[key] = values
+	}
+
+	if !plan.HTTPHeaders.IsNull() && !plan.HTTPHeaders.IsUnknown() {
+		headers := make(map[string]string)
+		diags.Append(plan.HTTPHeaders.ElementsAs(ctx, &headers, false)...)
+		// HttpHeaders is an array of objects in the Redfish schema
+		payload["HttpHeaders"] = []map[string]string{headers}
+	}
+	return payload, diags
+}
+
+// getEventSubscription returns the subscription with the given ID, or nil when it does not exist
+func getEventSubscription(service *gofish.Service, eventService *redfish.EventService, id string) (*redfish.EventDestination, error) {
+	subscription, err := redfish.GetEventDestination(service.GetClient(), strings.TrimRight(eventService.Subscriptions, "/")+"/"+id)
+	if err != nil {
+		if isRedfishNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// submitTestEvent asks the event service to send a test event to the subscription destination
+func submitTestEvent(service *gofish.Service, eventService *redfish.EventService, plan *models.EventSubscription) error {
+	if eventService.SubmitTestEventTarget == "" {
+		return fmt.Errorf("the event service does not support the SubmitTestEvent action")
+	}
+	payload := map[string]interface{}{
+		"Destination": plan.Destination.ValueString(),
+		"EventTypes":  "Alert",
+		"Context":     plan.Context.ValueString(),
+		"Protocol":    plan.Protocol.ValueString(),
+		"MessageId":   plan.TestEventMessageID.ValueString(),
+	}
+	testResp, err := service.GetClient().Post(eventService.SubmitTestEventTarget, payload)
+	if err != nil {
+		return err
+	}
+	testResp.Body.Close()
+	return nil
+}
+
+// updateEventSubscriptionState copies the subscription read from the BMC into the state.
+// Attributes the BMC does not return, like the HTTP headers, keep their planned value, and so does
+// the destination, which the BMC may return normalized.
+func updateEventSubscriptionState(ctx context.Context, state *models.EventSubscription, subscription *redfish.EventDestination,
+	eventService *redfish.EventService,
+) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	// the destination is only missing from the state when the subscription is imported
+	imported := state.Destination.IsNull() || state.Destination.IsUnknown()
+	state.ID = types.StringValue(subscription.ID)
+	if imported {
+		state.Destination = types.StringValue(subscription.Destination)
+	}
+	state.Context = types.StringValue(subscription.Context)
+	state.DeliveryRetryPolicy = types.StringValue(string(subscription.DeliveryRetryPolicy))
+	state.EventFormatType = types.StringValue(string(subscription.EventFormatType))
+	state.ServerSentEventURI = types.StringValue(eventService.ServerSentEventURI)
+	if subscription.Protocol != "" {
+		state.Protocol = types.StringValue(string(subscription.Protocol))
+	}
+	if subscription.SubscriptionType != "" {
+		state.SubscriptionType = types.StringValue(string(subscription.SubscriptionType))
+	}
+
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	state.EventTypes, d = eventSubscriptionList(ctx, eventTypes, state.EventTypes, imported)
+	diags.Append(d...)
+	state.RegistryPrefixes, d = eventSubscriptionList(ctx, subscription.RegistryPrefixes, state.RegistryPrefixes, imported)
+	diags.Append(d...)
+	state.ResourceTypes, d = eventSubscriptionList(ctx, subscription.ResourceTypes, state.ResourceTypes, imported)
+	diags.Append(d...)
+	state.MessageIDs, d = eventSubscriptionList(ctx, subscription.MessageIDs, state.MessageIDs, imported)
+	diags.Append(d...)
+	return diags
+}
+
+// eventSubscriptionList returns the filter of the subscription. An unset filter is kept null, so that the defaults of
+// the BMC are not seen as changes and removing a filter from the configuration replaces the subscription. The filters
+// of an imported subscription are read from the BMC.
+func eventSubscriptionList(ctx context.Context, values []string, current types.List, imported bool) (types.List, diag.Diagnostics) {
+	if current.IsNull() && (!imported || len(values) == 0) {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const eventSubscriptionDestination = "https://192.168.1.100:8188/events"
+
+// Test to create, update and import an event subscription - Positive
+func TestAccRedfishEventSubscription_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_event_subscription.subscription", "destination", eventSubscriptionDestination),
+					resource.TestCheckResourceAttr("redfish_event_subscription.subscription", "protocol", "Redfish"),
+					resource.TestCheckResourceAttr("redfish_event_subscription.subscription", "context", "terraform"),
+					resource.TestCheckResourceAttrSet("redfish_event_subscription.subscription", "id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_event_subscription.subscription", "context", "terraform-updated"),
+				),
+			},
+			{
+				ResourceName: "redfish_event_subscription.subscription",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources["redfish_event_subscription.subscription"].Primary.ID
+					return "{\"id\":\"" + id + "\",\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password +
+						"\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}", nil
+				},
+			},
+		},
+	})
+}
+
+// Test to import an event subscription with an invalid id - Negative
+func TestAccRedfishEventSubscription_ImportInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccRedfishResourceEventSubscriptionConfig(creds, "terraform"),
+				ResourceName:  "redfish_event_subscription.subscription",
+				ImportState:   true,
+				ImportStateId: "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ExpectError:   regexp.MustCompile("Invalid import id"),
+			},
+		},
+	})
+}
+
+// Test to create an event subscription with Mock err
+func TestAccRedfishEventSubscription_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(NewConfig).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceEventSubscriptionConfig(creds, "terraform"),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestUpdateEventSubscriptionState(t *testing.T) {
+	subscription := &redfish.EventDestination{
+		Destination:      "https://192.168.1.100:8188/events/",
+		RegistryPrefixes: []string{"iDRAC"},
+		EventTypes:       []redfish.EventType{redfish.AlertEventType},
+	}
+	subscription.ID = "1"
+	alert, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"Alert"})
+	state := &models.EventSubscription{
+		Destination:      types.StringValue(eventSubscriptionDestination),
+		EventTypes:       alert,
+		RegistryPrefixes: types.ListNull(types.StringType),
+		ResourceTypes:    types.ListNull(types.StringType),
+		MessageIDs:       types.ListNull(types.StringType),
+	}
+
+	if diags := updateEventSubscriptionState(context.Background(), state, subscription, &redfish.EventService{}); diags.HasError() {
+		t.Fatalf("Expected no error, got %v", diags)
+	}
+	// the configured destination is kept even when the BMC normalizes it
+	if state.Destination.ValueString() != eventSubscriptionDestination {
+		t.Errorf("Expected the configured destination, got %q", state.Destination.ValueString())
+	}
+	if !state.EventTypes.Equal(alert) {
+		t.Errorf("Expected the event types of the BMC, got %v", state.EventTypes)
+	}
+	// the unset filters stay null, even when the BMC returns default values for them
+	if !state.RegistryPrefixes.IsNull() || !state.ResourceTypes.IsNull() || !state.MessageIDs.IsNull() {
+		t.Errorf("Expected the unset filters to stay null, got %v, %v and %v", state.RegistryPrefixes, state.ResourceTypes, state.MessageIDs)
+	}
+
+	// an imported subscription reads the destination and the filters from the BMC
+	state.Destination = types.StringNull()
+	state.EventTypes = types.ListNull(types.StringType)
+	updateEventSubscriptionState(context.Background(), state, subscription, &redfish.EventService{})
+	if state.Destination.ValueString() != subscription.Destination {
+		t.Errorf("Expected the destination of the BMC, got %q", state.Destination.ValueString())
+	}
+	if !state.EventTypes.Equal(alert) || len(state.RegistryPrefixes.Elements()) != 1 {
+		t.Errorf("Expected the filters of the BMC, got %v and %v", state.EventTypes, state.RegistryPrefixes)
+	}
+	if !state.ResourceTypes.IsNull() || !state.MessageIDs.IsNull() {
+		t.Errorf("Expected the filters the BMC does not return to stay null, got %v and %v", state.ResourceTypes, state.MessageIDs)
+	}
+}
+
+func testAccRedfishResourceEventSubscriptionConfig(testingInfo TestingServerCredentials, context string) string {
+	return fmt.Sprintf(`
+	resource "redfish_event_subscription" "subscription" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		destination       = "%s"
+		event_types       = ["Alert"]
+		context           = "%s"
+		submit_test_event = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		eventSubscriptionDestination,
+		context,
+	)
+}
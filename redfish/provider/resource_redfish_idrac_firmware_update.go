@@ -295,8 +295,12 @@ func (r *idracFirmwareUpdateResource) Create(ctx context.Context, req resource.C
 		return
 	}
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	api, err := NewConfig(r.p, &plan.RedfishServer)
 	if err != nil {
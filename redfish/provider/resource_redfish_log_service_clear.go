@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &logServiceClearResource{}
+	_ resource.ResourceWithConfigure = &logServiceClearResource{}
+)
+
+// NewLogServiceClearResource is a helper function to simplify the provider implementation.
+func NewLogServiceClearResource() resource.Resource {
+	return &logServiceClearResource{}
+}
+
+// logServiceClearResource is the resource implementation.
+type logServiceClearResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *logServiceClearResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_log_service_clear configured")
+}
+
+// Metadata returns the resource type name.
+func (*logServiceClearResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "log_service_clear"
+}
+
+// LogServiceClearSchema to design the schema for log service clear resource.
+func LogServiceClearSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the log service clear resource",
+			Description:         "ID of the log service clear resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system. Defaults to the first system.",
+			Description:         "System ID of the system. Defaults to the first system.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"log_service_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the log service to clear, for example `Sel` or `Lclog`. The log services of the system " +
+				"are searched first, then the ones of the managers of the system.",
+			Description: "ID of the log service to clear, for example Sel or Lclog. The log services of the system " +
+				"are searched first, then the ones of the managers of the system.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"export_path": schema.StringAttribute{
+			MarkdownDescription: "Path of a local JSON file the entries are written to before the log is cleared. " +
+				"The log is not cleared when the export fails.",
+			Description: "Path of a local JSON file the entries are written to before the log is cleared. " +
+				"The log is not cleared when the export fails.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"exported_entries": schema.Int64Attribute{
+			MarkdownDescription: "Number of entries written to `export_path`.",
+			Description:         "Number of entries written to export_path.",
+			Computed:            true,
+		},
+		"log_service_odata_id": schema.StringAttribute{
+			MarkdownDescription: "OData ID of the cleared log service.",
+			Description:         "OData ID of the cleared log service.",
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*logServiceClearResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to clear a log service of the server, such as the System Event Log " +
+			"or the Lifecycle Controller log, optionally exporting its entries first.",
+		Description: "This resource is used to clear a log service of the server, such as the System Event Log " +
+			"or the Lifecycle Controller log, optionally exporting its entries first.",
+		Attributes: LogServiceClearSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *logServiceClearResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_log_service_clear create : Started")
+	var plan models.LogServiceClear
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	service := api.Service
+	defer api.Logout()
+
+	system, err := getSystemResource(service, plan.SystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching computer system", err.Error())
+		return
+	}
+	logService, err := getSystemLogService(system, plan.LogServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching log service", err.Error())
+		return
+	}
+
+	exported := 0
+	if exportPath := plan.ExportPath.ValueString(); exportPath != "" {
+		exported, err = exportLogServiceEntries(service, logService, exportPath)
+		if err != nil {
+			resp.Diagnostics.AddError("Error exporting the log entries, the log was not cleared", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Exported log entries", map[string]any{
+			"log_service": logService.ODataID,
+			"entries":     exported,
+			"path":        exportPath,
+		})
+	}
+
+	if err := logService.ClearLog(); err != nil {
+		resp.Diagnostics.AddError("Error clearing the log service", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(system.ID + "/" + logService.ID)
+	plan.SystemID = types.StringValue(system.ID)
+	plan.ExportedEntries = types.Int64Value(int64(exported))
+	plan.LogServiceODataID = types.StringValue(logService.ODataID)
+
+	tflog.Trace(ctx, "resource_log_service_clear create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_log_service_clear create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*logServiceClearResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_log_service_clear read: started")
+	var state models.LogServiceClear
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clearing the log is a one time action, the log fills up again and there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_log_service_clear read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*logServiceClearResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating log service clear.",
+		"An update plan of log service clear should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*logServiceClearResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_log_service_clear delete: started")
+	var state models.LogServiceClear
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_log_service_clear delete: finished")
+}
+
+// getSystemLogService returns the log service with the given ID of the system, or of one of its managers
+func getSystemLogService(system *redfish.ComputerSystem, logServiceID string) (*redfish.LogService, error) {
+	logServices, err := system.LogServices()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the log services of the system: %w", err)
+	}
+	for _, logService := range logServices {
+		if logService.ID == logServiceID {
+			return logService, nil
+		}
+	}
+
+	managers, err := system.ManagedBy()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the managers of the system: %w", err)
+	}
+	for _, manager := range managers {
+		logServices, err := manager.LogServices()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching the log services of the manager %s: %w", manager.ID, err)
+		}
+		for _, logService := range logServices {
+			if logService.ID == logServiceID {
+				return logService, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no log service found with id %s", logServiceID)
+}
+
+// exportLogServiceEntries writes the entries of the log service to a local JSON file and returns their number
+func exportLogServiceEntries(service *gofish.Service, logService *redfish.LogService, exportPath string) (int, error) {
+	members, err := listLogServiceMembers(service.GetClient(), logService)
+	if err != nil {
+		return 0, err
+	}
+	if members == nil {
+		members = []json.RawMessage{}
+	}
+
+	export := struct {
+		LogService string            `json:"LogService"`
+		ExportedAt string            `json:"ExportedAt"`
+		Entries    []json.RawMessage `json:"Entries"`
+	}{
+		LogService: logService.ODataID,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+		Entries:    members,
+	}
+	content, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	if dir := filepath.Dir(exportPath); dir != "." {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return 0, err
+		}
+	}
+	if err := os.WriteFile(exportPath, content, 0o600); err != nil {
+		return 0, err
+	}
+	return len(members), nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test to export and clear the System Event Log - Positive
+func TestAccRedfishLogServiceClear_basic(t *testing.T) {
+	exportPath := filepath.Join(t.TempDir(), "sel.json")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceLogServiceClearConfig(creds, "Sel", exportPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_log_service_clear.clear", "log_service_id", "Sel"),
+					resource.TestCheckResourceAttrSet("redfish_log_service_clear.clear", "exported_entries"),
+					resource.TestCheckResourceAttrSet("redfish_log_service_clear.clear", "log_service_odata_id"),
+					func(_ *terraform.State) error {
+						if _, err := os.Stat(exportPath); err != nil {
+							return fmt.Errorf("expected the log entries to be exported: %w", err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// Test to clear a log service which does not exist - Negative
+func TestAccRedfishLogServiceClear_InvalidLogService(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceLogServiceClearConfig(creds, "Invalid", ""),
+				ExpectError: regexp.MustCompile("no log service found with id Invalid"),
+			},
+		},
+	})
+}
+
+// Test that the log is not cleared when the export fails - Negative
+func TestAccRedfishLogServiceClear_ExportMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(listLogServiceMembers).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceLogServiceClearConfig(creds, "Sel", filepath.Join(t.TempDir(), "sel.json")),
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func testAccRedfishResourceLogServiceClearConfig(testingInfo TestingServerCredentials, logServiceID, exportPath string) string {
+	export := ""
+	if exportPath != "" {
+		export = fmt.Sprintf("export_path = %q", exportPath)
+	}
+	return fmt.Sprintf(`
+	resource "redfish_log_service_clear" "clear" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		log_service_id = "%s"
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		logServiceID,
+		export,
+	)
+}
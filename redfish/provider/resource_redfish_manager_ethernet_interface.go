@@ -0,0 +1,1060 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// ipv4StaticAddressType is the object type of a static IPv4 address
+var ipv4StaticAddressType = map[string]attr.Type{
+	"address":     types.StringType,
+	"subnet_mask": types.StringType,
+	"gateway":     types.StringType,
+}
+
+// ipv6StaticAddressType is the object type of a static IPv6 address or default gateway
+var ipv6StaticAddressType = map[string]attr.Type{
+	"address":       types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+// ethernetInterfaceVLANType is the object type of the VLAN of an ethernet interface
+var ethernetInterfaceVLANType = map[string]attr.Type{
+	"enabled":  types.BoolType,
+	"vlan_id":  types.Int64Type,
+	"priority": types.Int64Type,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &managerEthernetInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &managerEthernetInterfaceResource{}
+	_ resource.ResourceWithImportState = &managerEthernetInterfaceResource{}
+)
+
+// NewManagerEthernetInterfaceResource is a helper function to simplify the provider implementation.
+func NewManagerEthernetInterfaceResource() resource.Resource {
+	return &managerEthernetInterfaceResource{}
+}
+
+// managerEthernetInterfaceResource is the resource implementation.
+type managerEthernetInterfaceResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *managerEthernetInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_manager_ethernet_interface configured")
+}
+
+// Metadata returns the resource type name.
+func (*managerEthernetInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "manager_ethernet_interface"
+}
+
+// ManagerEthernetInterfaceSchema to design the schema for the manager ethernet interface resource.
+func ManagerEthernetInterfaceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager ethernet interface resource",
+			Description:         "ID of the manager ethernet interface resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager. Defaults to the first manager.",
+			Description:         "ID of the manager. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"interface_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the ethernet interface of the manager, such as `NIC.1`. Defaults to the first interface.",
+			Description:         "ID of the ethernet interface of the manager, such as 'NIC.1'. Defaults to the first interface.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Host name of the manager.",
+			Description:         "Host name of the manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"fqdn": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified domain name of the manager.",
+			Description:         "Fully qualified domain name of the manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dhcpv4_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the IPv4 address of the interface is obtained with DHCP.",
+			Description:         "Whether the IPv4 address of the interface is obtained with DHCP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dhcpv6_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the IPv6 addresses of the interface are obtained with stateful DHCPv6.",
+			Description:         "Whether the IPv6 addresses of the interface are obtained with stateful DHCPv6.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4_static_addresses": schema.ListNestedAttribute{
+			MarkdownDescription: "Static IPv4 addresses of the interface, used when `dhcpv4_enabled` is `false`.",
+			Description:         "Static IPv4 addresses of the interface, used when dhcpv4_enabled is false.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						MarkdownDescription: "IPv4 address.",
+						Description:         "IPv4 address.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"subnet_mask": schema.StringAttribute{
+						MarkdownDescription: "Subnet mask of the address.",
+						Description:         "Subnet mask of the address.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"gateway": schema.StringAttribute{
+						MarkdownDescription: "Gateway of the address. The current gateway is kept when it is not configured.",
+						Description:         "Gateway of the address. The current gateway is kept when it is not configured.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"ipv6_static_addresses": schema.ListNestedAttribute{
+			MarkdownDescription: "Static IPv6 addresses of the interface.",
+			Description:         "Static IPv6 addresses of the interface.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						MarkdownDescription: "IPv6 address.",
+						Description:         "IPv6 address.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"prefix_length": schema.Int64Attribute{
+						MarkdownDescription: "Prefix length of the address.",
+						Description:         "Prefix length of the address.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 128),
+						},
+					},
+				},
+			},
+		},
+		"ipv6_static_default_gateways": schema.ListNestedAttribute{
+			MarkdownDescription: "Static IPv6 default gateways of the interface.",
+			Description:         "Static IPv6 default gateways of the interface.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						MarkdownDescription: "IPv6 address of the gateway.",
+						Description:         "IPv6 address of the gateway.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"prefix_length": schema.Int64Attribute{
+						MarkdownDescription: "Prefix length of the gateway. The current prefix length is kept when it is not configured.",
+						Description:         "Prefix length of the gateway. The current prefix length is kept when it is not configured.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 128),
+						},
+					},
+				},
+			},
+		},
+		"static_name_servers": schema.ListAttribute{
+			MarkdownDescription: "Static DNS servers of the interface.",
+			Description:         "Static DNS servers of the interface.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vlan": schema.SingleNestedAttribute{
+			MarkdownDescription: "VLAN of the interface. The settings which are not configured are left unchanged.",
+			Description:         "VLAN of the interface. The settings which are not configured are left unchanged.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the VLAN is enabled.",
+					Description:         "Whether the VLAN is enabled.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"vlan_id": schema.Int64Attribute{
+					MarkdownDescription: "ID of the VLAN.",
+					Description:         "ID of the VLAN.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 4094),
+					},
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+				"priority": schema.Int64Attribute{
+					MarkdownDescription: "Priority of the VLAN.",
+					Description:         "Priority of the VLAN.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 7),
+					},
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+		"reconnect_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the manager to answer on its new address " +
+				"when the change moves the address used by the `endpoint` of the `redfish_server` block.",
+			Description: "Time in seconds that the provider waits for the manager to answer on its new address " +
+				"when the change moves the address used by the endpoint of the redfish_server block.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(int64(defaultCheckTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"current_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Endpoint at which the manager was last reached. It differs from the `endpoint` of the `redfish_server` block " +
+				"after a change that moves the address of the manager, until the block is updated to the new address.",
+			Description: "Endpoint at which the manager was last reached. It differs from the endpoint of the redfish_server block " +
+				"after a change that moves the address of the manager, until the block is updated to the new address.",
+			Computed: true,
+		},
+		"mac_address": schema.StringAttribute{
+			MarkdownDescription: "MAC address of the interface.",
+			Description:         "MAC address of the interface.",
+			Computed:            true,
+		},
+		"ipv4_addresses": schema.ListAttribute{
+			MarkdownDescription: "IPv4 addresses currently assigned to the interface.",
+			Description:         "IPv4 addresses currently assigned to the interface.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"ipv6_addresses": schema.ListAttribute{
+			MarkdownDescription: "IPv6 addresses currently assigned to the interface.",
+			Description:         "IPv6 addresses currently assigned to the interface.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"name_servers": schema.ListAttribute{
+			MarkdownDescription: "DNS servers currently used by the interface.",
+			Description:         "DNS servers currently used by the interface.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*managerEthernetInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure the network settings of an ethernet interface of the manager, " +
+			"such as DHCP, static IPv4 and IPv6 addresses, DNS servers, host name and VLAN.",
+		Description: "This Terraform resource is used to configure the network settings of an ethernet interface of the manager, " +
+			"such as DHCP, static IPv4 and IPv6 addresses, DNS servers, host name and VLAN.",
+		Attributes: ManagerEthernetInterfaceSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *managerEthernetInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_manager_ethernet_interface create : Started")
+	var plan models.ManagerEthernetInterface
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "resource_manager_ethernet_interface create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_ethernet_interface create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *managerEthernetInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_manager_ethernet_interface read: started")
+	var state models.ManagerEthernetInterface
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := managerEthernetInterfaceServer(r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil && state.RedfishServer[0].RedfishAlias.ValueString() == "" &&
+		state.CurrentEndpoint.ValueString() != "" && state.CurrentEndpoint.ValueString() != server.Endpoint.ValueString() {
+		// the manager moved to a new address which is not in the redfish_server block yet
+		server.Endpoint = state.CurrentEndpoint
+		api, err = NewConfig(r.p, &[]models.RedfishServer{server})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	manager, ethernetInterface, err := getManagerEthernetInterface(api.Service, state.ManagerID.ValueString(), state.InterfaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the ethernet interface of the manager", err.Error())
+		return
+	}
+	state.CurrentEndpoint = server.Endpoint
+	resp.Diagnostics.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_ethernet_interface read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *managerEthernetInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_manager_ethernet_interface update: started")
+	var plan models.ManagerEthernetInterface
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_ethernet_interface update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*managerEthernetInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_manager_ethernet_interface delete: started")
+	var state models.ManagerEthernetInterface
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the network settings are left as they are on the manager
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_manager_ethernet_interface delete: finished")
+}
+
+// ImportState import state for an existing manager ethernet interface
+func (*managerEthernetInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ManagerID    string `json:"manager_id"`
+		InterfaceID  string `json:"interface_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), c.ManagerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), c.InterfaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reconnect_timeout"), int64(defaultCheckTimeout))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redfish_server"), []models.RedfishServer{server})...)
+}
+
+// apply patches the network settings of the plan which differ from the interface and refreshes the plan,
+// reconnecting to the manager when the change moves the address of the endpoint
+func (r *managerEthernetInterfaceResource) apply(ctx context.Context, plan *models.ManagerEthernetInterface) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	defer api.Logout()
+
+	manager, ethernetInterface, err := getManagerEthernetInterface(api.Service, plan.ManagerID.ValueString(), plan.InterfaceID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching the ethernet interface of the manager", err.Error())
+		return diags
+	}
+
+	// the redfish_server block is kept as configured, a local copy is used to reach the manager at its new address
+	server, err := managerEthernetInterfaceServer(r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	plan.CurrentEndpoint = server.Endpoint
+
+	patch, d := managerEthernetInterfacePatch(ctx, plan, ethernetInterface)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if len(patch) == 0 {
+		diags.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, plan)...)
+		return diags
+	}
+
+	newEndpoint, moved := movedManagerEndpoint(server.Endpoint.ValueString(), ethernetInterface, patch)
+
+	tflog.Info(ctx, "Updating the ethernet interface of the manager", map[string]any{"patch": patch})
+	if err := patchEthernetInterface(api.Service, ethernetInterface.ODataID, patch); err != nil {
+		diags.AddError("Error updating the ethernet interface of the manager", err.Error())
+		return diags
+	}
+
+	if !moved {
+		manager, ethernetInterface, err = getManagerEthernetInterface(api.Service, manager.ID, ethernetInterface.ID)
+		if err != nil {
+			diags.AddError("Error fetching the ethernet interface of the manager", err.Error())
+			return diags
+		}
+		diags.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, plan)...)
+		return diags
+	}
+
+	// the session is lost when the address of the endpoint changes
+	plan.CurrentEndpoint = types.StringNull()
+	if newEndpoint != "" {
+		plan.CurrentEndpoint = types.StringValue(newEndpoint)
+	}
+	if newEndpoint == "" || plan.RedfishServer[0].RedfishAlias.ValueString() != "" {
+		diags.AddWarning("The address of the manager changed",
+			"The manager is no longer reachable at the endpoint of the redfish_server block, "+
+				"update the endpoint (or the redfish_servers entry of the alias) to its new address.")
+		applyEthernetInterfacePatch(ethernetInterface, patch)
+		diags.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, plan)...)
+		return diags
+	}
+
+	server.Endpoint = types.StringValue(newEndpoint)
+	newAPI, err := waitForManagerEndpoint(ctx, r.p, []models.RedfishServer{server}, manager.ID, int(plan.ReconnectTimeout.ValueInt64()))
+	if err != nil {
+		diags.AddWarning("The manager is not reachable at its new address",
+			fmt.Sprintf("The manager did not answer at %s within %d seconds: %s", newEndpoint, plan.ReconnectTimeout.ValueInt64(), err.Error()))
+		applyEthernetInterfacePatch(ethernetInterface, patch)
+		diags.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, plan)...)
+		return diags
+	}
+	defer newAPI.Logout()
+
+	diags.AddWarning("The address of the manager changed",
+		fmt.Sprintf("The manager is now reachable at %s, update the endpoint of the redfish_server block to use it.", newEndpoint))
+	manager, ethernetInterface, err = getManagerEthernetInterface(newAPI.Service, manager.ID, ethernetInterface.ID)
+	if err != nil {
+		diags.AddError("Error fetching the ethernet interface of the manager", err.Error())
+		return diags
+	}
+	diags.Append(updateManagerEthernetInterfaceState(ctx, manager, ethernetInterface, plan)...)
+	return diags
+}
+
+// waitForManagerEndpoint connects to the manager at the endpoint of the server until it answers or the timeout expires
+func waitForManagerEndpoint(ctx context.Context, pconfig *redfishProvider, rserver []models.RedfishServer,
+	managerID string, timeout int,
+) (*gofish.APIClient, error) {
+	var err error
+	for start := time.Now(); time.Since(start) < (time.Duration(timeout) * time.Second); {
+		tflog.Trace(ctx, "Checking the manager at its new address...")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(defaultCheckInterval) * time.Second):
+		}
+		var api *gofish.APIClient
+		api, err = NewConfig(pconfig, &rserver)
+		if err != nil {
+			continue
+		}
+		if _, err = getManagerResource(api.Service, managerID); err == nil {
+			return api, nil
+		}
+		api.Logout()
+	}
+	if err == nil {
+		err = fmt.Errorf("timed out")
+	}
+	return nil, err
+}
+
+// managerEthernetInterfaceServer returns a copy of the redfish_server block with the server of its alias resolved
+func managerEthernetInterfaceServer(pconfig *redfishProvider, rserver []models.RedfishServer) (models.RedfishServer, error) {
+	if len(rserver) == 0 {
+		return models.RedfishServer{}, fmt.Errorf("no provider block was found")
+	}
+	server := rserver[0]
+	err := getActiveAliasRedfishServer(pconfig, &server)
+	return server, err
+}
+
+// getManagerEthernetInterface returns the manager and its ethernet interface, the first one when no ID is given
+func getManagerEthernetInterface(service *gofish.Service, managerID, interfaceID string) (*redfish.Manager, *redfish.EthernetInterface, error) {
+	manager, err := getManagerResource(service, managerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ethernetInterfaces, err := manager.EthernetInterfaces()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, ethernetInterface := range ethernetInterfaces {
+		if interfaceID == "" || ethernetInterface.ID == interfaceID {
+			return manager, ethernetInterface, nil
+		}
+	}
+	if interfaceID == "" {
+		return nil, nil, fmt.Errorf("manager %s has no ethernet interface", manager.ID)
+	}
+	return nil, nil, fmt.Errorf("ethernet interface %s of manager %s not found", interfaceID, manager.ID)
+}
+
+// patchEthernetInterface sends the network settings to the ethernet interface of the manager
+func patchEthernetInterface(service *gofish.Service, uri string, patch map[string]any) error {
+	resp, err := service.GetClient().Patch(uri, patch)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// isUnsetAddress tells whether an address reported by the manager is a placeholder for an unset one
+func isUnsetAddress(address string) bool {
+	return address == "" || address == "0.0.0.0" || address == "::"
+}
+
+// staticIPv4Addresses returns the static IPv4 addresses of the interface, without their origin
+func staticIPv4Addresses(ethernetInterface *redfish.EthernetInterface) []redfish.IPv4Address {
+	addresses := ethernetInterface.IPv4StaticAddresses
+	if len(addresses) == 0 {
+		// some firmwares only report the static addresses among the assigned ones
+		for _, address := range ethernetInterface.IPv4Addresses {
+			if address.AddressOrigin == redfish.StaticIPv4AddressOrigin {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	static := make([]redfish.IPv4Address, 0, len(addresses))
+	for _, address := range addresses {
+		if isUnsetAddress(address.Address) {
+			continue
+		}
+		static = append(static, redfish.IPv4Address{Address: address.Address, SubnetMask: address.SubnetMask, Gateway: address.Gateway})
+	}
+	return static
+}
+
+// staticIPv6Addresses returns the static IPv6 addresses of the interface
+func staticIPv6Addresses(ethernetInterface *redfish.EthernetInterface) []redfish.IPv6StaticAddress {
+	static := make([]redfish.IPv6StaticAddress, 0, len(ethernetInterface.IPv6StaticAddresses))
+	for _, address := range ethernetInterface.IPv6StaticAddresses {
+		if !isUnsetAddress(address.Address) {
+			static = append(static, address)
+		}
+	}
+	return static
+}
+
+// staticIPv6DefaultGateways returns the static IPv6 default gateways of the interface
+func staticIPv6DefaultGateways(ethernetInterface *redfish.EthernetInterface) []redfish.IPv6GatewayStaticAddress {
+	static := make([]redfish.IPv6GatewayStaticAddress, 0, len(ethernetInterface.IPv6StaticDefaultGateways))
+	for _, gateway := range ethernetInterface.IPv6StaticDefaultGateways {
+		if !isUnsetAddress(gateway.Address) {
+			static = append(static, gateway)
+		}
+	}
+	return static
+}
+
+// setAddresses returns the addresses which are set, without the placeholders of the unset ones
+func setAddresses(addresses []string) []string {
+	set := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if !isUnsetAddress(address) {
+			set = append(set, address)
+		}
+	}
+	return set
+}
+
+// managerEthernetInterfacePatch returns the network settings of the plan which differ from the current ones
+func managerEthernetInterfacePatch(ctx context.Context, plan *models.ManagerEthernetInterface,
+	ethernetInterface *redfish.EthernetInterface,
+) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patch := make(map[string]any)
+
+	if !plan.HostName.IsNull() && !plan.HostName.IsUnknown() && plan.HostName.ValueString() != ethernetInterface.HostName {
+		patch["HostName"] = plan.HostName.ValueString()
+	}
+	if !plan.FQDN.IsNull() && !plan.FQDN.IsUnknown() && plan.FQDN.ValueString() != ethernetInterface.FQDN {
+		patch["FQDN"] = plan.FQDN.ValueString()
+	}
+	if !plan.DHCPv4Enabled.IsNull() && !plan.DHCPv4Enabled.IsUnknown() &&
+		plan.DHCPv4Enabled.ValueBool() != ethernetInterface.DHCPv4.DHCPEnabled {
+		patch["DHCPv4"] = map[string]any{"DHCPEnabled": plan.DHCPv4Enabled.ValueBool()}
+	}
+	if !plan.DHCPv6Enabled.IsNull() && !plan.DHCPv6Enabled.IsUnknown() &&
+		plan.DHCPv6Enabled.ValueBool() != dhcpv6Enabled(ethernetInterface) {
+		mode := redfish.DisabledDHCPv6OperatingMode
+		if plan.DHCPv6Enabled.ValueBool() {
+			mode = redfish.StatefulDHCPv6OperatingMode
+		}
+		patch["DHCPv6"] = map[string]any{"OperatingMode": mode}
+	}
+
+	if !plan.IPv4StaticAddresses.IsNull() && !plan.IPv4StaticAddresses.IsUnknown() {
+		var planned []models.IPv4StaticAddress
+		diags.Append(plan.IPv4StaticAddresses.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		current := staticIPv4Addresses(ethernetInterface)
+		addresses := make([]redfish.IPv4Address, 0, len(planned))
+		for i, address := range planned {
+			value := redfish.IPv4Address{Address: address.Address.ValueString(), SubnetMask: address.SubnetMask.ValueString()}
+			if !address.Gateway.IsNull() && !address.Gateway.IsUnknown() {
+				value.Gateway = address.Gateway.ValueString()
+			} else if i < len(current) {
+				value.Gateway = current[i].Gateway
+			}
+			addresses = append(addresses, value)
+		}
+		if !slices.Equal(addresses, current) {
+			patch["IPv4StaticAddresses"] = addresses
+		}
+	}
+
+	if !plan.IPv6StaticAddresses.IsNull() && !plan.IPv6StaticAddresses.IsUnknown() {
+		var planned []models.IPv6StaticAddress
+		diags.Append(plan.IPv6StaticAddresses.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		addresses := make([]redfish.IPv6StaticAddress, 0, len(planned))
+		for _, address := range planned {
+			addresses = append(addresses, redfish.IPv6StaticAddress{
+				Address:      address.Address.ValueString(),
+				PrefixLength: uint8(address.PrefixLength.ValueInt64()),
+			})
+		}
+		if !slices.Equal(addresses, staticIPv6Addresses(ethernetInterface)) {
+			patch["IPv6StaticAddresses"] = addresses
+		}
+	}
+
+	if !plan.IPv6StaticDefaultGateways.IsNull() && !plan.IPv6StaticDefaultGateways.IsUnknown() {
+		var planned []models.IPv6StaticAddress
+		diags.Append(plan.IPv6StaticDefaultGateways.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		current := staticIPv6DefaultGateways(ethernetInterface)
+		gateways := make([]redfish.IPv6GatewayStaticAddress, 0, len(planned))
+		for i, gateway := range planned {
+			value := redfish.IPv6GatewayStaticAddress{Address: gateway.Address.ValueString()}
+			if !gateway.PrefixLength.IsNull() && !gateway.PrefixLength.IsUnknown() {
+				value.PrefixLength = uint8(gateway.PrefixLength.ValueInt64())
+			} else if i < len(current) {
+				value.PrefixLength = current[i].PrefixLength
+			}
+			gateways = append(gateways, value)
+		}
+		if !slices.Equal(gateways, current) {
+			patch["IPv6StaticDefaultGateways"] = gateways
+		}
+	}
+
+	if !plan.StaticNameServers.IsNull() && !plan.StaticNameServers.IsUnknown() {
+		var nameServers []string
+		diags.Append(plan.StaticNameServers.ElementsAs(ctx, &nameServers, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !slices.Equal(nameServers, setAddresses(ethernetInterface.StaticNameServers)) {
+			patch["StaticNameServers"] = nameServers
+		}
+	}
+
+	if !plan.VLAN.IsNull() && !plan.VLAN.IsUnknown() {
+		var vlan models.EthernetInterfaceVLAN
+		diags.Append(plan.VLAN.As(ctx, &vlan, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		changes := make(map[string]any)
+		if !vlan.Enabled.IsNull() && !vlan.Enabled.IsUnknown() && vlan.Enabled.ValueBool() != ethernetInterface.VLAN.VLANEnable {
+			changes["VLANEnable"] = vlan.Enabled.ValueBool()
+		}
+		if !vlan.VLANID.IsNull() && !vlan.VLANID.IsUnknown() && vlan.VLANID.ValueInt64() != int64(ethernetInterface.VLAN.VLANID) {
+			changes["VLANId"] = int16(vlan.VLANID.ValueInt64())
+		}
+		if !vlan.Priority.IsNull() && !vlan.Priority.IsUnknown() && vlan.Priority.ValueInt64() != int64(ethernetInterface.VLAN.VLANPriority) {
+			changes["VLANPriority"] = int(vlan.Priority.ValueInt64())
+		}
+		if len(changes) > 0 {
+			patch["VLAN"] = changes
+		}
+	}
+	return patch, diags
+}
+
+// dhcpv6Enabled tells whether the interface obtains its IPv6 addresses with stateful DHCPv6
+func dhcpv6Enabled(ethernetInterface *redfish.EthernetInterface) bool {
+	return ethernetInterface.DHCPv6.OperatingMode == redfish.StatefulDHCPv6OperatingMode
+}
+
+// movedManagerEndpoint tells whether the patch moves the address used by the endpoint, and returns the endpoint
+// of the new address when it is known in advance (a static one) or an empty string when it is not (DHCP).
+// Endpoints using a host name are expected to follow the change through DNS.
+func movedManagerEndpoint(endpoint string, ethernetInterface *redfish.EthernetInterface, patch map[string]any) (string, bool) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false
+	}
+	host := net.ParseIP(parsed.Hostname())
+	if host == nil {
+		return "", false
+	}
+
+	var current, planned []string
+	var dhcp, changed bool
+	if host.To4() != nil {
+		for _, address := range ethernetInterface.IPv4Addresses {
+			current = append(current, address.Address)
+		}
+		addresses, ok := patch["IPv4StaticAddresses"].([]redfish.IPv4Address)
+		if !ok {
+			addresses = staticIPv4Addresses(ethernetInterface)
+		}
+		for _, address := range addresses {
+			planned = append(planned, address.Address)
+		}
+		dhcpv4, _ := patch["DHCPv4"].(map[string]any)
+		dhcp = dhcpv4["DHCPEnabled"] == true
+		changed = ok || dhcpv4 != nil
+	} else {
+		for _, address := range ethernetInterface.IPv6Addresses {
+			current = append(current, address.Address)
+		}
+		addresses, ok := patch["IPv6StaticAddresses"].([]redfish.IPv6StaticAddress)
+		if !ok {
+			addresses = staticIPv6Addresses(ethernetInterface)
+		}
+		for _, address := range addresses {
+			planned = append(planned, address.Address)
+		}
+		dhcpv6, _ := patch["DHCPv6"].(map[string]any)
+		dhcp = dhcpv6["OperatingMode"] == redfish.StatefulDHCPv6OperatingMode
+		changed = ok || dhcpv6 != nil
+	}
+
+	if !changed || !containsIP(current, host) {
+		return "", false
+	}
+	if dhcp {
+		return "", true
+	}
+	if containsIP(planned, host) {
+		return "", false
+	}
+	if len(planned) == 0 {
+		return "", true
+	}
+
+	newHost := planned[0]
+	if port := parsed.Port(); port != "" {
+		parsed.Host = net.JoinHostPort(newHost, port)
+	} else if host.To4() == nil {
+		parsed.Host = "[" + newHost + "]"
+	} else {
+		parsed.Host = newHost
+	}
+	return parsed.String(), true
+}
+
+// containsIP tells whether the addresses contain the IP, whatever the way it is written
+func containsIP(addresses []string, ip net.IP) bool {
+	return slices.ContainsFunc(addresses, func(address string) bool {
+		return ip.Equal(net.ParseIP(address))
+	})
+}
+
+// applyEthernetInterfacePatch updates the interface read from the manager with the patched settings
+func applyEthernetInterfacePatch(ethernetInterface *redfish.EthernetInterface, patch map[string]any) {
+	if hostName, ok := patch["HostName"].(string); ok {
+		ethernetInterface.HostName = hostName
+	}
+	if fqdn, ok := patch["FQDN"].(string); ok {
+		ethernetInterface.FQDN = fqdn
+	}
+	if dhcpv4, ok := patch["DHCPv4"].(map[string]any); ok {
+		ethernetInterface.DHCPv4.DHCPEnabled, _ = dhcpv4["DHCPEnabled"].(bool)
+	}
+	if dhcpv6, ok := patch["DHCPv6"].(map[string]any); ok {
+		ethernetInterface.DHCPv6.OperatingMode, _ = dhcpv6["OperatingMode"].(redfish.DHCPv6OperatingMode)
+	}
+	if addresses, ok := patch["IPv4StaticAddresses"].([]redfish.IPv4Address); ok {
+		ethernetInterface.IPv4StaticAddresses = addresses
+		if !ethernetInterface.DHCPv4.DHCPEnabled {
+			ethernetInterface.IPv4Addresses = addresses
+		}
+	}
+	if addresses, ok := patch["IPv6StaticAddresses"].([]redfish.IPv6StaticAddress); ok {
+		ethernetInterface.IPv6StaticAddresses = addresses
+	}
+	if gateways, ok := patch["IPv6StaticDefaultGateways"].([]redfish.IPv6GatewayStaticAddress); ok {
+		ethernetInterface.IPv6StaticDefaultGateways = gateways
+	}
+	if nameServers, ok := patch["StaticNameServers"].([]string); ok {
+		ethernetInterface.StaticNameServers = nameServers
+	}
+	if vlan, ok := patch["VLAN"].(map[string]any); ok {
+		if enabled, ok := vlan["VLANEnable"].(bool); ok {
+			ethernetInterface.VLAN.VLANEnable = enabled
+		}
+		if vlanID, ok := vlan["VLANId"].(int16); ok {
+			ethernetInterface.VLAN.VLANID = vlanID
+		}
+		if priority, ok := vlan["VLANPriority"].(int); ok {
+			ethernetInterface.VLAN.VLANPriority = priority
+		}
+	}
+}
+
+// updateManagerEthernetInterfaceState copies the network settings read from the interface into the state
+func updateManagerEthernetInterfaceState(ctx context.Context, manager *redfish.Manager, ethernetInterface *redfish.EthernetInterface,
+	state *models.ManagerEthernetInterface,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(ethernetInterface.ODataID)
+	state.ManagerID = types.StringValue(manager.ID)
+	state.InterfaceID = types.StringValue(ethernetInterface.ID)
+	state.HostName = types.StringValue(ethernetInterface.HostName)
+	state.FQDN = types.StringValue(ethernetInterface.FQDN)
+	state.DHCPv4Enabled = types.BoolValue(ethernetInterface.DHCPv4.DHCPEnabled)
+	state.DHCPv6Enabled = types.BoolValue(dhcpv6Enabled(ethernetInterface))
+	state.MACAddress = types.StringValue(ethernetInterface.MACAddress)
+	if state.ReconnectTimeout.IsNull() || state.ReconnectTimeout.IsUnknown() {
+		state.ReconnectTimeout = types.Int64Value(int64(defaultCheckTimeout))
+	}
+
+	ipv4Static := make([]models.IPv4StaticAddress, 0)
+	for _, address := range staticIPv4Addresses(ethernetInterface) {
+		ipv4Static = append(ipv4Static, models.IPv4StaticAddress{
+			Address:    types.StringValue(address.Address),
+			SubnetMask: types.StringValue(address.SubnetMask),
+			Gateway:    types.StringValue(address.Gateway),
+		})
+	}
+	ipv6Static := make([]models.IPv6StaticAddress, 0)
+	for _, address := range staticIPv6Addresses(ethernetInterface) {
+		ipv6Static = append(ipv6Static, models.IPv6StaticAddress{
+			Address:      types.StringValue(address.Address),
+			PrefixLength: types.Int64Value(int64(address.PrefixLength)),
+		})
+	}
+	ipv6Gateways := make([]models.IPv6StaticAddress, 0)
+	for _, gateway := range staticIPv6DefaultGateways(ethernetInterface) {
+		ipv6Gateways = append(ipv6Gateways, models.IPv6StaticAddress{
+			Address:      types.StringValue(gateway.Address),
+			PrefixLength: types.Int64Value(int64(gateway.PrefixLength)),
+		})
+	}
+	ipv4Addresses := make([]string, 0)
+	for _, address := range ethernetInterface.IPv4Addresses {
+		ipv4Addresses = append(ipv4Addresses, address.Address)
+	}
+	ipv6Addresses := make([]string, 0)
+	for _, address := range ethernetInterface.IPv6Addresses {
+		ipv6Addresses = append(ipv6Addresses, address.Address)
+	}
+
+	var d diag.Diagnostics
+	state.IPv4StaticAddresses, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipv4StaticAddressType}, ipv4Static)
+	diags.Append(d...)
+	state.IPv6StaticAddresses, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipv6StaticAddressType}, ipv6Static)
+	diags.Append(d...)
+	state.IPv6StaticDefaultGateways, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipv6StaticAddressType}, ipv6Gateways)
+	diags.Append(d...)
+	state.StaticNameServers, d = types.ListValueFrom(ctx, types.StringType, setAddresses(ethernetInterface.StaticNameServers))
+	diags.Append(d...)
+	state.IPv4Addresses, d = types.ListValueFrom(ctx, types.StringType, setAddresses(ipv4Addresses))
+	diags.Append(d...)
+	state.IPv6Addresses, d = types.ListValueFrom(ctx, types.StringType, setAddresses(ipv6Addresses))
+	diags.Append(d...)
+	state.NameServers, d = types.ListValueFrom(ctx, types.StringType, setAddresses(ethernetInterface.NameServers))
+	diags.Append(d...)
+	state.VLAN, d = types.ObjectValue(ethernetInterfaceVLANType, map[string]attr.Value{
+		"enabled":  types.BoolValue(ethernetInterface.VLAN.VLANEnable),
+		"vlan_id":  types.Int64Value(int64(ethernetInterface.VLAN.VLANID)),
+		"priority": types.Int64Value(int64(ethernetInterface.VLAN.VLANPriority)),
+	})
+	diags.Append(d...)
+	return diags
+}
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to configure, update and import the ethernet interface of the manager - Positive
+func TestAccRedfishManagerEthernetInterface_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerEthernetInterfaceConfig(creds, `
+				hostname            = "idrac-tf-test"
+				static_name_servers = ["8.8.8.8", "8.8.4.4"]
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_ethernet_interface.bmc", "hostname", "idrac-tf-test"),
+					resource.TestCheckResourceAttr("redfish_manager_ethernet_interface.bmc", "static_name_servers.#", "2"),
+					resource.TestCheckResourceAttrSet("redfish_manager_ethernet_interface.bmc", "interface_id"),
+					resource.TestCheckResourceAttrSet("redfish_manager_ethernet_interface.bmc", "mac_address"),
+					resource.TestCheckResourceAttrSet("redfish_manager_ethernet_interface.bmc", "current_endpoint"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerEthernetInterfaceConfig(creds, `
+				hostname            = "idrac-tf-test2"
+				static_name_servers = ["8.8.8.8"]
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_ethernet_interface.bmc", "hostname", "idrac-tf-test2"),
+					resource.TestCheckResourceAttr("redfish_manager_ethernet_interface.bmc", "static_name_servers.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "redfish_manager_ethernet_interface.bmc",
+				ImportState:       true,
+				ImportStateId:     "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"redfish_server",
+				},
+			},
+		},
+	})
+}
+
+// Test to configure the ethernet interface with an invalid VLAN - Negative
+func TestAccRedfishManagerEthernetInterface_InvalidVLAN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceManagerEthernetInterfaceConfig(creds, `vlan = { enabled = true, vlan_id = 5000 }`),
+				ExpectError: regexp.MustCompile("must be between 1 and 4094"),
+			},
+		},
+	})
+}
+
+// Test to configure the ethernet interface of an unknown interface - Negative
+func TestAccRedfishManagerEthernetInterface_InvalidInterface(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceManagerEthernetInterfaceConfig(creds, `interface_id = "NIC.Invalid"`),
+				ExpectError: regexp.MustCompile("Error fetching the ethernet interface of the manager"),
+			},
+		},
+	})
+}
+
+// Test to configure the ethernet interface with a mocked error - Negative
+func TestAccRedfishManagerEthernetInterface_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(patchEthernetInterface).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceManagerEthernetInterfaceConfig(creds, `hostname = "idrac-tf-mock"`),
+				ExpectError: regexp.MustCompile("Error updating the ethernet interface of the manager"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestManagerEthernetInterfacePatch(t *testing.T) {
+	ipv4 := types.ObjectType{AttrTypes: ipv4StaticAddressType}
+	plan := models.ManagerEthernetInterface{
+		HostName:      types.StringValue("idrac-new"),
+		FQDN:          types.StringUnknown(),
+		DHCPv4Enabled: types.BoolValue(false),
+		DHCPv6Enabled: types.BoolNull(),
+		IPv4StaticAddresses: types.ListValueMust(ipv4, []attr.Value{
+			types.ObjectValueMust(ipv4StaticAddressType, map[string]attr.Value{
+				"address":     types.StringValue("10.0.0.20"),
+				"subnet_mask": types.StringValue("255.255.255.0"),
+				"gateway":     types.StringUnknown(),
+			}),
+		}),
+		IPv6StaticAddresses:       types.ListNull(types.ObjectType{AttrTypes: ipv6StaticAddressType}),
+		IPv6StaticDefaultGateways: types.ListNull(types.ObjectType{AttrTypes: ipv6StaticAddressType}),
+		StaticNameServers:         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("8.8.8.8")}),
+		VLAN: types.ObjectValueMust(ethernetInterfaceVLANType, map[string]attr.Value{
+			"enabled":  types.BoolValue(false),
+			"vlan_id":  types.Int64Value(10),
+			"priority": types.Int64Unknown(),
+		}),
+	}
+	ethernetInterface := &redfish.EthernetInterface{
+		HostName:            "idrac-old",
+		DHCPv4:              redfish.DHCPv4Configuration{DHCPEnabled: true},
+		IPv4Addresses:       []redfish.IPv4Address{{Address: "10.0.0.10", SubnetMask: "255.255.255.0", Gateway: "10.0.0.1", AddressOrigin: "DHCP"}},
+		IPv4StaticAddresses: []redfish.IPv4Address{{Address: "10.0.0.10", SubnetMask: "255.255.255.0", Gateway: "10.0.0.1"}},
+		StaticNameServers:   []string{"8.8.8.8", "0.0.0.0", "::"},
+		VLAN:                redfish.VLAN{VLANID: 10},
+	}
+
+	patch, diags := managerEthernetInterfacePatch(context.Background(), &plan, ethernetInterface)
+	if diags.HasError() {
+		t.Fatalf("Expected no error, got %v", diags)
+	}
+	addresses, ok := patch["IPv4StaticAddresses"].([]redfish.IPv4Address)
+	if len(patch) != 3 || patch["HostName"] != "idrac-new" || !ok || len(addresses) != 1 ||
+		addresses[0].Gateway != "10.0.0.1" || addresses[0].Address != "10.0.0.20" {
+		t.Fatalf("Unexpected patch %v", patch)
+	}
+	if patch["DHCPv4"].(map[string]any)["DHCPEnabled"] != false {
+		t.Fatalf("Expected DHCPv4 to be disabled, got %v", patch["DHCPv4"])
+	}
+
+	applyEthernetInterfacePatch(ethernetInterface, patch)
+	if ethernetInterface.HostName != "idrac-new" || ethernetInterface.DHCPv4.DHCPEnabled ||
+		ethernetInterface.IPv4Addresses[0].Address != "10.0.0.20" {
+		t.Errorf("Expected the patch to be applied, got %+v", ethernetInterface)
+	}
+}
+
+func TestMovedManagerEndpoint(t *testing.T) {
+	ethernetInterface := &redfish.EthernetInterface{
+		IPv4Addresses:       []redfish.IPv4Address{{Address: "10.0.0.10"}},
+		IPv4StaticAddresses: []redfish.IPv4Address{{Address: "10.0.0.10"}},
+		IPv6Addresses:       []redfish.IPv6Address{{Address: "fe80::10"}},
+	}
+	static := func(address string) map[string]any {
+		return map[string]any{"IPv4StaticAddresses": []redfish.IPv4Address{{Address: address}}}
+	}
+	tests := []struct {
+		name     string
+		endpoint string
+		patch    map[string]any
+		want     string
+		moved    bool
+	}{
+		{"new static address", "https://10.0.0.10", static("10.0.0.20"), "https://10.0.0.20", true},
+		{"new static address with port", "https://10.0.0.10:8443", static("10.0.0.20"), "https://10.0.0.20:8443", true},
+		{"same static address", "https://10.0.0.10", static("10.0.0.10"), "", false},
+		{"other address", "https://10.0.0.99", static("10.0.0.20"), "", false},
+		{"host name", "https://idrac.example.com", static("10.0.0.20"), "", false},
+		{"DHCP enabled", "https://10.0.0.10", map[string]any{"DHCPv4": map[string]any{"DHCPEnabled": true}}, "", true},
+		{"no address change", "https://10.0.0.10", map[string]any{"HostName": "idrac"}, "", false},
+		{
+			"new static IPv6 address", "https://[fe80::10]",
+			map[string]any{"IPv6StaticAddresses": []redfish.IPv6StaticAddress{{Address: "fe80::20", PrefixLength: 64}}},
+			"https://[fe80::20]", true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, moved := movedManagerEndpoint(tt.endpoint, ethernetInterface, tt.patch)
+		if got != tt.want || moved != tt.moved {
+			t.Errorf("%s: movedManagerEndpoint(%q) = %q, %v, want %q, %v", tt.name, tt.endpoint, got, moved, tt.want, tt.moved)
+		}
+	}
+}
+
+func testAccRedfishResourceManagerEthernetInterfaceConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_manager_ethernet_interface" "bmc" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
+
+func TestManagerEthernetInterfaceServer(t *testing.T) {
+	p := testAliasProvider(t, map[string]string{"server1": "https://10.0.0.10"})
+	rserver := []models.RedfishServer{{RedfishAlias: types.StringValue("server1")}}
+
+	server, err := managerEthernetInterfaceServer(p, rserver)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if server.Endpoint.ValueString() != "https://10.0.0.10" {
+		t.Fatalf("Expected the endpoint of the alias, got %q", server.Endpoint.ValueString())
+	}
+	// the configured redfish_server block is left as is
+	server.Endpoint = types.StringValue("https://10.0.0.20")
+	if !rserver[0].Endpoint.IsNull() {
+		t.Fatalf("Expected the configured endpoint to stay null, got %q", rserver[0].Endpoint.ValueString())
+	}
+
+	if _, err := managerEthernetInterfaceServer(p, nil); err == nil {
+		t.Fatal("Expected an error without a redfish_server block, got nil")
+	}
+}
+
+func TestWaitForManagerEndpoint_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	rserver := []models.RedfishServer{{Endpoint: types.StringValue("https://10.0.0.20")}}
+	if _, err := waitForManagerEndpoint(ctx, &redfishProvider{}, rserver, "iDRAC.Embedded.1", 600); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the cancellation of the context, got %v", err)
+	}
+}
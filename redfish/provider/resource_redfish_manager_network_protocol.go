@@ -0,0 +1,481 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// managerNetworkProtocols lists the protocols managed by the resource, with their attribute and Redfish property names
+var managerNetworkProtocols = []struct {
+	attribute   string
+	property    string
+	description string
+}{
+	{"http", "HTTP", "HTTP"},
+	{"https", "HTTPS", "HTTPS"},
+	{"ssh", "SSH", "SSH"},
+	{"ipmi", "IPMI", "IPMI over LAN"},
+	{"snmp", "SNMP", "SNMP"},
+	{"kvmip", "KVMIP", "KVM-IP"},
+	{"virtual_media", "VirtualMedia", "virtual media"},
+	{"ssdp", "SSDP", "SSDP"},
+}
+
+// networkProtocolSettingType is the object type of the settings of a network protocol
+var networkProtocolSettingType = map[string]attr.Type{
+	"enabled": types.BoolType,
+	"port":    types.Int64Type,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &managerNetworkProtocolResource{}
+	_ resource.ResourceWithConfigure   = &managerNetworkProtocolResource{}
+	_ resource.ResourceWithImportState = &managerNetworkProtocolResource{}
+)
+
+// NewManagerNetworkProtocolResource is a helper function to simplify the provider implementation.
+func NewManagerNetworkProtocolResource() resource.Resource {
+	return &managerNetworkProtocolResource{}
+}
+
+// managerNetworkProtocolResource is the resource implementation.
+type managerNetworkProtocolResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *managerNetworkProtocolResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_manager_network_protocol configured")
+}
+
+// Metadata returns the resource type name.
+func (*managerNetworkProtocolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "manager_network_protocol"
+}
+
+// ManagerNetworkProtocolSchema to design the schema for the manager network protocol resource.
+func ManagerNetworkProtocolSchema() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager network protocol resource",
+			Description:         "ID of the manager network protocol resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager. Defaults to the first manager.",
+			Description:         "ID of the manager. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Host name of the manager.",
+			Description:         "Host name of the manager.",
+			Computed:            true,
+		},
+		"fqdn": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified domain name of the manager.",
+			Description:         "Fully qualified domain name of the manager.",
+			Computed:            true,
+		},
+	}
+	for _, protocol := range managerNetworkProtocols {
+		attributes[protocol.attribute] = networkProtocolSettingSchema(protocol.description)
+	}
+	return attributes
+}
+
+// networkProtocolSettingSchema returns the schema of the settings of a network protocol
+func networkProtocolSettingSchema(protocol string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Settings of the %s protocol. The settings which are not configured are left unchanged.", protocol),
+		Description:         fmt.Sprintf("Settings of the %s protocol. The settings which are not configured are left unchanged.", protocol),
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Whether the %s protocol is enabled.", protocol),
+				Description:         fmt.Sprintf("Whether the %s protocol is enabled.", protocol),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Port of the %s protocol.", protocol),
+				Description:         fmt.Sprintf("Port of the %s protocol.", protocol),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*managerNetworkProtocolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to enable or disable the network protocols of the manager, " +
+			"such as HTTPS, SSH, IPMI over LAN and SNMP, and to set their ports.",
+		Description: "This Terraform resource is used to enable or disable the network protocols of the manager, " +
+			"such as HTTPS, SSH, IPMI over LAN and SNMP, and to set their ports.",
+		Attributes: ManagerNetworkProtocolSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *managerNetworkProtocolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_manager_network_protocol create : Started")
+	var plan models.ManagerNetworkProtocol
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "resource_manager_network_protocol create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_network_protocol create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *managerNetworkProtocolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_manager_network_protocol read: started")
+	var state models.ManagerNetworkProtocol
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	manager, err := getManagerResource(api.Service, state.ManagerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manager", err.Error())
+		return
+	}
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the network protocols", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(updateManagerNetworkProtocolState(manager, networkProtocol, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_network_protocol read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *managerNetworkProtocolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_manager_network_protocol update: started")
+	var plan models.ManagerNetworkProtocol
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_network_protocol update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*managerNetworkProtocolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_manager_network_protocol delete: started")
+	var state models.ManagerNetworkProtocol
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the protocols are left as they are on the manager
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_manager_network_protocol delete: finished")
+}
+
+// ImportState import state for existing manager network protocols
+func (*managerNetworkProtocolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ManagerID    string `json:"manager_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), c.ManagerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redfish_server"), []models.RedfishServer{server})...)
+}
+
+// apply patches the protocol settings of the plan which differ from the manager and refreshes the plan
+func (r *managerNetworkProtocolResource) apply(ctx context.Context, plan *models.ManagerNetworkProtocol) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	defer api.Logout()
+
+	manager, err := getManagerResource(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return diags
+	}
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		diags.AddError("Error fetching the network protocols", err.Error())
+		return diags
+	}
+
+	patch, d := managerNetworkProtocolPatch(ctx, plan, currentNetworkProtocols(networkProtocol))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if len(patch) == 0 {
+		diags.Append(updateManagerNetworkProtocolState(manager, networkProtocol, plan)...)
+		return diags
+	}
+
+	tflog.Info(ctx, "Updating the network protocols of the manager", map[string]any{"patch": patch})
+	if err := patchNetworkProtocol(api.Service, networkProtocol.ODataID, patch); err != nil {
+		diags.AddError("Error updating the network protocols", err.Error())
+		return diags
+	}
+
+	if httpsPort, ok := patch["HTTPS"]["Port"]; ok {
+		// the session is lost when the HTTPS port changes, the state is built from the applied settings instead
+		diags.AddWarning("The HTTPS port of the manager changed",
+			fmt.Sprintf("The manager now listens on port %d, update the endpoint of the redfish_server block to use it.", httpsPort))
+		applyNetworkProtocolPatch(networkProtocol, patch)
+		diags.Append(updateManagerNetworkProtocolState(manager, networkProtocol, plan)...)
+		return diags
+	}
+
+	networkProtocol, err = manager.NetworkProtocol()
+	if err != nil {
+		diags.AddError("Error fetching the network protocols", err.Error())
+		return diags
+	}
+	diags.Append(updateManagerNetworkProtocolState(manager, networkProtocol, plan)...)
+	return diags
+}
+
+// patchNetworkProtocol sends the protocol settings to the network protocol resource of the manager
+func patchNetworkProtocol(service *gofish.Service, uri string, patch map[string]map[string]any) error {
+	resp, err := service.GetClient().Patch(uri, patch)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// currentNetworkProtocols returns the settings of the managed protocols by their Redfish property
+func currentNetworkProtocols(networkProtocol *redfish.NetworkProtocolSettings) map[string]*redfish.NetworkProtocol {
+	return map[string]*redfish.NetworkProtocol{
+		"HTTP":         &networkProtocol.HTTP,
+		"HTTPS":        &networkProtocol.HTTPS.NetworkProtocol,
+		"SSH":          &networkProtocol.SSH,
+		"IPMI":         &networkProtocol.IPMI,
+		"SNMP":         &networkProtocol.SNMP.NetworkProtocol,
+		"KVMIP":        &networkProtocol.KVMIP,
+		"VirtualMedia": &networkProtocol.VirtualMedia,
+		"SSDP":         &networkProtocol.SSDP.NetworkProtocol,
+	}
+}
+
+// networkProtocolObjects returns the protocol settings of the model by their Redfish property
+func networkProtocolObjects(m *models.ManagerNetworkProtocol) map[string]*types.Object {
+	return map[string]*types.Object{
+		"HTTP":         &m.HTTP,
+		"HTTPS":        &m.HTTPS,
+		"SSH":          &m.SSH,
+		"IPMI":         &m.IPMI,
+		"SNMP":         &m.SNMP,
+		"KVMIP":        &m.KVMIP,
+		"VirtualMedia": &m.VirtualMedia,
+		"SSDP":         &m.SSDP,
+	}
+}
+
+// managerNetworkProtocolPatch returns the protocol settings of the plan which differ from the current ones
+func managerNetworkProtocolPatch(ctx context.Context, plan *models.ManagerNetworkProtocol,
+	current map[string]*redfish.NetworkProtocol,
+) (map[string]map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patch := make(map[string]map[string]any)
+	for property, object := range networkProtocolObjects(plan) {
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		var setting models.NetworkProtocolSetting
+		diags.Append(object.As(ctx, &setting, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		changes := make(map[string]any)
+		if !setting.Enabled.IsNull() && !setting.Enabled.IsUnknown() && setting.Enabled.ValueBool() != current[property].ProtocolEnabled {
+			changes["ProtocolEnabled"] = setting.Enabled.ValueBool()
+		}
+		if !setting.Port.IsNull() && !setting.Port.IsUnknown() && setting.Port.ValueInt64() != current[property].Port {
+			changes["Port"] = setting.Port.ValueInt64()
+		}
+		if len(changes) > 0 {
+			patch[property] = changes
+		}
+	}
+	return patch, diags
+}
+
+// applyNetworkProtocolPatch updates the protocol settings read from the manager with the patched values
+func applyNetworkProtocolPatch(networkProtocol *redfish.NetworkProtocolSettings, patch map[string]map[string]any) {
+	current := currentNetworkProtocols(networkProtocol)
+	for property, changes := range patch {
+		if enabled, ok := changes["ProtocolEnabled"].(bool); ok {
+			current[property].ProtocolEnabled = enabled
+		}
+		if port, ok := changes["Port"].(int64); ok {
+			current[property].Port = port
+		}
+	}
+}
+
+// updateManagerNetworkProtocolState copies the protocol settings read from the manager into the state
+func updateManagerNetworkProtocolState(manager *redfish.Manager, networkProtocol *redfish.NetworkProtocolSettings,
+	state *models.ManagerNetworkProtocol,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(manager.ID)
+	state.ManagerID = types.StringValue(manager.ID)
+	state.HostName = types.StringValue(networkProtocol.HostName)
+	state.FQDN = types.StringValue(networkProtocol.FQDN)
+
+	current := currentNetworkProtocols(networkProtocol)
+	for property, object := range networkProtocolObjects(state) {
+		setting := current[property]
+		// protocols without a port, such as SSDP on some firmwares, report 0
+		port := types.Int64Null()
+		if setting.Port != 0 {
+			port = types.Int64Value(setting.Port)
+		}
+		value, d := types.ObjectValue(networkProtocolSettingType, map[string]attr.Value{
+			"enabled": types.BoolValue(setting.ProtocolEnabled),
+			"port":    port,
+		})
+		diags.Append(d...)
+		*object = value
+	}
+	return diags
+}
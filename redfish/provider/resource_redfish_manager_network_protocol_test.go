@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to configure, update and import the network protocols of the manager - Positive
+func TestAccRedfishManagerNetworkProtocol_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerNetworkProtocolConfig(creds, `
+				ipmi = { enabled = false }
+				ssh  = { enabled = true, port = 2222 }
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_network_protocol.protocols", "ipmi.enabled", "false"),
+					resource.TestCheckResourceAttr("redfish_manager_network_protocol.protocols", "ssh.enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_manager_network_protocol.protocols", "ssh.port", "2222"),
+					resource.TestCheckResourceAttrSet("redfish_manager_network_protocol.protocols", "https.port"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerNetworkProtocolConfig(creds, `
+				ipmi = { enabled = false }
+				ssh  = { port = 22 }
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_network_protocol.protocols", "ssh.enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_manager_network_protocol.protocols", "ssh.port", "22"),
+				),
+			},
+			{
+				ResourceName:      "redfish_manager_network_protocol.protocols",
+				ImportState:       true,
+				ImportStateId:     "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"redfish_server",
+				},
+			},
+		},
+	})
+}
+
+// Test to configure the network protocols with an invalid port - Negative
+func TestAccRedfishManagerNetworkProtocol_InvalidPort(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceManagerNetworkProtocolConfig(creds, `ssh = { port = 70000 }`),
+				ExpectError: regexp.MustCompile("must be between 1 and 65535"),
+			},
+		},
+	})
+}
+
+// Test to configure the network protocols with a mocked error - Negative
+func TestAccRedfishManagerNetworkProtocol_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(patchNetworkProtocol).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceManagerNetworkProtocolConfig(creds, `ssh = { port = 2223 }`),
+				ExpectError: regexp.MustCompile("Error updating the network protocols"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestManagerNetworkProtocolPatch(t *testing.T) {
+	setting := func(enabled types.Bool, port types.Int64) types.Object {
+		return types.ObjectValueMust(networkProtocolSettingType, map[string]attr.Value{"enabled": enabled, "port": port})
+	}
+	plan := models.ManagerNetworkProtocol{
+		HTTP:         types.ObjectNull(networkProtocolSettingType),
+		HTTPS:        setting(types.BoolValue(true), types.Int64Value(443)),
+		SSH:          setting(types.BoolUnknown(), types.Int64Value(2222)),
+		IPMI:         setting(types.BoolValue(false), types.Int64Null()),
+		SNMP:         types.ObjectUnknown(networkProtocolSettingType),
+		KVMIP:        types.ObjectNull(networkProtocolSettingType),
+		VirtualMedia: types.ObjectNull(networkProtocolSettingType),
+		SSDP:         types.ObjectNull(networkProtocolSettingType),
+	}
+	networkProtocol := &redfish.NetworkProtocolSettings{}
+	networkProtocol.HTTPS.ProtocolEnabled, networkProtocol.HTTPS.Port = true, 443
+	networkProtocol.SSH.ProtocolEnabled, networkProtocol.SSH.Port = true, 22
+	networkProtocol.IPMI.ProtocolEnabled, networkProtocol.IPMI.Port = true, 623
+
+	patch, diags := managerNetworkProtocolPatch(context.Background(), &plan, currentNetworkProtocols(networkProtocol))
+	if diags.HasError() {
+		t.Fatalf("Expected no error, got %v", diags)
+	}
+	if len(patch) != 2 || patch["SSH"]["Port"] != int64(2222) || len(patch["SSH"]) != 1 ||
+		patch["IPMI"]["ProtocolEnabled"] != false || len(patch["IPMI"]) != 1 {
+		t.Fatalf("Unexpected patch %v", patch)
+	}
+
+	applyNetworkProtocolPatch(networkProtocol, patch)
+	if networkProtocol.SSH.Port != 2222 || networkProtocol.IPMI.ProtocolEnabled {
+		t.Errorf("Expected the patch to be applied, got SSH port %d and IPMI enabled %v",
+			networkProtocol.SSH.Port, networkProtocol.IPMI.ProtocolEnabled)
+	}
+}
+
+func testAccRedfishResourceManagerNetworkProtocolConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_manager_network_protocol" "protocols" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -120,8 +120,12 @@ func (r *managerResetResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	resetType := plan.ResetType.ValueString()
 	managerID := plan.Id.ValueString()
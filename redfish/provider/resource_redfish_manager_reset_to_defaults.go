@@ -0,0 +1,384 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// resetToDefaultsDelay is the time given to the iDRAC to start resetting before waiting for it to be ready
+	resetToDefaultsDelay = 60 * time.Second
+	// defaultRootPassword is the password of the root account after a reset to defaults which resets the users
+	defaultRootPassword = "calvin"
+	// dellResetToDefaultsAction is the Dell OEM ResetToDefaults action of the manager
+	dellResetToDefaultsAction = "DellManager.ResetToDefaults"
+	// managerResetToDefaultsAction is the Redfish ResetToDefaults action of the manager
+	managerResetToDefaultsAction = "Manager.ResetToDefaults"
+	// resetBiosAction is the Redfish ResetBios action of the BIOS of the system
+	resetBiosAction = "Bios.ResetBios"
+)
+
+// resetToDefaultsType describes a reset type of the resource, with the action performing it
+// and the settings of the iDRAC it resets
+type resetToDefaultsType struct {
+	action        string
+	resetsUsers   bool
+	resetsNetwork bool
+}
+
+// resetToDefaultsTypes lists the reset types of the resource
+var resetToDefaultsTypes = map[string]resetToDefaultsType{
+	"All":                      {action: dellResetToDefaultsAction, resetsUsers: true, resetsNetwork: true},
+	"ResetAllWithRootDefaults": {action: dellResetToDefaultsAction, resetsUsers: true, resetsNetwork: true},
+	"Default":                  {action: dellResetToDefaultsAction},
+	string(redfish.ResetAllResetToDefaultsType):                {action: managerResetToDefaultsAction, resetsUsers: true, resetsNetwork: true},
+	string(redfish.PreserveNetworkResetToDefaultsType):         {action: managerResetToDefaultsAction, resetsUsers: true},
+	string(redfish.PreserveNetworkAndUsersResetToDefaultsType): {action: managerResetToDefaultsAction},
+	"ResetBios": {action: resetBiosAction},
+}
+
+// waitsForReady returns whether the resource waits for the iDRAC to be ready after the reset by default:
+// the iDRAC is restarted by the reset types of the manager, and stays reachable on the same address unless the network is reset
+func (t resetToDefaultsType) waitsForReady() bool {
+	return t.action != resetBiosAction && !t.resetsNetwork
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &managerResetToDefaultsResource{}
+	_ resource.ResourceWithConfigure      = &managerResetToDefaultsResource{}
+	_ resource.ResourceWithValidateConfig = &managerResetToDefaultsResource{}
+)
+
+// NewManagerResetToDefaultsResource is a helper function to simplify the provider implementation.
+func NewManagerResetToDefaultsResource() resource.Resource {
+	return &managerResetToDefaultsResource{}
+}
+
+// managerResetToDefaultsResource is the resource implementation.
+type managerResetToDefaultsResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *managerResetToDefaultsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_manager_reset_to_defaults configured")
+}
+
+// Metadata returns the resource type name.
+func (*managerResetToDefaultsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "manager_reset_to_defaults"
+}
+
+// ManagerResetToDefaultsSchema to design the schema for the manager reset to defaults resource.
+func ManagerResetToDefaultsSchema() map[string]schema.Attribute {
+	resetTypes := make([]string, 0, len(resetToDefaultsTypes))
+	for resetType := range resetToDefaultsTypes {
+		resetTypes = append(resetTypes, resetType)
+	}
+	sort.Strings(resetTypes)
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager reset to defaults resource",
+			Description:         "ID of the manager reset to defaults resource",
+			Computed:            true,
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager. Defaults to the first manager.",
+			Description:         "ID of the manager. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the system whose BIOS is reset by the `ResetBios` reset type. Defaults to the first system.",
+			Description:         "ID of the system whose BIOS is reset by the ResetBios reset type. Defaults to the first system.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"reset_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the reset to defaults. " +
+				"`All`, `ResetAllWithRootDefaults` and `Default` use the Dell ResetToDefaults action of the iDRAC:" +
+				" `All` resets all the settings and the users, `ResetAllWithRootDefaults` resets all the settings" +
+				" and the users with the root password `calvin`, `Default` resets all the settings except the users and the network. " +
+				"`ResetAll`, `PreserveNetwork` and `PreserveNetworkAndUsers` use the Redfish ResetToDefaults action of the manager. " +
+				"`ResetBios` resets the BIOS attributes of the system, they are applied on the next reboot of the system.",
+			Description: "Type of the reset to defaults. " +
+				"All, ResetAllWithRootDefaults and Default use the Dell ResetToDefaults action of the iDRAC:" +
+				" All resets all the settings and the users, ResetAllWithRootDefaults resets all the settings" +
+				" and the users with the root password calvin, Default resets all the settings except the users and the network. " +
+				"ResetAll, PreserveNetwork and PreserveNetworkAndUsers use the Redfish ResetToDefaults action of the manager. " +
+				"ResetBios resets the BIOS attributes of the system, they are applied on the next reboot of the system.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(resetTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"confirm": schema.BoolAttribute{
+			MarkdownDescription: "Confirmation of the reset to defaults, which erases the settings of the server. Must be `true`.",
+			Description:         "Confirmation of the reset to defaults, which erases the settings of the server. Must be true.",
+			Required:            true,
+		},
+		"wait_for_ready": schema.BoolAttribute{
+			MarkdownDescription: "Whether to wait for the iDRAC to be ready after the reset. " +
+				"Defaults to `true` for the reset types which keep the network settings of the iDRAC, and to `false` for the other ones," +
+				" since the iDRAC may not be reachable on the same address after them.",
+			Description: "Whether to wait for the iDRAC to be ready after the reset. " +
+				"Defaults to true for the reset types which keep the network settings of the iDRAC, and to false for the other ones," +
+				" since the iDRAC may not be reachable on the same address after them.",
+			Optional: true,
+			Computed: true,
+		},
+		"default_password": schema.StringAttribute{
+			MarkdownDescription: "Password of the `root` account after a reset type which resets the users," +
+				" used to wait for the iDRAC to be ready. Defaults to `calvin`.",
+			Description: "Password of the root account after a reset type which resets the users," +
+				" used to wait for the iDRAC to be ready. Defaults to calvin.",
+			Optional:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*managerResetToDefaultsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to reset the iDRAC or the BIOS of a server to their factory defaults," +
+			" such as when the server is decommissioned." +
+			" The reset is performed when the resource is created, destroying the resource does not change the server.",
+		Description: "This resource is used to reset the iDRAC or the BIOS of a server to their factory defaults," +
+			" such as when the server is decommissioned." +
+			" The reset is performed when the resource is created, destroying the resource does not change the server.",
+		Attributes: ManagerResetToDefaultsSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*managerResetToDefaultsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config models.ManagerResetToDefaults
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Confirm.IsUnknown() && !config.Confirm.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("confirm"), "Reset to defaults not confirmed",
+			"The reset to defaults erases the settings of the server, confirm must be set to true to perform it.")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *managerResetToDefaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_manager_reset_to_defaults create: started")
+	var plan models.ManagerResetToDefaults
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resetType := resetToDefaultsTypes[plan.ResetType.ValueString()]
+	if plan.WaitForReady.IsUnknown() {
+		plan.WaitForReady = types.BoolValue(resetType.waitsForReady())
+	}
+
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	err = resetToDefaults(api.Service, &plan, resetType)
+	// the session may not outlive the reset
+	api.Logout()
+	if err != nil {
+		resp.Diagnostics.AddError("Error resetting to defaults", err.Error())
+		return
+	}
+	plan.ID = plan.ManagerID
+	if resetType.action == resetBiosAction {
+		plan.ID = plan.SystemID
+	}
+
+	if resetType.resetsNetwork && !plan.WaitForReady.ValueBool() {
+		resp.Diagnostics.AddWarning("The iDRAC was reset to defaults",
+			"The network settings of the iDRAC were reset, it may not be reachable on the same address anymore.")
+	}
+	if plan.WaitForReady.ValueBool() {
+		if err := r.waitForResetToDefaults(ctx, plan, resetType); err != nil {
+			resp.Diagnostics.AddError("Error waiting for the iDRAC to be ready after the reset to defaults", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_manager_reset_to_defaults create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+// The reset is an action, and the server may not be reachable with the same credentials after it, so the state is kept.
+func (*managerResetToDefaultsResource) Read(_ context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.State = req.State
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// The changes of the reset replace the resource, the other changes are only saved.
+func (*managerResetToDefaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.ManagerResetToDefaults
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+	if plan.WaitForReady.IsUnknown() {
+		plan.WaitForReady = state.WaitForReady
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*managerResetToDefaultsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+// resetToDefaults performs the reset to defaults action of the reset type and sets the IDs of the manager and the system
+func resetToDefaults(service *gofish.Service, plan *models.ManagerResetToDefaults, resetType resetToDefaultsType) error {
+	manager, err := getManagerResource(service, plan.ManagerID.ValueString())
+	if err != nil {
+		return err
+	}
+	plan.ManagerID = types.StringValue(manager.ID)
+	system, err := getSystemResource(service, plan.SystemID.ValueString())
+	if err != nil {
+		return err
+	}
+	plan.SystemID = types.StringValue(system.ID)
+
+	switch resetType.action {
+	case dellResetToDefaultsAction:
+		dellManager, err := dell.Manager(manager)
+		if err != nil {
+			return err
+		}
+		actions := dellManager.Actions
+		if actions.ResetToDefaultsTarget == "" {
+			return fmt.Errorf("the manager %s does not support the %s action", manager.ID, dellResetToDefaultsAction)
+		}
+		if len(actions.ResetToDefaultsResetType) > 0 && !slices.Contains(actions.ResetToDefaultsResetType, plan.ResetType.ValueString()) {
+			return fmt.Errorf("the manager %s does not support the %s reset type, supported reset types: %v",
+				manager.ID, plan.ResetType.ValueString(), actions.ResetToDefaultsResetType)
+		}
+		response, err := service.GetClient().Post(actions.ResetToDefaultsTarget, map[string]string{"ResetType": plan.ResetType.ValueString()})
+		if err != nil {
+			return err
+		}
+		return response.Body.Close()
+	case managerResetToDefaultsAction:
+		return manager.ResetToDefaults(redfish.ResetToDefaultsType(plan.ResetType.ValueString()))
+	default:
+		bios, err := system.Bios()
+		if err != nil {
+			return err
+		}
+		return bios.ResetBios()
+	}
+}
+
+// waitForResetToDefaults waits for the iDRAC to be ready after the reset, with the root account and the default password
+// when the reset type resets the users, and with the credentials of the server or of the provider otherwise
+func (r *managerResetToDefaultsResource) waitForResetToDefaults(ctx context.Context, plan models.ManagerResetToDefaults,
+	resetType resetToDefaultsType,
+) error {
+	server := plan.RedfishServer[0]
+	if err := getActiveAliasRedfishServer(r.p, &server); err != nil {
+		return err
+	}
+	retryConfig, err := getRedfishServerRetryConfig(r.p, &plan.RedfishServer[0])
+	if err != nil {
+		return err
+	}
+	user, password := server.User.ValueString(), server.Password.ValueString()
+	if resetType.resetsUsers {
+		user, password = "root", defaultRootPassword
+		if !plan.DefaultPassword.IsNull() {
+			password = plan.DefaultPassword.ValueString()
+		}
+	}
+
+	checker, err := newRedfishServerReadinessChecker(r.p, &server, user, password, retryConfig)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(resetToDefaultsDelay):
+	}
+	return checker.WaitForReady(ctx)
+}
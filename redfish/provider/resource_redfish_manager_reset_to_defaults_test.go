@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to reset the BIOS of the system to defaults - Positive
+func TestAccRedfishManagerResetToDefaults_ResetBios(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerResetToDefaultsConfig(creds, `
+				reset_type = "ResetBios"
+				confirm    = true
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_reset_to_defaults.reset", "reset_type", "ResetBios"),
+					resource.TestCheckResourceAttr("redfish_manager_reset_to_defaults.reset", "wait_for_ready", "false"),
+					resource.TestCheckResourceAttrSet("redfish_manager_reset_to_defaults.reset", "manager_id"),
+					resource.TestCheckResourceAttrSet("redfish_manager_reset_to_defaults.reset", "system_id"),
+				),
+			},
+		},
+	})
+}
+
+// Test to reset to defaults without confirmation and with an invalid reset type - Negative
+func TestAccRedfishManagerResetToDefaults_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerResetToDefaultsConfig(creds, `
+				reset_type = "Default"
+				confirm    = false
+				`),
+				ExpectError: regexp.MustCompile("Reset to defaults not confirmed"),
+			},
+			{
+				Config: testAccRedfishResourceManagerResetToDefaultsConfig(creds, `
+				reset_type = "Factory"
+				confirm    = true
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+// Test to reset to defaults with a mocked error - Negative
+func TestAccRedfishManagerResetToDefaults_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(resetToDefaults).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config: testAccRedfishResourceManagerResetToDefaultsConfig(creds, `
+				reset_type = "All"
+				confirm    = true
+				`),
+				ExpectError: regexp.MustCompile("Error resetting to defaults"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestResetToDefaultsTypes(t *testing.T) {
+	tests := []struct {
+		resetType     string
+		action        string
+		resetsUsers   bool
+		waitsForReady bool
+	}{
+		{"All", dellResetToDefaultsAction, true, false},
+		{"ResetAllWithRootDefaults", dellResetToDefaultsAction, true, false},
+		{"Default", dellResetToDefaultsAction, false, true},
+		{"ResetAll", managerResetToDefaultsAction, true, false},
+		{"PreserveNetwork", managerResetToDefaultsAction, true, true},
+		{"PreserveNetworkAndUsers", managerResetToDefaultsAction, false, true},
+		{"ResetBios", resetBiosAction, false, false},
+	}
+	if len(tests) != len(resetToDefaultsTypes) {
+		t.Fatalf("Expected %d reset types, got %d", len(tests), len(resetToDefaultsTypes))
+	}
+
+	for _, tt := range tests {
+		resetType, ok := resetToDefaultsTypes[tt.resetType]
+		if !ok {
+			t.Errorf("Expected the reset type %s to be supported", tt.resetType)
+			continue
+		}
+		if resetType.action != tt.action || resetType.resetsUsers != tt.resetsUsers || resetType.waitsForReady() != tt.waitsForReady {
+			t.Errorf("Unexpected reset type %s: %+v, waits for ready %v", tt.resetType, resetType, resetType.waitsForReady())
+		}
+	}
+}
+
+func TestWaitForResetToDefaults(t *testing.T) {
+	p := &redfishProvider{RetryConfig: RetryConfig{EnableReadinessCheck: true}}
+	p.Username = types.StringValue("admin")
+	p.Password = types.StringValue("secret")
+	plan := models.ManagerResetToDefaults{
+		RedfishServer: []models.RedfishServer{{Endpoint: types.StringValue("https://127.0.0.1")}},
+	}
+
+	// the credentials of the provider are used when the block has none
+	checker, err := newRedfishServerReadinessChecker(p, &plan.RedfishServer[0], "", "", p.RetryConfig)
+	if err != nil || checker.username != "admin" || checker.password != "secret" {
+		t.Fatalf("Expected the provider credentials, got %v", err)
+	}
+
+	// the wait stops when the context is done instead of sleeping before the readiness check
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := &managerResetToDefaultsResource{p: p}
+	if err := r.waitForResetToDefaults(ctx, plan, resetToDefaultsTypes["Default"]); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the wait to be canceled, got %v", err)
+	}
+}
+
+func testAccRedfishResourceManagerResetToDefaultsConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_manager_reset_to_defaults" "reset" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -0,0 +1,554 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// timeZoneAttribute is the iDRAC attribute holding the time zone of the manager
+	timeZoneAttribute = "Time.1.Timezone"
+)
+
+// regexpManagerDateTime matches the YYYY-MM-DDThh:mm:ss+hh:mm format of the date and time accepted by the Dell time service
+var regexpManagerDateTime = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}[+-]\d{2}:\d{2}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &managerTimeResource{}
+	_ resource.ResourceWithConfigure      = &managerTimeResource{}
+	_ resource.ResourceWithImportState    = &managerTimeResource{}
+	_ resource.ResourceWithValidateConfig = &managerTimeResource{}
+)
+
+// NewManagerTimeResource is a helper function to simplify the provider implementation.
+func NewManagerTimeResource() resource.Resource {
+	return &managerTimeResource{}
+}
+
+// managerTimeResource is the resource implementation.
+type managerTimeResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *managerTimeResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_manager_time configured")
+}
+
+// Metadata returns the resource type name.
+func (*managerTimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "manager_time"
+}
+
+// ManagerTimeSchema to design the schema for the manager time resource.
+func ManagerTimeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager time resource",
+			Description:         "ID of the manager time resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager. Defaults to the first manager.",
+			Description:         "ID of the manager. Defaults to the first manager.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the manager synchronizes its clock with the NTP servers.",
+			Description:         "Whether the manager synchronizes its clock with the NTP servers.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_servers": schema.ListAttribute{
+			MarkdownDescription: "NTP servers of the manager, in order of preference. The iDRAC supports up to 3 servers.",
+			Description:         "NTP servers of the manager, in order of preference. The iDRAC supports up to 3 servers.",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone": schema.StringAttribute{
+			MarkdownDescription: "Time zone of the manager, for example `UTC` or `CST6CDT`. " +
+				"It is validated against the values allowed by the manager attribute registry.",
+			Description: "Time zone of the manager, for example UTC or CST6CDT. " +
+				"It is validated against the values allowed by the manager attribute registry.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time set on the manager when the resource is created or when the value changes, " +
+				"in the `YYYY-MM-DDThh:mm:ss+hh:mm` format. It requires `ntp_enabled` to be `false`.",
+			Description: "Date and time set on the manager when the resource is created or when the value changes, " +
+				"in the YYYY-MM-DDThh:mm:ss+hh:mm format. It requires ntp_enabled to be false.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexpManagerDateTime, "must use the YYYY-MM-DDThh:mm:ss+hh:mm format"),
+			},
+		},
+		"bmc_date_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time of the manager when the state was last refreshed.",
+			Description:         "Date and time of the manager when the state was last refreshed.",
+			Computed:            true,
+		},
+		"clock_skew_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Difference in seconds between the clock of the manager and the clock of the Terraform host " +
+				"when the state was last refreshed. A positive value means the manager is ahead.",
+			Description: "Difference in seconds between the clock of the manager and the clock of the Terraform host " +
+				"when the state was last refreshed. A positive value means the manager is ahead.",
+			Computed: true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*managerTimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure the NTP servers, the time zone and the date and time of the iDRAC.",
+		Description:         "This Terraform resource is used to configure the NTP servers, the time zone and the date and time of the iDRAC.",
+		Attributes:          ManagerTimeSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*managerTimeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.ManagerTime
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.DateTime.IsNull() && config.NTPEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("date_time"),
+			"Invalid manager time configuration",
+			"date_time cannot be set while ntp_enabled is true, the NTP servers would override it.")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *managerTimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_manager_time create : Started")
+	var plan models.ManagerTime
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(applyManagerTime(ctx, api.Service, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readManagerTime(ctx, api.Service, &plan)...)
+
+	tflog.Trace(ctx, "resource_manager_time create: updating state finished, saving ...")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_time create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *managerTimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_manager_time read: started")
+	var state models.ManagerTime
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readManagerTime(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_time read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *managerTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_manager_time update: started")
+	var plan, state models.ManagerTime
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	// the date and time is only set again when it changes, the clock of the manager moves on in the meantime
+	setDateTime := !plan.DateTime.Equal(state.DateTime)
+	resp.Diagnostics.Append(applyManagerTime(ctx, api.Service, &plan, setDateTime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readManagerTime(ctx, api.Service, &plan)...)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_manager_time update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*managerTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_manager_time delete: started")
+	var state models.ManagerTime
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the time settings are left as they are on the manager
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_manager_time delete: finished")
+}
+
+// ImportState import state for existing manager time settings
+func (*managerTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		ManagerID    string `json:"manager_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), c.ManagerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redfish_server"), []models.RedfishServer{server})...)
+}
+
+// applyManagerTime configures the NTP servers, the time zone and, when setDateTime is true, the date and time of the manager
+func applyManagerTime(ctx context.Context, service *gofish.Service, plan *models.ManagerTime, setDateTime bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	manager, err := getManagerResource(service, plan.ManagerID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return diags
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return diags
+	}
+
+	if !plan.NTPEnabled.IsUnknown() || !plan.NTPServers.IsUnknown() {
+		var ntpServers []string
+		if !plan.NTPServers.IsUnknown() && !plan.NTPServers.IsNull() {
+			diags.Append(plan.NTPServers.ElementsAs(ctx, &ntpServers, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		if err := updateManagerNTP(manager, plan.NTPEnabled, ntpServers); err != nil {
+			diags.AddError("Error updating the NTP settings", err.Error())
+			return diags
+		}
+	}
+
+	if timeZone := plan.TimeZone.ValueString(); timeZone != "" {
+		if err := updateManagerTimeZone(service, dellManager, timeZone); err != nil {
+			diags.AddAttributeError(path.Root("time_zone"), "Error updating the time zone", err.Error())
+			return diags
+		}
+	}
+
+	if dateTime := plan.DateTime.ValueString(); dateTime != "" && setDateTime {
+		timeService, err := dellManager.TimeService()
+		if err != nil {
+			diags.AddError("Error fetching the time service", err.Error())
+			return diags
+		}
+		if err := timeService.SetTime(dateTime); err != nil {
+			diags.AddError("Error setting the date and time", err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+// updateManagerNTP patches the NTP settings of the manager which differ from the requested ones
+func updateManagerNTP(manager *redfish.Manager, enabled types.Bool, servers []string) error {
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		return err
+	}
+
+	ntp := make(map[string]any)
+	if !enabled.IsUnknown() && !enabled.IsNull() && enabled.ValueBool() != networkProtocol.NTP.ProtocolEnabled {
+		ntp["ProtocolEnabled"] = enabled.ValueBool()
+	}
+	if servers != nil && !slices.Equal(servers, configuredNTPServers(networkProtocol.NTP.NTPServers)) {
+		ntp["NTPServers"] = ntpServersPayload(servers, networkProtocol.NTP.NTPServers)
+	}
+	if len(ntp) == 0 {
+		return nil
+	}
+
+	resp, err := manager.GetClient().Patch(networkProtocol.ODataID, map[string]any{"NTP": ntp})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// updateManagerTimeZone sets the time zone attribute of the manager after checking the registry allows it
+func updateManagerTimeZone(service *gofish.Service, dellManager *dell.ManagerExtended, timeZone string) error {
+	idracAttributes, err := getManagerIdracAttributes(dellManager)
+	if err != nil {
+		return err
+	}
+	if idracAttributes.Attributes.String(timeZoneAttribute) == timeZone {
+		return nil
+	}
+
+	registry, err := getManagerAttributeRegistry(service)
+	if err != nil {
+		return err
+	}
+	allowed, err := registry.AllowableValues(timeZoneAttribute)
+	if err != nil {
+		return err
+	}
+	if err := checkTimeZone(timeZone, allowed); err != nil {
+		return err
+	}
+
+	patchBody := struct {
+		ApplyTime  string `json:"@Redfish.OperationApplyTime"`
+		Attributes map[string]interface{}
+	}{
+		ApplyTime:  "Immediate",
+		Attributes: map[string]interface{}{timeZoneAttribute: timeZone},
+	}
+	resp, err := service.GetClient().Patch(idracAttributes.ODataID, patchBody)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// readManagerTime refreshes the time settings of the manager and the clock skew with the Terraform host
+func readManagerTime(ctx context.Context, service *gofish.Service, state *models.ManagerTime) diag.Diagnostics {
+	var diags diag.Diagnostics
+	manager, err := getManagerResource(service, state.ManagerID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return diags
+	}
+	dellManager, err := dell.Manager(manager)
+	if err != nil {
+		diags.AddError("Error fetching manager", err.Error())
+		return diags
+	}
+
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		diags.AddError("Error fetching the NTP settings", err.Error())
+		return diags
+	}
+	ntpServers, d := types.ListValueFrom(ctx, types.StringType, configuredNTPServers(networkProtocol.NTP.NTPServers))
+	diags.Append(d...)
+
+	idracAttributes, err := getManagerIdracAttributes(dellManager)
+	if err != nil {
+		diags.AddError("Error fetching the time zone", err.Error())
+		return diags
+	}
+
+	// the Dell time service gives the time with the offset of the time zone, the manager resource is the fallback
+	bmcDateTime := manager.DateTime
+	if timeService, err := dellManager.TimeService(); err == nil {
+		if timeData, err := timeService.GetTime(); err == nil && timeData != "" {
+			bmcDateTime = timeData
+		}
+	}
+	hostTime := time.Now()
+
+	state.ID = types.StringValue(manager.ID)
+	state.ManagerID = types.StringValue(manager.ID)
+	state.NTPEnabled = types.BoolValue(networkProtocol.NTP.ProtocolEnabled)
+	state.NTPServers = ntpServers
+	state.TimeZone = types.StringValue(idracAttributes.Attributes.String(timeZoneAttribute))
+	state.BMCDateTime = types.StringValue(bmcDateTime)
+	state.ClockSkewSeconds = types.Int64Null()
+	if skew, err := clockSkewSeconds(bmcDateTime, hostTime); err == nil {
+		state.ClockSkewSeconds = types.Int64Value(skew)
+	} else {
+		tflog.Warn(ctx, "Unable to compute the clock skew of the manager", map[string]any{"error": err.Error()})
+	}
+	return diags
+}
+
+// getManagerIdracAttributes returns the iDRAC attributes of the manager
+func getManagerIdracAttributes(dellManager *dell.ManagerExtended) (*dell.Attributes, error) {
+	dellAttributes, err := dellManager.DellAttributes()
+	if err != nil {
+		return nil, err
+	}
+	return getIdracAttributes(dellAttributes)
+}
+
+// configuredNTPServers drops the empty slots the iDRAC reports for the NTP servers which are not set
+func configuredNTPServers(servers []string) []string {
+	configured := make([]string, 0, len(servers))
+	for _, server := range servers {
+		if strings.TrimSpace(server) != "" {
+			configured = append(configured, server)
+		}
+	}
+	return configured
+}
+
+// ntpServersPayload pads the NTP servers with empty values so that the slots of the removed servers are cleared
+func ntpServersPayload(servers, current []string) []string {
+	payload := slices.Clone(servers)
+	for len(payload) < len(current) {
+		payload = append(payload, "")
+	}
+	return payload
+}
+
+// checkTimeZone returns an error when the time zone is not one of the allowed values, an empty list allowing any value
+func checkTimeZone(timeZone string, allowed []string) error {
+	if len(allowed) == 0 || slices.Contains(allowed, timeZone) {
+		return nil
+	}
+	return fmt.Errorf("time zone %s is not allowed by the manager. Allowed values: %s", timeZone, strings.Join(allowed, ", "))
+}
+
+// clockSkewSeconds returns the number of seconds the manager clock is ahead of the host clock
+func clockSkewSeconds(bmcDateTime string, hostTime time.Time) (int64, error) {
+	bmcTime, err := time.Parse(time.RFC3339, bmcDateTime)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(bmcTime.Sub(hostTime).Seconds())), nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test to configure, update and import the time settings of the manager - Positive
+func TestAccRedfishManagerTime_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerTimeConfig(creds, `
+				ntp_enabled = true
+				ntp_servers = ["0.pool.ntp.org", "1.pool.ntp.org"]
+				time_zone   = "UTC"
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_time.time", "ntp_enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_manager_time.time", "ntp_servers.#", "2"),
+					resource.TestCheckResourceAttr("redfish_manager_time.time", "time_zone", "UTC"),
+					resource.TestCheckResourceAttrSet("redfish_manager_time.time", "bmc_date_time"),
+					resource.TestCheckResourceAttrSet("redfish_manager_time.time", "clock_skew_seconds"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerTimeConfig(creds, `
+				ntp_enabled = true
+				ntp_servers = ["0.pool.ntp.org"]
+				time_zone   = "CST6CDT"
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_time.time", "ntp_servers.#", "1"),
+					resource.TestCheckResourceAttr("redfish_manager_time.time", "time_zone", "CST6CDT"),
+				),
+			},
+			{
+				ResourceName:  "redfish_manager_time.time",
+				ImportState:   true,
+				ImportStateId: "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["time_zone"] != "CST6CDT" {
+						return fmt.Errorf("expected the imported time zone to be CST6CDT")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// Test to configure the time settings with invalid values - Negative
+func TestAccRedfishManagerTime_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerTimeConfig(creds, `
+				ntp_enabled = true
+				date_time   = "2026-01-02T03:04:05-06:00"
+				`),
+				ExpectError: regexp.MustCompile("date_time cannot be set while ntp_enabled is true"),
+			},
+			{
+				Config:      testAccRedfishResourceManagerTimeConfig(creds, `date_time = "2026-01-02 03:04:05"`),
+				ExpectError: regexp.MustCompile("YYYY-MM-DDThh:mm:ss"),
+			},
+			{
+				Config:      testAccRedfishResourceManagerTimeConfig(creds, `time_zone = "Invalid/Zone"`),
+				ExpectError: regexp.MustCompile("Error updating the time zone"),
+			},
+		},
+	})
+}
+
+// Test to configure the time settings with a mocked error - Negative
+func TestAccRedfishManagerTime_CreateMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(updateManagerNTP).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      testAccRedfishResourceManagerTimeConfig(creds, `ntp_enabled = true`),
+				ExpectError: regexp.MustCompile("Error updating the NTP settings"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestManagerTimeHelpers(t *testing.T) {
+	if got := configuredNTPServers([]string{"0.pool.ntp.org", "", " "}); !slices.Equal(got, []string{"0.pool.ntp.org"}) {
+		t.Errorf("configuredNTPServers() = %v", got)
+	}
+	if got := ntpServersPayload([]string{"a"}, []string{"x", "y", ""}); !slices.Equal(got, []string{"a", "", ""}) {
+		t.Errorf("ntpServersPayload() = %v", got)
+	}
+
+	if err := checkTimeZone("UTC", []string{"CST6CDT", "UTC"}); err != nil {
+		t.Errorf("Expected UTC to be allowed, got %v", err)
+	}
+	if err := checkTimeZone("Invalid/Zone", []string{"CST6CDT", "UTC"}); err == nil {
+		t.Error("Expected an error for a time zone which is not allowed, got nil")
+	}
+	if err := checkTimeZone("Any/Zone", nil); err != nil {
+		t.Errorf("Expected any time zone to be allowed without a list, got %v", err)
+	}
+
+	host := time.Date(2026, 1, 2, 9, 4, 0, 0, time.UTC)
+	if skew, err := clockSkewSeconds("2026-01-02T03:04:05-06:00", host); err != nil || skew != 5 {
+		t.Errorf("clockSkewSeconds() = %d, %v, want 5", skew, err)
+	}
+	if _, err := clockSkewSeconds("not a time", host); err == nil {
+		t.Error("Expected an error for an invalid date and time, got nil")
+	}
+}
+
+func testAccRedfishResourceManagerTimeConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_manager_time" "time" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
 	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -102,6 +103,15 @@ func (r *RedfishNICResource) Create(ctx context.Context, req resource.CreateRequ
 			noteMessageUpdateOneAttrsOnly)
 		return
 	}
+
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	diags = updateRedfishNIC(ctx, service, &emptyState, &plan)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
@@ -192,6 +202,14 @@ func (r *RedfishNICResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	diags = updateRedfishNIC(ctx, service, &state, &plan)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
@@ -278,10 +296,6 @@ func updateRedfishNIC(ctx context.Context, service *gofish.Service, state, plan
 		jobWait = false
 	}
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
-
 	// OnReset case
 	if applyTime == string(redfishcommon.OnResetApplyTime) {
 		// Reboot the server
@@ -577,23 +591,36 @@ func readRedfishNIC(ctx context.Context, service *gofish.Service, state *models.
 		return diags
 	}
 
+	// the settings which are staged but not applied yet are read with their pending value
+	deviceFunction := &redfish.NetworkDeviceFunction{}
+	if err := decodeWithPendingSettings(ctx, service.GetClient(), networketworkDeviceFunc.ODataID, deviceFunction); err != nil {
+		diags.AddError("Error when retrieving NetworkDeviceFunction", err.Error())
+		return diags
+	}
+	deviceFunction.SetClient(service.GetClient())
+
 	// get OEM data
-	dellDeviceFunction, _ := dell.NetworkDeviceFunction(networketworkDeviceFunc)
+	dellDeviceFunction, _ := dell.NetworkDeviceFunction(deviceFunction)
 	if dellDeviceFunction.DellNetworkAttributes.ODataID == "" {
 		diags.AddError("there was an issue when reading NIC", "error get DellNetworkAttributes ODataID from NetworkDeviceFunction Extension")
 		return diags
 	}
-	dellNetworkAttributes, err := dell.GetDellNetworkAttributes(service.GetClient(), dellDeviceFunction.DellNetworkAttributes.ODataID)
+	dellNetworkAttributes := &dell.NetworkAttributes{}
+	err = decodeWithPendingSettings(ctx, service.GetClient(), dellDeviceFunction.DellNetworkAttributes.ODataID, dellNetworkAttributes)
 	if err != nil {
 		diags.AddError("Error when retrieving DellNetworkAttributes", err.Error())
 		return diags
 	}
+	dellNetworkAttributes.SetClient(service.GetClient())
 	if diags = parseDellNetworkAttributesIntoState(ctx, dellNetworkAttributes, state); diags.HasError() {
 		return diags
 	}
 	if diags = parseNetworkDeviceFunctionIntoState(ctx, dellDeviceFunction, state); diags.HasError() {
 		return diags
 	}
+	pending := readPendingSettings(ctx, service, nicConfigurationJobType,
+		dellDeviceFunction.ODataID, dellDeviceFunction.DellNetworkAttributes.ODataID)
+	state.PendingAttributes, state.PendingJobID = pending.values()
 
 	state.ID = types.StringValue("redfish_network_adapter_resource")
 	state.SystemID = types.StringValue(system.ID)
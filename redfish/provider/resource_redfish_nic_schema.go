@@ -99,6 +99,8 @@ func NICResourceSchema() map[string]schema.Attribute {
 			Description:         "ID of the network interface cards resource",
 			Computed:            true,
 		},
+		"pending_attributes": pendingAttributesSchema(),
+		"pending_job_id":     pendingJobIDSchema(),
 		"network_adapter_id": schema.StringAttribute{
 			MarkdownDescription: "ID of the network adapter",
 			Description:         "ID of the network adapter",
@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -874,3 +875,42 @@ func testAccRedfishResourceNICAttributesIscsiConfigUpdate(testingInfo testingNIC
 		testingInfo.NetworkDeviceFunctionID,
 	)
 }
+
+func TestReadNICPendingSettings(t *testing.T) {
+	uri := "/redfish/v1/Chassis/System.Embedded.1/NetworkAdapters/NIC.Integrated.1/NetworkDeviceFunctions/NIC.Integrated.1-1-1"
+	resource := `{"@odata.id": "` + uri + `", "Id": "NIC.Integrated.1-1-1",
+		"@Redfish.Settings": {"SettingsObject": {"@odata.id": "` + uri + `/Settings"}},
+		"Ethernet": {"MACAddress": "AA:BB:CC:DD:EE:FF", "MTUSize": 1500, "VLAN": {"VLANEnabled": false, "VLANID": 1}}}`
+	settings := `{"@odata.id": "` + uri + `/Settings", "Id": "Settings", "Ethernet": {"MTUSize": 9000, "VLAN": {"VLANEnabled": true}}}`
+
+	deviceFunction := &redfish.NetworkDeviceFunction{}
+	if err := decodeWithPendingSettings(context.Background(), testPendingSettingsClient(resource, settings), uri, deviceFunction); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	dellDeviceFunction, err := dell.NetworkDeviceFunction(deviceFunction)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// the staged settings are read with their pending value, the other ones with their current value
+	if dellDeviceFunction.ODataID != uri || dellDeviceFunction.DellEthernet.MACAddress != "AA:BB:CC:DD:EE:FF" {
+		t.Errorf("Expected the current values of the network device function, got %+v", dellDeviceFunction.DellEthernet)
+	}
+	if dellDeviceFunction.DellEthernet.MTUSize != 9000 || !dellDeviceFunction.DellEthernet.VLAN.VLANEnabled ||
+		dellDeviceFunction.DellEthernet.VLAN.VLANID != 1 {
+		t.Errorf("Expected the pending values of the network device function, got %+v", dellDeviceFunction.DellEthernet)
+	}
+
+	attributesURI := uri + "/Oem/Dell/DellNetworkAttributes/NIC.Integrated.1-1-1"
+	attributes := `{"@odata.id": "` + attributesURI + `", "Id": "NIC.Integrated.1-1-1",
+		"@Redfish.Settings": {"SettingsObject": {"@odata.id": "` + attributesURI + `/Settings"}},
+		"Attributes": {"WakeOnLan": "Disabled", "VLanMode": "Disabled"}}`
+	networkAttributes := &dell.NetworkAttributes{}
+	err = decodeWithPendingSettings(context.Background(),
+		testPendingSettingsClient(attributes, `{"Id": "Settings", "Attributes": {"WakeOnLan": "Enabled"}}`), attributesURI, networkAttributes)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if networkAttributes.Attributes["WakeOnLan"] != "Enabled" || networkAttributes.Attributes["VLanMode"] != "Disabled" {
+		t.Errorf("Expected the pending values of the network attributes, got %v", networkAttributes.Attributes)
+	}
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -100,11 +102,33 @@ func RedfishScpExportSchema() map[string]schema.Attribute {
 			MarkdownDescription: "ID of the export SCP resource",
 			Description:         "ID of the export SCP resource",
 			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
 		},
 		"file_content": schema.StringAttribute{
 			MarkdownDescription: "File Content",
 			Description:         "File Content",
 			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"components": schema.MapAttribute{
+			MarkdownDescription: "Attribute values of the exported profile by the FQDD of their component and the name of the attribute," +
+				" selected by `filter`. The profile is parsed only when it is exported to the `LOCAL` share type.",
+			Description: "Attribute values of the exported profile by the FQDD of their component and the name of the attribute," +
+				" selected by filter. The profile is parsed only when it is exported to the LOCAL share type.",
+			Computed:    true,
+			ElementType: types.MapType{ElemType: types.StringType},
+		},
+		"filter": schema.SingleNestedAttribute{
+			MarkdownDescription: "Filter of the components and attributes of the exported profile returned in `components`." +
+				" Changing the filter parses the exported profile again, without exporting it.",
+			Description: "Filter of the components and attributes of the exported profile returned in components." +
+				" Changing the filter parses the exported profile again, without exporting it.",
+			Optional:   true,
+			Attributes: ScpProfileFilterSchema(),
 		},
 		"export_format": schema.StringAttribute{
 			MarkdownDescription: "Specify the output file format.",
@@ -386,6 +410,36 @@ func ShareParametersExportSchema() map[string]schema.Attribute {
 	}
 }
 
+// ScpProfileFilterSchema returns the schema of the filter of the components and attributes of a profile
+func ScpProfileFilterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"components": schema.ListAttribute{
+			MarkdownDescription: "Regular expressions matching the FQDDs of the components to select, such as `^NIC\\.` or `BIOS.Setup.1-1`." +
+				" All the components are selected when it is not set.",
+			Description: "Regular expressions matching the FQDDs of the components to select, such as ^NIC\\. or BIOS.Setup.1-1." +
+				" All the components are selected when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"attributes": schema.ListAttribute{
+			MarkdownDescription: "Regular expressions matching the names of the attributes to select, such as `^SNMP\\.` or `BootMode`." +
+				" All the attributes are selected when it is not set.",
+			Description: "Regular expressions matching the names of the attributes to select, such as ^SNMP\\. or BootMode." +
+				" All the attributes are selected when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
 // ValidateConfig validates the resource config.
 func (*ScpExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	// Get Plan Data
@@ -395,6 +449,12 @@ func (*ScpExportResource) ValidateConfig(ctx context.Context, req resource.Valid
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !plan.Filter.IsUnknown() {
+		if _, err := newScpExportFilter(ctx, plan); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid Server Configuration Profile filter", err.Error())
+			return
+		}
+	}
 	if plan.ShareParameters.IsUnknown() {
 		return
 	}
@@ -458,8 +518,12 @@ func (r *ScpExportResource) Create(ctx context.Context, req resource.CreateReque
 	var sp models.TFShareParameters
 	plan.ShareParameters.As(ctx, &sp, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
 
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	api, err := NewConfig(r.p, &plan.RedfishServer)
 	if err != nil {
@@ -475,6 +539,8 @@ func (r *ScpExportResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 	plan.FileContent = types.StringValue(content)
+	plan.Components, diags = scpExportComponents(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Trace(ctx, "resource_ScpExport create: updating state finished, saving ...")
 	// Save into State
@@ -485,11 +551,21 @@ func (r *ScpExportResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (*ScpExportResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Error updating Export Server Configuration Profile.",
-		"An update plan of Export Server Configuration Profile should never be invoked. This resource is supposed to be replaced on update.",
-	)
+// The changes of the export parameters replace the resource, the other changes parse the exported profile again.
+func (*ScpExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.TFRedfishScpExport
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.FileContent = state.FileContent
+	components, diags := scpExportComponents(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	plan.Components = components
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -536,6 +612,47 @@ func scpExportExecutor(ctx context.Context, service *gofish.Service, plan models
 	return "SCP exported successfully", nil
 }
 
+// newScpExportFilter returns the filter of the components and attributes of the exported profile
+func newScpExportFilter(ctx context.Context, plan models.TFRedfishScpExport) (*scpProfileFilter, error) {
+	if plan.Filter.IsNull() || plan.Filter.IsUnknown() {
+		return newScpProfileFilter(nil, nil)
+	}
+	var filter models.ScpProfileFilter
+	if diags := plan.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("unable to read the filter")
+	}
+	return newScpProfileFilterFromModel(&filter)
+}
+
+// scpExportComponents parses the profile exported to the LOCAL share type into the attribute values of its components,
+// the components are null for the other share types, which do not return the profile
+func scpExportComponents(ctx context.Context, plan models.TFRedfishScpExport) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	componentsType := types.MapType{ElemType: types.StringType}
+	var sp models.TFShareParameters
+	plan.ShareParameters.As(ctx, &sp, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	if sp.ShareType.ValueString() != "LOCAL" {
+		return types.MapNull(componentsType), diags
+	}
+
+	content, err := base64.StdEncoding.DecodeString(plan.FileContent.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to parse the exported Server Configuration Profile", err.Error())
+		return types.MapNull(componentsType), diags
+	}
+	profile, err := parseScpProfile(string(content))
+	if err != nil {
+		diags.AddWarning("Unable to parse the exported Server Configuration Profile", err.Error())
+		return types.MapNull(componentsType), diags
+	}
+	filter, err := newScpExportFilter(ctx, plan)
+	if err != nil {
+		diags.AddError("Invalid Server Configuration Profile filter", err.Error())
+		return types.MapNull(componentsType), diags
+	}
+	return types.MapValueFrom(ctx, componentsType, map[string]map[string]string(filter.apply(profile)))
+}
+
 // constructExportPayload is a function that constructs the SCP export payload
 func constructExportPayload(ctx context.Context, plan models.TFRedfishScpExport, firmwareVersion string) models.SCPExport {
 	var sp models.TFShareParameters
@@ -19,24 +19,29 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
-	"terraform-provider-redfish/common"
 	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -46,11 +51,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &ScpImportResource{}
+	_ resource.Resource                   = &ScpImportResource{}
+	_ resource.ResourceWithModifyPlan     = &ScpImportResource{}
+	_ resource.ResourceWithValidateConfig = &ScpImportResource{}
 )
 
 const (
@@ -61,6 +69,23 @@ const (
 	defaultIntBase          int   = 10
 )
 
+// scpTargets lists the components a server configuration profile import can be limited to
+var scpTargets = []string{
+	"ALL",
+	"IDRAC",
+	"BIOS",
+	"NIC",
+	"RAID",
+	"FC",
+	"InfiniBand",
+	"SupportAssist",
+	"EventFilters",
+	"System",
+	"LifecycleController",
+	"AHCI",
+	"PCIeSSD",
+}
+
 // NewScpImportResource is a helper function to simplify the provider implementation.
 func NewScpImportResource() resource.Resource {
 	return &ScpImportResource{}
@@ -213,6 +238,127 @@ func RedfishScpImportSchema() map[string]schema.Attribute {
 				objectplanmodifier.RequiresReplace(),
 			},
 		},
+		"import_file": schema.StringAttribute{
+			MarkdownDescription: "Path of a local Server Configuration Profile file, in XML or JSON format, to import with the `LOCAL` share type." +
+				" Conflicts with `import_buffer`.",
+			Description: "Path of a local Server Configuration Profile file, in XML or JSON format, to import with the LOCAL share type." +
+				" Conflicts with import_buffer.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(path.MatchRoot("import_buffer")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"import_file_hash": schema.StringAttribute{
+			MarkdownDescription: "SHA-256 hash of the content of `import_file`. A change of the content of the file imports the profile again.",
+			Description:         "SHA-256 hash of the content of import_file. A change of the content of the file imports the profile again.",
+			Computed:            true,
+		},
+		"template_vars": schema.MapAttribute{
+			MarkdownDescription: "Variables of the server substituted in the profile of `import_file` or `import_buffer`," +
+				" which is written as a Go template such as `{{ .hostname }}`. The profile is imported as it is when it is not set.",
+			Description: "Variables of the server substituted in the profile of import_file or import_buffer," +
+				" which is written as a Go template such as {{ .hostname }}. The profile is imported as it is when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"fqdds": schema.ListAttribute{
+			MarkdownDescription: "FQDDs of the components of the profile of `import_file` or `import_buffer` to import," +
+				" such as `BIOS.Setup.1-1` or `NIC.Integrated.1-1-1`. The other components are left out of the import." +
+				" All the components are imported when it is not set.",
+			Description: "FQDDs of the components of the profile of import_file or import_buffer to import," +
+				" such as BIOS.Setup.1-1 or NIC.Integrated.1-1-1. The other components are left out of the import." +
+				" All the components are imported when it is not set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the import job.",
+			Description:         "ID of the import job.",
+			Computed:            true,
+		},
+		"job_state": schema.StringAttribute{
+			MarkdownDescription: "State of the import job, such as `Completed` or `CompletedWithErrors`.",
+			Description:         "State of the import job, such as Completed or CompletedWithErrors.",
+			Computed:            true,
+		},
+		"error_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of attributes of the profile which failed to import.",
+			Description:         "Number of attributes of the profile which failed to import.",
+			Computed:            true,
+		},
+		"results": schema.ListNestedAttribute{
+			MarkdownDescription: "Import results of the attributes of each component of the profile.",
+			Description:         "Import results of the attributes of each component of the profile.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ScpAttributeResultResourceSchema(),
+			},
+		},
+	}
+}
+
+// ScpAttributeResultResourceSchema returns the schema of the result of an attribute of a server configuration profile import
+func ScpAttributeResultResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"fqdd": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified device descriptor of the component of the attribute",
+			Description:         "Fully qualified device descriptor of the component of the attribute",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the attribute",
+			Description:         "Name of the attribute",
+			Computed:            true,
+		},
+		"old_value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute on the server before the import",
+			Description:         "Value of the attribute on the server before the import",
+			Computed:            true,
+		},
+		"new_value": schema.StringAttribute{
+			MarkdownDescription: "Value of the attribute in the profile",
+			Description:         "Value of the attribute in the profile",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the attribute, Success or Failure",
+			Description:         "Status of the attribute, Success or Failure",
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Message of the result",
+			Description:         "Message of the result",
+			Computed:            true,
+		},
+		"message_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the message of the result",
+			Description:         "ID of the message of the result",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "Severity of the message of the result",
+			Description:         "Severity of the message of the result",
+			Computed:            true,
+		},
+		"error_code": schema.Int64Attribute{
+			MarkdownDescription: "Error code of the attribute",
+			Description:         "Error code of the attribute",
+			Computed:            true,
+		},
 	}
 }
 
@@ -396,21 +542,7 @@ func ShareParametersSchema() map[string]schema.Attribute {
 			),
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
-				listvalidator.ValueStringsAre(stringvalidator.OneOf([]string{
-					string("ALL"),
-					string("IDRAC"),
-					string("BIOS"),
-					string("NIC"),
-					string("RAID"),
-					string("FC"),
-					string("InfiniBand"),
-					string("SupportAssist"),
-					string("EventFilters"),
-					string("System"),
-					string("LifecycleController"),
-					string("AHCI"),
-					string("PCIeSSD"),
-				}...)),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(scpTargets...)),
 			},
 		},
 		"username": schema.StringAttribute{
@@ -434,12 +566,25 @@ func (*ScpImportResource) ValidateConfig(ctx context.Context, req resource.Valid
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if (!plan.TemplateVars.IsNull() || !plan.FQDDs.IsNull()) && plan.ImportFile.IsNull() && plan.ImportBuffer.IsNull() {
+		resp.Diagnostics.AddError(
+			"Import Profile Error",
+			"When configuring template_vars or fqdds, it is essential to provide the profile in import_file or import_buffer.")
+		return
+	}
 	if plan.ShareParameters.IsUnknown() {
 		return
 	}
 	var sp models.TFShareParameters
 	plan.ShareParameters.As(ctx, &sp, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
 	shareType := sp.ShareType.ValueString()
+	if !plan.ImportFile.IsNull() && !sp.ShareType.IsUnknown() && shareType != "LOCAL" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("import_file"),
+			"Import Profile Error",
+			fmt.Sprintf("import_file can only be used with the LOCAL share type, got %s.", shareType))
+		return
+	}
 	switch shareType {
 	case "NFS":
 		if sp.IPAddress.IsNull() || sp.ShareName.IsNull() {
@@ -477,6 +622,34 @@ func (*ScpImportResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
+// ModifyPlan computes the hash of the content of import_file, so that a change of the content of the file,
+// and not only of its path, replaces the resource to import the profile again
+func (*ScpImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var importFile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("import_file"), &importFile)...)
+	if resp.Diagnostics.HasError() || importFile.IsUnknown() {
+		return
+	}
+
+	stateHash := types.StringUnknown()
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("import_file_hash"), &stateHash)...)
+	}
+	hash, err := scpImportFileHash(importFile)
+	if err != nil {
+		// the file may be written during the apply, its hash is then computed when it is imported
+		tflog.Debug(ctx, "unable to compute the hash of import_file: "+err.Error())
+		hash = stateHash
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("import_file_hash"), hash)...)
+	if !req.State.Raw.IsNull() && !hash.Equal(stateHash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("import_file_hash"))
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *ScpImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "resource_ScpImport create : Started")
@@ -494,8 +667,12 @@ func (r *ScpImportResource) Create(ctx context.Context, req resource.CreateReque
 	var sp models.TFShareParameters
 	plan.ShareParameters.As(ctx, &sp, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
 
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	api, err := NewConfig(r.p, &plan.RedfishServer)
 	if err != nil {
@@ -505,11 +682,35 @@ func (r *ScpImportResource) Create(ctx context.Context, req resource.CreateReque
 	service := api.Service
 	defer api.Logout()
 
-	log, err := scpImportExecutor(ctx, service, plan)
+	if plan.ImportFileHash.IsUnknown() {
+		if plan.ImportFileHash, err = scpImportFileHash(plan.ImportFile); err != nil {
+			resp.Diagnostics.AddError("error while reading the server configuration profile", err.Error())
+			return
+		}
+	}
+
+	if err := waitForRedfishServerReady(ctx, r.p, plan.RedfishServer); err != nil {
+		resp.Diagnostics.AddError("iDRAC is not ready for the import", err.Error())
+		return
+	}
+
+	task, log, err := scpImportExecutor(ctx, service, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(log, err.Error())
 		return
 	}
+	if task == nil {
+		task = &dell.ServerConfigurationProfileTask{}
+	}
+	plan.JobID = types.StringValue(task.ID)
+	plan.JobState = types.StringValue(scpJobState(task))
+	failures := task.Failures()
+	plan.ErrorCount = types.Int64Value(int64(len(failures)))
+	plan.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scpAttributeResultType()}, newScpAttributeResults(task))
+	resp.Diagnostics.Append(diags...)
+	if len(failures) > 0 {
+		resp.Diagnostics.AddWarning("Some attributes of the Server Configuration Profile were not imported", scpFailuresDetail(task, failures))
+	}
 
 	tflog.Trace(ctx, "resource_ScpImport create: updating state finished, saving ...")
 	// Save into State
@@ -541,37 +742,108 @@ func (*ScpImportResource) Delete(ctx context.Context, _ resource.DeleteRequest,
 //
 // Parameters:
 // - service: a pointer to a gofish.Service object representing the Redfish service.
-// - scpImportPayload: a models.SCPImport object containing the payload for the SCP import.
-// - jobCheckIntervalTime: an int64 representing the interval time for checking the job status.
-// - jobDefaultTimeout: an int64 representing the default timeout for the job.
+// - plan: the RedfishScpImport plan of the profile to import.
 //
 // Returns:
+// - *dell.ServerConfigurationProfileTask: the finished task of the import job, with the result of each attribute.
 // - string: a message indicating the result of the SCP import.
 // - error: an error object if there was an error during the import process.
-func scpImportExecutor(ctx context.Context, service *gofish.Service, plan models.RedfishScpImport) (string, error) {
+func scpImportExecutor(ctx context.Context, service *gofish.Service,
+	plan models.RedfishScpImport,
+) (*dell.ServerConfigurationProfileTask, string, error) {
+	importBuffer, err := scpImportBuffer(ctx, plan)
+	if err != nil {
+		return nil, "error while reading the server configuration profile", err
+	}
 	managers, err := service.Managers()
 	if err != nil {
-		return "error while retrieving managers", err
+		return nil, "error while retrieving managers", err
 	}
 	dellManager, err := dell.Manager(managers[0])
 	if err != nil {
-		return "error while retrieving dell manager", err
+		return nil, "error while retrieving dell manager", err
 	}
-	time.Sleep(60 * time.Second)
 	importURL := dellManager.Actions.ImportSystemConfigurationTarget
-	response, err := service.GetClient().Post(importURL, constructPayload(ctx, plan, dellManager.FirmwareVersion))
+	payload := constructPayload(ctx, plan, dellManager.FirmwareVersion)
+	payload.ImportBuffer = importBuffer
+	response, err := service.GetClient().Post(importURL, payload)
 	if err != nil {
-		return "error during import", err
+		return nil, "error during import", err
 	}
 
 	if location, err := response.Location(); err == nil {
-		taskURI := location.EscapedPath()
-		err = common.WaitForDellJobToFinish(service, taskURI, intervalJobCheckTime, defaultJobTimeout)
+		task, err := waitForScpTask(ctx, service, location.EscapedPath(), intervalJobCheckTime, defaultJobTimeout, nil)
 		if err != nil {
-			return "error waiting for SCP Export monitor task to be completed", err
+			return nil, "error waiting for SCP Import monitor task to be completed", err
 		}
+		if redfish.TaskState(task.TaskState) != redfish.CompletedTaskState || scpJobState(task) == "Failed" {
+			return task, "error importing the server configuration profile", errors.New(scpFailuresDetail(task, task.Failures()))
+		}
+		return task, "The server configuration profile was successfully imported", nil
+	}
+	return nil, "The server configuration profile was successfully imported", nil
+}
+
+// scpImportBuffer returns the profile to import from import_file or import_buffer, with its template variables
+// substituted and only its selected components
+func scpImportBuffer(ctx context.Context, plan models.RedfishScpImport) (string, error) {
+	content := plan.ImportBuffer.ValueString()
+	if !plan.ImportFile.IsNull() {
+		data, err := os.ReadFile(plan.ImportFile.ValueString())
+		if err != nil {
+			return "", err
+		}
+		content = string(data)
+	}
+	if !plan.TemplateVars.IsNull() {
+		vars := map[string]string{}
+		if diags := plan.TemplateVars.ElementsAs(ctx, &vars, false); diags.HasError() {
+			return "", fmt.Errorf("unable to read the template variables")
+		}
+		var err error
+		if content, err = renderScpProfile(content, vars); err != nil {
+			return "", err
+		}
+	}
+	if !plan.FQDDs.IsNull() {
+		var fqdds []string
+		if diags := plan.FQDDs.ElementsAs(ctx, &fqdds, false); diags.HasError() {
+			return "", fmt.Errorf("unable to read the fqdds")
+		}
+		var err error
+		if content, err = selectScpComponents(content, fqdds); err != nil {
+			return "", err
+		}
+	}
+	return content, nil
+}
+
+// scpImportFileHash returns the SHA-256 hash of the content of the import file, null when there is none
+func scpImportFileHash(importFile types.String) (types.String, error) {
+	if importFile.IsNull() {
+		return types.StringNull(), nil
+	}
+	data, err := os.ReadFile(importFile.ValueString())
+	if err != nil {
+		return types.StringUnknown(), err
+	}
+	sum := sha256.Sum256(data)
+	return types.StringValue(hex.EncodeToString(sum[:])), nil
+}
+
+// scpAttributeResultType returns the type of the result of an attribute of a server configuration profile job
+func scpAttributeResultType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"fqdd":       types.StringType,
+		"name":       types.StringType,
+		"old_value":  types.StringType,
+		"new_value":  types.StringType,
+		"status":     types.StringType,
+		"message":    types.StringType,
+		"message_id": types.StringType,
+		"severity":   types.StringType,
+		"error_code": types.Int64Type,
 	}
-	return "The server configuration profile was successfully imported", nil
 }
 
 // constructPayload constructs a SCPImport payload from a RedfishScpImport plan.
@@ -619,11 +891,7 @@ func constructPayload(ctx context.Context, plan models.RedfishScpImport, firmwar
 		Username:                 sp.Username.ValueString(),
 	}
 
-	if strings.HasPrefix(firmwareVersion, "5.") {
-		scpImport.ShareParameters.Target = strings.Join(target, ", ")
-	} else {
-		scpImport.ShareParameters.Target = target
-	}
+	scpImport.ShareParameters.Target = scpTarget(target, firmwareVersion)
 
 	// Set proxySupport to "Enabled" if the plan's proxySupport value is true,
 	// otherwise set it to "Disabled".
@@ -646,3 +914,12 @@ func constructPayload(ctx context.Context, plan models.RedfishScpImport, firmwar
 
 	return scpImport
 }
+
+// scpTarget returns the components targeted by a server configuration profile job in the format of the firmware,
+// a comma separated string on the 5.x firmwares and a list on the later ones
+func scpTarget(target []string, firmwareVersion string) interface{} {
+	if strings.HasPrefix(firmwareVersion, "5.") {
+		return strings.Join(target, ", ")
+	}
+	return target
+}
@@ -18,12 +18,20 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
+	"terraform-provider-redfish/redfish/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -133,6 +141,203 @@ func TestAccRedfishSCP(t *testing.T) {
 	})
 }
 
+func TestAccRedfishSCPExportComponents(t *testing.T) {
+	exportName := "redfish_idrac_server_configuration_profile_export.components"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: createSCPConfig("export", "components", getSP("LOCAL", nil), `
+				filter = {
+					components = ["^EventFilters\\."]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(exportName, "components.%"),
+				),
+			},
+			{
+				// the new filter parses the exported profile again, without exporting it
+				Config: createSCPConfig("export", "components", getSP("LOCAL", nil), `
+				filter = {
+					components = ["^EventFilters\\."]
+					attributes = ["^Alert\\.1#"]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(exportName, "components.%"),
+				),
+			},
+			{
+				Config:      createSCPConfig("export", "components", getSP("LOCAL", nil), `filter = { attributes = ["("] }`),
+				ExpectError: regexp.MustCompile("Invalid Server Configuration Profile filter"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishSCPImportFile(t *testing.T) {
+	profile := filepath.Join(t.TempDir(), "profile.xml")
+	content := `<SystemConfiguration>
+<Component FQDD="iDRAC.Embedded.1">
+<Attribute Name="SNMP.1#AgentCommunity">{{ .community }}</Attribute>
+</Component>
+<Component FQDD="BIOS.Setup.1-1">
+<Attribute Name="NumLock">On</Attribute>
+</Component>
+</SystemConfiguration>`
+	if err := os.WriteFile(profile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	importName := "redfish_idrac_server_configuration_profile_import.file"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: createSCPConfig("import", "file", `
+				share_parameters = {
+					filename   = "profile.xml"
+					target     = ["IDRAC"]
+					share_type = "LOCAL"
+				}`, fmt.Sprintf(`
+				import_file   = "%s"
+				template_vars = { community = "public" }
+				fqdds         = ["iDRAC.Embedded.1"]
+				`, profile)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(importName, "job_id"),
+					resource.TestCheckResourceAttr(importName, "error_count", "0"),
+					resource.TestCheckResourceAttr(importName, "results.0.fqdd", "iDRAC.Embedded.1"),
+					resource.TestCheckResourceAttr(importName, "results.0.name", "SNMP.1#AgentCommunity"),
+					resource.TestCheckResourceAttr(importName, "results.0.new_value", "public"),
+				),
+			},
+			{
+				Config: createSCPConfig("import", "file_nfs", getSP("NFS", nil), fmt.Sprintf(`
+				import_file = "%s"
+				`, profile)),
+				ExpectError: regexp.MustCompile("import_file can only be used with the LOCAL share type"),
+			},
+			{
+				Config: createSCPConfig("import", "missing_profile", getSP("LOCAL", nil), `
+				fqdds = ["iDRAC.Embedded.1"]
+				`),
+				ExpectError: regexp.MustCompile("Import Profile Error"),
+			},
+		},
+	})
+}
+
+func TestScpImportBuffer(t *testing.T) {
+	profile := filepath.Join(t.TempDir(), "profile.json")
+	content := `{"SystemConfiguration": {"Components": [
+		{"FQDD": "iDRAC.Embedded.1", "Attributes": [{"Name": "NIC.1#DNSRacName", "Value": "{{ .hostname }}"}]},
+		{"FQDD": "BIOS.Setup.1-1", "Attributes": [{"Name": "NumLock", "Value": "On"}]}
+	]}}`
+	if err := os.WriteFile(profile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	plan := models.RedfishScpImport{
+		ImportBuffer: types.StringNull(),
+		ImportFile:   types.StringValue(profile),
+		TemplateVars: types.MapValueMust(types.StringType, map[string]attr.Value{"hostname": types.StringValue("server-01")}),
+		FQDDs:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("iDRAC.Embedded.1")}),
+	}
+	buffer, err := scpImportBuffer(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	parsed, err := parseScpProfile(buffer)
+	if err != nil {
+		t.Fatalf("Expected the import buffer to parse, got %v", err)
+	}
+	if want := (scpProfile{"iDRAC.Embedded.1": {"NIC.1#DNSRacName": "server-01"}}); !reflect.DeepEqual(parsed, want) {
+		t.Fatalf("Expected the import buffer %v, got %v", want, parsed)
+	}
+
+	plan.TemplateVars = types.MapNull(types.StringType)
+	plan.FQDDs = types.ListNull(types.StringType)
+	if buffer, err = scpImportBuffer(context.Background(), plan); err != nil || buffer != content {
+		t.Fatalf("Expected the profile to be imported as it is, got %q and %v", buffer, err)
+	}
+
+	plan.ImportFile = types.StringValue(filepath.Join(t.TempDir(), "missing.xml"))
+	if _, err := scpImportBuffer(context.Background(), plan); err == nil {
+		t.Fatal("Expected an error for a missing file, got nil")
+	}
+}
+
+func TestScpImportFileHash(t *testing.T) {
+	profile := filepath.Join(t.TempDir(), "profile.xml")
+	if err := os.WriteFile(profile, []byte(`<SystemConfiguration></SystemConfiguration>`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := scpImportFileHash(types.StringValue(profile))
+	if err != nil || len(hash.ValueString()) != 64 {
+		t.Fatalf("Expected the SHA-256 hash of the file, got %v and %v", hash, err)
+	}
+
+	// a change of the content of the file changes its hash
+	if err := os.WriteFile(profile, []byte(`<SystemConfiguration ServiceTag="ABC1234"></SystemConfiguration>`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	changed, err := scpImportFileHash(types.StringValue(profile))
+	if err != nil || changed.Equal(hash) {
+		t.Fatalf("Expected a different hash for the changed file, got %v and %v", changed, err)
+	}
+
+	if hash, err := scpImportFileHash(types.StringNull()); err != nil || !hash.IsNull() {
+		t.Fatalf("Expected a null hash without import file, got %v and %v", hash, err)
+	}
+	if hash, err := scpImportFileHash(types.StringValue(filepath.Join(t.TempDir(), "missing.xml"))); err == nil || !hash.IsUnknown() {
+		t.Fatalf("Expected an unknown hash and an error for a missing file, got %v and %v", hash, err)
+	}
+}
+
+func TestWaitForRedfishServerReady(t *testing.T) {
+	var statusCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "GetRemoteServicesAPIStatus") {
+			statusCalls++
+			_, _ = w.Write([]byte(`{"Status": "Ready", "LCStatus": "Ready"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	p := &redfishProvider{RetryConfig: RetryConfig{MaxRetries: 0, EnableReadinessCheck: true}}
+	rserver := []models.RedfishServer{{
+		User:     types.StringValue("root"),
+		Password: types.StringValue("calvin"),
+		Endpoint: types.StringValue(server.URL),
+	}}
+	if err := waitForRedfishServerReady(context.Background(), p, rserver); err != nil || statusCalls != 1 {
+		t.Fatalf("Expected the iDRAC to be ready after one status check, got %v after %d checks", err, statusCalls)
+	}
+
+	// the readiness check is skipped when it is disabled
+	p.RetryConfig.EnableReadinessCheck = false
+	if err := waitForRedfishServerReady(context.Background(), p, rserver); err != nil || statusCalls != 1 {
+		t.Fatalf("Expected no status check, got %v after %d checks", err, statusCalls)
+	}
+
+	// the certificate of the iDRAC is verified with the ca_bundle of the provider
+	tlsServer := httptest.NewTLSServer(server.Config.Handler)
+	defer tlsServer.Close()
+	p.RetryConfig.EnableReadinessCheck = true
+	rserver[0].Endpoint = types.StringValue(tlsServer.URL)
+	if err := waitForRedfishServerReady(context.Background(), p, rserver); err == nil {
+		t.Fatal("Expected the iDRAC certificate to be rejected without its CA")
+	}
+	p.CABundle = types.StringValue(serverPEM(tlsServer))
+	if err := waitForRedfishServerReady(context.Background(), p, rserver); err != nil || statusCalls != 2 {
+		t.Fatalf("Expected the iDRAC to be ready with its CA, got %v after %d checks", err, statusCalls)
+	}
+}
+
 func TestAccRedfishSCPInvalid(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
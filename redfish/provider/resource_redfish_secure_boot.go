@@ -0,0 +1,701 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/sha1" // #nosec G505
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	pemencoding "encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// secureBootDatabaseIDs are the UEFI secure boot databases whose certificates are managed by the resource
+var secureBootDatabaseIDs = []string{"db", "dbx", "KEK", "PK"}
+
+// secureBootCertificateType is the object type of the certificates of the resource
+var secureBootCertificateType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"database_id":        types.StringType,
+	"certificate_string": types.StringType,
+}}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &SecureBootResource{}
+	_ resource.ResourceWithConfigure      = &SecureBootResource{}
+	_ resource.ResourceWithModifyPlan     = &SecureBootResource{}
+	_ resource.ResourceWithValidateConfig = &SecureBootResource{}
+	_ resource.ResourceWithImportState    = &SecureBootResource{}
+)
+
+// NewSecureBootResource is a helper function to simplify the provider implementation.
+func NewSecureBootResource() resource.Resource {
+	return &SecureBootResource{}
+}
+
+// SecureBootResource is the resource implementation.
+type SecureBootResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *SecureBootResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata returns the resource type name.
+func (*SecureBootResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "secure_boot"
+}
+
+// Schema defines the schema for the resource.
+func (*SecureBootResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure UEFI Secure Boot of the iDRAC Server: its state and mode," +
+			" the reset of its keys and the certificates of its key databases.",
+		Description: "This Terraform resource is used to configure UEFI Secure Boot of the iDRAC Server: its state and mode," +
+			" the reset of its keys and the certificates of its key databases.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resource.",
+				Description:         "The ID of the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System ID of the system",
+				Description:         "System ID of the system",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"secure_boot_enable": schema.BoolAttribute{
+				MarkdownDescription: "Whether UEFI Secure Boot is enabled. Secure Boot can be enabled only in UEFI boot mode.",
+				Description:         "Whether UEFI Secure Boot is enabled. Secure Boot can be enabled only in UEFI boot mode.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secure_boot_mode": schema.StringAttribute{
+				MarkdownDescription: "The Secure Boot mode, as defined in the UEFI Specification." +
+					" Accepted values: `SetupMode`, `UserMode`, `AuditMode`, `DeployedMode`.",
+				Description: "The Secure Boot mode, as defined in the UEFI Specification." +
+					" Accepted values: SetupMode, UserMode, AuditMode, DeployedMode.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(redfish.SetupModeSecureBootModeType),
+						string(redfish.UserModeSecureBootModeType),
+						string(redfish.AuditModeSecureBootModeType),
+						string(redfish.DeployedModeSecureBootModeType),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secure_boot_current_boot": schema.StringAttribute{
+				MarkdownDescription: "The UEFI Secure Boot state during the current boot cycle.",
+				Description:         "The UEFI Secure Boot state during the current boot cycle.",
+				Computed:            true,
+			},
+			"reset_keys": schema.StringAttribute{
+				MarkdownDescription: "Resets the keys of the Secure Boot databases when the resource is created or when this value changes." +
+					" Accepted values: `ResetAllKeysToDefault`, `DeleteAllKeys`, `DeletePK`." +
+					" The keys are reset before the certificates are enrolled.",
+				Description: "Resets the keys of the Secure Boot databases when the resource is created or when this value changes." +
+					" Accepted values: ResetAllKeysToDefault, DeleteAllKeys, DeletePK." +
+					" The keys are reset before the certificates are enrolled.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(redfish.ResetAllKeysToDefaultResetKeysType),
+						string(redfish.DeleteAllKeysResetKeysType),
+						string(redfish.DeletePKResetKeysType),
+					),
+				},
+			},
+			"certificates": schema.SetNestedAttribute{
+				MarkdownDescription: "Certificates enrolled in the Secure Boot databases. The certificates which are removed from the set" +
+					" are deleted from their database, the other certificates of the databases are left as is.",
+				Description: "Certificates enrolled in the Secure Boot databases. The certificates which are removed from the set" +
+					" are deleted from their database, the other certificates of the databases are left as is.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_id": schema.StringAttribute{
+							MarkdownDescription: "The Secure Boot database of the certificate. Accepted values: `db`, `dbx`, `KEK`, `PK`.",
+							Description:         "The Secure Boot database of the certificate. Accepted values: db, dbx, KEK, PK.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(secureBootDatabaseIDs...),
+							},
+						},
+						"certificate_string": schema.StringAttribute{
+							MarkdownDescription: "The PEM content of the certificate.",
+							Description:         "The PEM content of the certificate.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"reset_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Reset type to apply on the computer system after Secure Boot is enabled, disabled or its mode is changed," +
+					" when the change is applied by a BIOS configuration job. Applicable values are 'ForceRestart', 'GracefulRestart'," +
+					" and 'PowerCycle'. Default = \"GracefulRestart\".",
+				MarkdownDescription: "Reset type to apply on the computer system after Secure Boot is enabled, disabled or its mode is changed," +
+					" when the change is applied by a BIOS configuration job. Applicable values are `ForceRestart`, `GracefulRestart`," +
+					" and `PowerCycle`. Default = `GracefulRestart`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						string(redfish.ForceRestartResetType),
+						string(redfish.GracefulRestartResetType),
+						string(redfish.PowerCycleResetType),
+					}...),
+				},
+				Computed: true,
+				Default:  stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			},
+			"reset_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "reset_timeout is the time in seconds that the provider waits for the server to be reset before timing out.",
+				Default:     int64default.StaticInt64(int64(defaultBiosConfigServerResetTimeout)),
+				Computed:    true,
+			},
+			"bios_job_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "bios_job_timeout is the time in seconds that the provider waits for the BIOS configuration job" +
+					" applying the Secure Boot settings to be completed before timing out.",
+				Default:  int64default.StaticInt64(int64(defaultBiosConfigJobTimeout)),
+				Computed: true,
+			},
+		},
+		Blocks: RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the PEM content of the certificates
+func (*SecureBootResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.SecureBoot
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Certificates.IsUnknown() || config.Certificates.IsNull() {
+		return
+	}
+
+	var certificates []models.SecureBootCertificate
+	resp.Diagnostics.Append(config.Certificates.ElementsAs(ctx, &certificates, false)...)
+	for _, certificate := range certificates {
+		if certificate.CertificateString.IsUnknown() || certificate.CertificateString.IsNull() {
+			continue
+		}
+		if err := checkSecureBootCertificate(certificate.CertificateString.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(tfpath.Root("certificates"), "Invalid Secure Boot certificate",
+				fmt.Sprintf("The certificate of the database %s is not valid: %s", certificate.DatabaseID.ValueString(), err.Error()))
+		}
+	}
+}
+
+// ModifyPlan marks the state and the mode of Secure Boot as unknown when they are not configured and the keys change,
+// as resetting the keys or enrolling a platform key changes the mode.
+func (*SecureBootResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state, config models.SecureBoot
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ResetKeys.Equal(state.ResetKeys) && plan.Certificates.Equal(state.Certificates) {
+		return
+	}
+	if config.SecureBootEnable.IsNull() {
+		plan.SecureBootEnable = types.BoolUnknown()
+	}
+	if config.SecureBootMode.IsNull() {
+		plan.SecureBootMode = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SecureBootResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_secure_boot create : Started")
+	var plan models.SecureBoot
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(r.updateSecureBoot(ctx, api.Service, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_secure_boot create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecureBootResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_secure_boot read: started")
+	var state models.SecureBoot
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readSecureBoot(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_secure_boot read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SecureBootResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_secure_boot update: started")
+	var plan, state models.SecureBoot
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(r.updateSecureBoot(ctx, api.Service, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_secure_boot update: finished")
+}
+
+// Delete removes the resource from the Terraform state. Secure Boot and the enrolled certificates are left as is.
+func (*SecureBootResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_secure_boot delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_secure_boot delete: finished")
+}
+
+// ImportState import state for existing resource
+func (*SecureBootResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		SystemID     string `json:"system_id"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("redfish_server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("system_id"), types.StringValue(c.SystemID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("certificates"), types.SetNull(secureBootCertificateType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("reset_type"),
+		types.StringValue(string(redfish.GracefulRestartResetType)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("reset_timeout"), types.Int64Value(defaultBiosConfigServerResetTimeout))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tfpath.Root("bios_job_timeout"), types.Int64Value(defaultBiosConfigJobTimeout))...)
+}
+
+// updateSecureBoot resets the keys, enrolls and deletes the certificates, and applies the state and the mode of Secure Boot.
+// The state is nil when the resource is created.
+func (r *SecureBootResource) updateSecureBoot(ctx context.Context, service *gofish.Service, plan, state *models.SecureBoot) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Lock the mutex to serialize the changes of Secure Boot with the other changes of the BIOS
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		diags.AddError(lockServerErrorMsg, err.Error())
+		return diags
+	}
+	defer unlock()
+
+	system, secureBoot, err := getSecureBoot(service, plan.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching Secure Boot", err.Error())
+		return diags
+	}
+
+	if !plan.ResetKeys.IsNull() && (state == nil || !plan.ResetKeys.Equal(state.ResetKeys)) {
+		tflog.Info(ctx, "Resetting the Secure Boot keys with "+plan.ResetKeys.ValueString())
+		if err := secureBoot.ResetKeys(redfish.ResetKeysType(plan.ResetKeys.ValueString())); err != nil {
+			diags.AddError("Error resetting the Secure Boot keys", err.Error())
+			return diags
+		}
+	}
+
+	var planned, previous []models.SecureBootCertificate
+	diags.Append(plan.Certificates.ElementsAs(ctx, &planned, true)...)
+	if state != nil {
+		diags.Append(state.Certificates.ElementsAs(ctx, &previous, true)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	if len(planned) != 0 || len(previous) != 0 {
+		if err := updateSecureBootCertificates(ctx, secureBoot, planned, previous); err != nil {
+			diags.AddError("Error updating the Secure Boot certificates", err.Error())
+			return diags
+		}
+	}
+
+	payload := secureBootPatch(plan, secureBoot)
+	if len(payload) != 0 {
+		tflog.Info(ctx, "Updating the state and the mode of Secure Boot")
+		if err := patchSecureBoot(secureBoot, payload); err != nil {
+			diags.AddError("Error updating Secure Boot", err.Error())
+			return diags
+		}
+		bios, err := system.Bios()
+		if err != nil {
+			diags.AddError("Error fetching the BIOS", err.Error())
+			return diags
+		}
+		err = applyBiosConfigurationJob(ctx, service, bios, system.ID, plan.ResetType.ValueString(),
+			plan.ResetTimeout.ValueInt64(), plan.JobTimeout.ValueInt64())
+		if err != nil {
+			diags.AddError("Error applying the Secure Boot settings", err.Error())
+			return diags
+		}
+	}
+
+	diags.Append(readSecureBoot(ctx, service, plan)...)
+	return diags
+}
+
+// readSecureBoot reads the state and the mode of Secure Boot. The certificates of the state which are no longer
+// enrolled are removed from it.
+func readSecureBoot(ctx context.Context, service *gofish.Service, state *models.SecureBoot) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	system, secureBoot, err := getSecureBoot(service, state.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching Secure Boot", err.Error())
+		return diags
+	}
+
+	state.ID = types.StringValue(secureBoot.ODataID)
+	state.SystemID = types.StringValue(system.ID)
+	state.SecureBootEnable = types.BoolValue(secureBoot.SecureBootEnable)
+	state.SecureBootMode = types.StringValue(string(secureBoot.SecureBootMode))
+	state.SecureBootCurrentBoot = types.StringValue(string(secureBoot.SecureBootCurrentBoot))
+
+	if state.Certificates.IsNull() || state.Certificates.IsUnknown() {
+		state.Certificates = types.SetNull(secureBootCertificateType)
+		return diags
+	}
+
+	var certificates []models.SecureBootCertificate
+	diags.Append(state.Certificates.ElementsAs(ctx, &certificates, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	enrolled, err := getSecureBootCertificates(secureBoot)
+	if err != nil {
+		diags.AddError("Error fetching the Secure Boot certificates", err.Error())
+		return diags
+	}
+	current := make([]models.SecureBootCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		database := enrolled[certificate.DatabaseID.ValueString()]
+		if database != nil && findSecureBootCertificate(database.certificates, certificate.CertificateString.ValueString()) != nil {
+			current = append(current, certificate)
+		}
+	}
+	state.Certificates, diags = types.SetValueFrom(ctx, secureBootCertificateType, current)
+	return diags
+}
+
+// getSecureBoot returns the computer system and its Secure Boot resource
+func getSecureBoot(service *gofish.Service, systemID string) (*redfish.ComputerSystem, *redfish.SecureBoot, error) {
+	system, err := getSystemResource(service, systemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	secureBoot, err := system.SecureBoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	return system, secureBoot, nil
+}
+
+// secureBootDatabase is a Secure Boot database with the URI of its certificates collection and its enrolled certificates
+type secureBootDatabase struct {
+	*redfish.SecureBootDatabase
+	certificatesURI string
+	certificates    []*redfish.Certificate
+}
+
+// getSecureBootCertificates returns the databases of Secure Boot with their certificates, by database ID
+func getSecureBootCertificates(secureBoot *redfish.SecureBoot) (map[string]*secureBootDatabase, error) {
+	raw, err := getRawResource(secureBoot.GetClient(), secureBoot.ODataID)
+	if err != nil {
+		return nil, err
+	}
+	var links struct {
+		SecureBootDatabases redfishcommon.Link
+	}
+	if err := json.Unmarshal(raw, &links); err != nil {
+		return nil, err
+	}
+	if links.SecureBootDatabases == "" {
+		return nil, errors.New("Secure Boot does not provide key databases")
+	}
+
+	databases, err := redfish.ListReferencedSecureBootDatabases(secureBoot.GetClient(), links.SecureBootDatabases.String())
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*secureBootDatabase, len(databases))
+	for _, database := range databases {
+		raw, err := getRawResource(database.GetClient(), database.ODataID)
+		if err != nil {
+			return nil, err
+		}
+		var databaseLinks struct {
+			Certificates redfishcommon.Link
+		}
+		if err := json.Unmarshal(raw, &databaseLinks); err != nil {
+			return nil, err
+		}
+		certificates, err := database.Certificates()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch the certificates of the database %s: %w", database.DatabaseID, err)
+		}
+		databaseID := database.DatabaseID
+		if databaseID == "" {
+			databaseID = database.ID
+		}
+		result[databaseID] = &secureBootDatabase{
+			SecureBootDatabase: database,
+			certificatesURI:    databaseLinks.Certificates.String(),
+			certificates:       certificates,
+		}
+	}
+	return result, nil
+}
+
+// updateSecureBootCertificates deletes the certificates removed from the plan and enrolls the certificates of the plan
+// which are not enrolled yet
+func updateSecureBootCertificates(ctx context.Context, secureBoot *redfish.SecureBoot, planned, previous []models.SecureBootCertificate) error {
+	enrolled, err := getSecureBootCertificates(secureBoot)
+	if err != nil {
+		return err
+	}
+	client := secureBoot.GetClient()
+
+	for _, certificate := range previous {
+		if containsSecureBootCertificate(planned, certificate) {
+			continue
+		}
+		database := enrolled[certificate.DatabaseID.ValueString()]
+		if database == nil {
+			continue
+		}
+		if found := findSecureBootCertificate(database.certificates, certificate.CertificateString.ValueString()); found != nil {
+			tflog.Info(ctx, "Deleting the certificate "+found.ODataID)
+			resp, err := client.Delete(found.ODataID)
+			if err != nil {
+				return fmt.Errorf("unable to delete the certificate %s: %w", found.ODataID, err)
+			}
+			_ = resp.Body.Close()
+		}
+	}
+
+	for _, certificate := range planned {
+		databaseID := certificate.DatabaseID.ValueString()
+		database := enrolled[databaseID]
+		if database == nil || database.certificatesURI == "" {
+			return fmt.Errorf("the Secure Boot database %s does not provide certificates", databaseID)
+		}
+		if findSecureBootCertificate(database.certificates, certificate.CertificateString.ValueString()) != nil {
+			continue
+		}
+		tflog.Info(ctx, "Enrolling a certificate in the Secure Boot database "+databaseID)
+		payload := map[string]string{
+			certificateString: certificate.CertificateString.ValueString(),
+			certificateType:   pem,
+		}
+		resp, err := client.Post(database.certificatesURI, payload)
+		if err != nil {
+			return fmt.Errorf("unable to enroll the certificate in the database %s: %w", databaseID, err)
+		}
+		_ = resp.Body.Close()
+	}
+	return nil
+}
+
+// secureBootPatch returns the properties of Secure Boot which differ from the plan
+func secureBootPatch(plan *models.SecureBoot, secureBoot *redfish.SecureBoot) map[string]interface{} {
+	payload := make(map[string]interface{})
+	if !plan.SecureBootEnable.IsNull() && !plan.SecureBootEnable.IsUnknown() &&
+		plan.SecureBootEnable.ValueBool() != secureBoot.SecureBootEnable {
+		payload["SecureBootEnable"] = plan.SecureBootEnable.ValueBool()
+	}
+	if !plan.SecureBootMode.IsNull() && !plan.SecureBootMode.IsUnknown() &&
+		plan.SecureBootMode.ValueString() != string(secureBoot.SecureBootMode) {
+		payload["SecureBootMode"] = plan.SecureBootMode.ValueString()
+	}
+	return payload
+}
+
+// patchSecureBoot patches the properties of Secure Boot
+func patchSecureBoot(secureBoot *redfish.SecureBoot, payload map[string]interface{}) error {
+	resp, err := secureBoot.GetClient().Patch(secureBoot.ODataID, payload)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// containsSecureBootCertificate returns whether the certificates contain the certificate in the same database
+func containsSecureBootCertificate(certificates []models.SecureBootCertificate, certificate models.SecureBootCertificate) bool {
+	for _, c := range certificates {
+		if c.DatabaseID.Equal(certificate.DatabaseID) &&
+			secureBootCertificateDER(c.CertificateString.ValueString()) == secureBootCertificateDER(certificate.CertificateString.ValueString()) {
+			return true
+		}
+	}
+	return false
+}
+
+// findSecureBootCertificate returns the enrolled certificate matching the PEM certificate. The certificates are compared
+// by their DER content, or by their fingerprint when the server does not return the content of the certificates.
+func findSecureBootCertificate(certificates []*redfish.Certificate, certificate string) *redfish.Certificate {
+	der := secureBootCertificateDER(certificate)
+	for _, enrolled := range certificates {
+		if enrolled.CertificateString != "" {
+			if secureBootCertificateDER(enrolled.CertificateString) == der {
+				return enrolled
+			}
+			continue
+		}
+		if enrolled.Fingerprint != "" && secureBootFingerprint(der, enrolled.FingerprintHashAlgorithm) == normalizeFingerprint(enrolled.Fingerprint) {
+			return enrolled
+		}
+	}
+	return nil
+}
+
+// secureBootCertificateDER returns the DER content of a PEM certificate, or the trimmed certificate when it is not PEM
+func secureBootCertificateDER(certificate string) string {
+	block, _ := pemencoding.Decode([]byte(strings.TrimSpace(certificate)))
+	if block == nil {
+		return strings.TrimSpace(certificate)
+	}
+	return string(block.Bytes)
+}
+
+// secureBootFingerprint returns the fingerprint of the DER content of a certificate with the hash algorithm
+// reported by the server, SHA-256 by default
+func secureBootFingerprint(der, algorithm string) string {
+	if strings.Contains(strings.ToUpper(algorithm), "SHA1") {
+		sum := sha1.Sum([]byte(der)) // #nosec G401
+		return hex.EncodeToString(sum[:])
+	}
+	sum := sha256.Sum256([]byte(der))
+	return hex.EncodeToString(sum[:])
+}
+
+// checkSecureBootCertificate checks that the certificate is a PEM encoded X.509 certificate
+func checkSecureBootCertificate(certificate string) error {
+	block, _ := pemencoding.Decode([]byte(strings.TrimSpace(certificate)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.New("the certificate is not a PEM encoded certificate")
+	}
+	_, err := x509.ParseCertificate(block.Bytes)
+	return err
+}
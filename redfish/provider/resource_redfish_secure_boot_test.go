@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	pemencoding "encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to enroll a certificate in the db database and to remove it - Positive
+func TestAccRedfishSecureBoot_Certificates(t *testing.T) {
+	certificate := testSecureBootCertificate(t, "Kernel Signing Key")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSecureBootConfig(creds, fmt.Sprintf(`
+				certificates = [
+				  {
+				    database_id        = "db"
+				    certificate_string = <<-EOT
+%sEOT
+				  }
+				]
+				`, certificate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_secure_boot.secure_boot", "certificates.#", "1"),
+					resource.TestCheckResourceAttrSet("redfish_secure_boot.secure_boot", "secure_boot_mode"),
+					resource.TestCheckResourceAttrSet("redfish_secure_boot.secure_boot", "secure_boot_enable"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceSecureBootConfig(creds, ""),
+				Check:  resource.TestCheckNoResourceAttr("redfish_secure_boot.secure_boot", "certificates.#"),
+			},
+		},
+	})
+}
+
+// Test to import the secure boot resource - Positive
+func TestAccRedfishSecureBoot_Import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccRedfishResourceSecureBootConfig(creds, ""),
+				ResourceName:  "redfish_secure_boot.secure_boot",
+				ImportState:   true,
+				ImportStateId: "{\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true,\"system_id\":\"System.Embedded.1\"}",
+				ExpectError:   nil,
+			},
+		},
+	})
+}
+
+// Test the secure boot resource with an invalid configuration - Negative
+func TestAccRedfishSecureBoot_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSecureBootConfig(creds, `
+				certificates = [
+				  {
+				    database_id        = "db"
+				    certificate_string = "invalid"
+				  }
+				]
+				`),
+				ExpectError: regexp.MustCompile("Invalid Secure Boot certificate"),
+			},
+			{
+				Config: testAccRedfishResourceSecureBootConfig(creds, `
+				secure_boot_mode = "CustomMode"
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+// Test to reset the secure boot keys with a mocked error - Negative
+func TestAccRedfishSecureBoot_ResetKeysMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock((*redfish.SecureBoot).ResetKeys).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config: testAccRedfishResourceSecureBootConfig(creds, `
+				reset_keys = "ResetAllKeysToDefault"
+				`),
+				ExpectError: regexp.MustCompile("Error resetting the Secure Boot keys"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestFindSecureBootCertificate(t *testing.T) {
+	certificate := testSecureBootCertificate(t, "db")
+	other := testSecureBootCertificate(t, "other")
+	block, _ := pemencoding.Decode([]byte(certificate))
+	sum := sha256.Sum256(block.Bytes)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	enrolled := []*redfish.Certificate{
+		{CertificateString: other},
+		// the same certificate with CRLF line endings
+		{CertificateString: strings.ReplaceAll(certificate, "\n", "\r\n")},
+	}
+	if found := findSecureBootCertificate(enrolled, certificate); found != enrolled[1] {
+		t.Errorf("Expected the certificate to be found by its content, got %v", found)
+	}
+
+	byFingerprint := []*redfish.Certificate{{Fingerprint: fingerprint, FingerprintHashAlgorithm: "TPM_ALG_SHA256"}}
+	if found := findSecureBootCertificate(byFingerprint, certificate); found != byFingerprint[0] {
+		t.Errorf("Expected the certificate to be found by its fingerprint, got %v", found)
+	}
+	if found := findSecureBootCertificate(byFingerprint, other); found != nil {
+		t.Errorf("Expected no certificate to be found, got %v", found)
+	}
+}
+
+func TestCheckSecureBootCertificate(t *testing.T) {
+	if err := checkSecureBootCertificate(testSecureBootCertificate(t, "db")); err != nil {
+		t.Errorf("Expected a valid certificate, got %v", err)
+	}
+	if err := checkSecureBootCertificate("invalid"); err == nil {
+		t.Error("Expected an error for a certificate which is not PEM encoded")
+	}
+	if err := checkSecureBootCertificate("-----BEGIN CERTIFICATE-----\nYWJj\n-----END CERTIFICATE-----\n"); err == nil {
+		t.Error("Expected an error for a certificate which is not X.509")
+	}
+}
+
+func TestSecureBootPatch(t *testing.T) {
+	secureBoot := &redfish.SecureBoot{SecureBootEnable: false, SecureBootMode: redfish.SetupModeSecureBootModeType}
+
+	payload := secureBootPatch(&models.SecureBoot{
+		SecureBootEnable: types.BoolValue(true),
+		SecureBootMode:   types.StringValue(string(redfish.SetupModeSecureBootModeType)),
+	}, secureBoot)
+	if len(payload) != 1 || payload["SecureBootEnable"] != true {
+		t.Errorf("Expected only SecureBootEnable to be patched, got %v", payload)
+	}
+
+	payload = secureBootPatch(&models.SecureBoot{
+		SecureBootEnable: types.BoolUnknown(),
+		SecureBootMode:   types.StringValue(string(redfish.DeployedModeSecureBootModeType)),
+	}, secureBoot)
+	if len(payload) != 1 || payload["SecureBootMode"] != string(redfish.DeployedModeSecureBootModeType) {
+		t.Errorf("Expected only SecureBootMode to be patched, got %v", payload)
+	}
+}
+
+// testSecureBootCertificate returns a self-signed PEM certificate with the common name
+func testSecureBootCertificate(t *testing.T, commonName string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate a key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create a certificate: %v", err)
+	}
+	return string(pemencoding.EncodeToMemory(&pemencoding.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccRedfishResourceSecureBootConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_secure_boot" "secure_boot" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
 	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -95,6 +96,14 @@ func (r *RedfishStorageControllerResource) Create(ctx context.Context, req resou
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	// update
 	diags = updateRedfishStorageController(ctx, service, &emptyState, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -188,6 +197,14 @@ func (r *RedfishStorageControllerResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	// update
 	diags = updateRedfishStorageController(ctx, service, &state, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -274,7 +291,15 @@ func readRedfishStorageController(ctx context.Context, service *gofish.Service,
 		return diags
 	}
 
-	storageControllerExtended, err := dell.StorageController(storageController)
+	// the settings which are staged but not applied yet are read with their pending value
+	controller := &redfish.StorageController{}
+	if err = decodeWithPendingSettings(ctx, service.GetClient(), storageController.ODataID, controller); err != nil {
+		diags.AddError("Error when retrieving storage controller", err.Error())
+		return diags
+	}
+	controller.SetClient(service.GetClient())
+
+	storageControllerExtended, err := dell.StorageController(controller)
 	if err != nil {
 		diags.AddError("Error when retrieving storage controller extended", err.Error())
 		return diags
@@ -289,6 +314,8 @@ func readRedfishStorageController(ctx context.Context, service *gofish.Service,
 	if diags.HasError() {
 		return diags
 	}
+	pending := readPendingSettings(ctx, service, raidConfigurationJobType, storageController.ODataID)
+	state.PendingAttributes, state.PendingJobID = pending.values()
 
 	state.ID = types.StringValue("redfish_storage_controller_resource")
 	state.SystemID = types.StringValue(system.ID)
@@ -329,10 +356,6 @@ func updateRedfishStorageController(ctx context.Context, service *gofish.Service
 		jobWait = false
 	}
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
-
 	// OnReset case
 	if applyTime == string(redfishcommon.OnResetApplyTime) {
 		// Reboot the server
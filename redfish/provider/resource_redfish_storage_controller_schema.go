@@ -41,6 +41,8 @@ func StorageControllerResourceSchema() map[string]schema.Attribute {
 			Description:         "ID of the storage controller resource",
 			Computed:            true,
 		},
+		"pending_attributes": pendingAttributesSchema(),
+		"pending_job_id":     pendingJobIDSchema(),
 		"storage_id": schema.StringAttribute{
 			MarkdownDescription: "ID of the storage",
 			Description:         "ID of the storage",
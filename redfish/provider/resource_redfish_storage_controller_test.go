@@ -18,13 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-redfish/gofish/dell"
 	"testing"
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 var storageControllerParams testingStorageControllerInputs
@@ -1649,3 +1652,25 @@ func testAccRedfishResourceStorageControllerUpdateControllerMode(testingInfo tes
 		controllerMode,
 	)
 }
+
+func TestReadStorageControllerPendingSettings(t *testing.T) {
+	uri := "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Controllers/RAID.Integrated.1-1"
+	resource := `{"@odata.id": "` + uri + `", "Id": "RAID.Integrated.1-1",
+		"@Redfish.Settings": {"SettingsObject": {"@odata.id": "` + uri + `/Settings"}},
+		"Oem": {"Dell": {"DellStorageController": {"ControllerMode": "RAID", "PatrolReadMode": "Automatic"}}}}`
+	settings := `{"@odata.id": "` + uri + `/Settings", "Id": "Settings", "Oem": {"Dell": {"DellStorageController": {"ControllerMode": "HBA"}}}}`
+
+	controller := &redfish.StorageController{}
+	if err := decodeWithPendingSettings(context.Background(), testPendingSettingsClient(resource, settings), uri, controller); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	storageControllerExtended, err := dell.StorageController(controller)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// the staged settings are read with their pending value, the other ones with their current value
+	dellController := storageControllerExtended.Oem.Dell.DellStorageController
+	if controller.ID != "RAID.Integrated.1-1" || dellController.ControllerMode != "HBA" || dellController.PatrolReadMode != "Automatic" {
+		t.Errorf("Expected the pending values of the storage controller, got %+v", dellController)
+	}
+}
@@ -306,6 +306,14 @@ func (r *RedfishStorageVolumeResource) Create(ctx context.Context, req resource.
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	diags = createRedfishStorageVolume(ctx, service, &plan)
 	resp.Diagnostics.Append(diags...)
 
@@ -385,6 +393,14 @@ func (r *RedfishStorageVolumeResource) Update(ctx context.Context, req resource.
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	diags = updateRedfishStorageVolume(ctx, service, &plan, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -416,6 +432,14 @@ func (r *RedfishStorageVolumeResource) Delete(ctx context.Context, req resource.
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid race conditions with other resources
+	unlock, err := lockRedfishServer(ctx, r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
 	diags = deleteRedfishStorageVolume(ctx, service, &state)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -470,9 +494,6 @@ func (*RedfishStorageVolumeResource) ImportState(ctx context.Context, req resour
 // nolint: revive
 func createRedfishStorageVolume(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume) diag.Diagnostics {
 	var diags diag.Diagnostics
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
 
 	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
 	if err != nil {
@@ -653,10 +674,6 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
-
 	// Get user config
 	storageID := d.StorageControllerID.ValueString()
 	volumeName := d.VolumeName.ValueString()
@@ -754,10 +771,6 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 func deleteRedfishStorageVolume(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
-
 	// Get vars from schema
 	applyTime := d.SettingsApplyTime.ValueString()
 	volumeJobTimeout := d.VolumeJobTimeout.ValueInt64()
@@ -0,0 +1,437 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// tpmSecurityAttribute is the BIOS attribute enabling the TPM
+	tpmSecurityAttribute = "TpmSecurity"
+	// tpm2HierarchyAttribute is the BIOS attribute enabling, disabling or clearing the TPM 2.0 hierarchy
+	tpm2HierarchyAttribute = "Tpm2Hierarchy"
+	// tpmPpiBypassClearAttribute is the BIOS attribute allowing to clear the TPM without physical presence
+	tpmPpiBypassClearAttribute = "TpmPpiBypassClear"
+	// tpm2HierarchyClear is the value of the Tpm2Hierarchy attribute clearing the TPM
+	tpm2HierarchyClear = "Clear"
+)
+
+// tpmAttributes maps the attributes of the resource to their BIOS attributes
+var tpmAttributes = []struct {
+	name      string
+	attribute string
+	value     func(*models.TPM) *types.String
+}{
+	{"tpm_security", tpmSecurityAttribute, func(m *models.TPM) *types.String { return &m.TpmSecurity }},
+	{"tpm2_hierarchy", tpm2HierarchyAttribute, func(m *models.TPM) *types.String { return &m.Tpm2Hierarchy }},
+	{"tpm_ppi_bypass_clear", tpmPpiBypassClearAttribute, func(m *models.TPM) *types.String { return &m.TpmPpiBypassClear }},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &TPMResource{}
+	_ resource.ResourceWithConfigure      = &TPMResource{}
+	_ resource.ResourceWithModifyPlan     = &TPMResource{}
+	_ resource.ResourceWithValidateConfig = &TPMResource{}
+)
+
+// NewTPMResource is a helper function to simplify the provider implementation.
+func NewTPMResource() resource.Resource {
+	return &TPMResource{}
+}
+
+// TPMResource is the resource implementation.
+type TPMResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *TPMResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata returns the resource type name.
+func (*TPMResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "tpm"
+}
+
+// Schema defines the schema for the resource.
+func (*TPMResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to activate and clear the TPM of the iDRAC Server through the BIOS attributes" +
+			" `TpmSecurity`, `Tpm2Hierarchy` and `TpmPpiBypassClear`. The attributes are validated against the BIOS attribute registry" +
+			" and applied with a BIOS configuration job and a reboot of the server.",
+		Description: "This Terraform resource is used to activate and clear the TPM of the iDRAC Server through the BIOS attributes" +
+			" TpmSecurity, Tpm2Hierarchy and TpmPpiBypassClear. The attributes are validated against the BIOS attribute registry" +
+			" and applied with a BIOS configuration job and a reboot of the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resource.",
+				Description:         "The ID of the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "System ID of the system",
+				Description:         "System ID of the system",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"tpm_security": schema.StringAttribute{
+				MarkdownDescription: "The value of the `TpmSecurity` BIOS attribute, which activates the TPM, such as `On` or `Off`." +
+					" The accepted values are defined by the BIOS attribute registry of the server.",
+				Description: "The value of the TpmSecurity BIOS attribute, which activates the TPM, such as On or Off." +
+					" The accepted values are defined by the BIOS attribute registry of the server.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tpm2_hierarchy": schema.StringAttribute{
+				MarkdownDescription: "The value of the `Tpm2Hierarchy` BIOS attribute, which enables the TPM 2.0 hierarchy." +
+					" Accepted values: `Enabled`, `Disabled`. Use `clear_version` to clear the TPM.",
+				Description: "The value of the Tpm2Hierarchy BIOS attribute, which enables the TPM 2.0 hierarchy." +
+					" Accepted values: Enabled, Disabled. Use clear_version to clear the TPM.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tpm_ppi_bypass_clear": schema.StringAttribute{
+				MarkdownDescription: "The value of the `TpmPpiBypassClear` BIOS attribute, which allows to clear the TPM without" +
+					" a physical presence confirmation at the next boot. Accepted values: `Enabled`, `Disabled`.",
+				Description: "The value of the TpmPpiBypassClear BIOS attribute, which allows to clear the TPM without" +
+					" a physical presence confirmation at the next boot. Accepted values: Enabled, Disabled.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clear_version": schema.Int64Attribute{
+				MarkdownDescription: "Clears the TPM, by setting `Tpm2Hierarchy` to `Clear`, when the resource is created with" +
+					" a version or when the version changes. The owner of the TPM and its keys are removed.",
+				Description: "Clears the TPM, by setting Tpm2Hierarchy to Clear, when the resource is created with" +
+					" a version or when the version changes. The owner of the TPM and its keys are removed.",
+				Optional: true,
+			},
+			"reset_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Reset type to apply on the computer system after the BIOS settings are applied. " +
+					"Applicable values are 'ForceRestart', " +
+					"'GracefulRestart', and 'PowerCycle'." +
+					"Default = \"GracefulRestart\". ",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						string(redfish.ForceRestartResetType),
+						string(redfish.GracefulRestartResetType),
+						string(redfish.PowerCycleResetType),
+					}...),
+				},
+				Computed: true,
+				Default:  stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			},
+			"reset_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "reset_timeout is the time in seconds that the provider waits for the server to be reset before timing out.",
+				Default:     int64default.StaticInt64(int64(defaultBiosConfigServerResetTimeout)),
+				Computed:    true,
+			},
+			"bios_job_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "bios_job_timeout is the time in seconds that the provider waits for the bios update job to be" +
+					" completed before timing out.",
+				Default:  int64default.StaticInt64(int64(defaultBiosConfigJobTimeout)),
+				Computed: true,
+			},
+		},
+		Blocks: RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig checks that the TPM hierarchy is not disabled when the TPM is cleared
+func (*TPMResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.TPM
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.ClearVersion.IsNull() && config.Tpm2Hierarchy.ValueString() == "Disabled" {
+		resp.Diagnostics.AddAttributeError(tfpath.Root("clear_version"), "Invalid Attribute Combination",
+			"the TPM can not be cleared when tpm2_hierarchy is Disabled")
+	}
+}
+
+// ModifyPlan validates the TPM attributes of the plan against the BIOS attribute registry of the server
+func (r *TPMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.p == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, config models.TPM
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state models.TPM
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		// the hierarchy is enabled again once the TPM is cleared
+		if !plan.ClearVersion.Equal(state.ClearVersion) && config.Tpm2Hierarchy.IsNull() {
+			plan.Tpm2Hierarchy = types.StringUnknown()
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		}
+	}
+
+	biosPlan := tpmBiosPlan(&plan, false)
+	if resp.Diagnostics.HasError() || !biosPlanKnown(biosPlan) {
+		return
+	}
+
+	unlock, err := rLockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
+
+	// the server may not be reachable yet, the attributes are then validated when they are applied
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate the TPM attributes", err.Error())
+		return
+	}
+	defer api.Logout()
+
+	_, _, diags := getBiosAttrsPatch(ctx, api.Service, &biosPlan)
+	resp.Diagnostics.Append(tpmDiagnostics(diags)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TPMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_tpm create : Started")
+	var plan models.TPM
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	resp.Diagnostics.Append(r.updateTPM(ctx, api.Service, &plan, !plan.ClearVersion.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_tpm create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TPMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_tpm read: started")
+	var state models.TPM
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	if err := readTPM(api.Service, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading the TPM attributes", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_tpm read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TPMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_tpm update: started")
+	var plan, state models.TPM
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	defer api.Logout()
+
+	clear := !plan.ClearVersion.IsNull() && !plan.ClearVersion.Equal(state.ClearVersion)
+	resp.Diagnostics.Append(r.updateTPM(ctx, api.Service, &plan, clear)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_tpm update: finished")
+}
+
+// Delete removes the resource from the Terraform state. The TPM attributes are left as is.
+func (*TPMResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_tpm delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_tpm delete: finished")
+}
+
+// updateTPM applies the TPM attributes of the plan with the reboot and job flow of the BIOS resource,
+// and reads the applied attributes
+func (r *TPMResource) updateTPM(ctx context.Context, service *gofish.Service, plan *models.TPM, clear bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	biosPlan := tpmBiosPlan(plan, clear)
+	if clear {
+		tflog.Info(ctx, "Clearing the TPM")
+	}
+	biosState, biosDiags := (&BiosResource{p: r.p}).updateRedfishDellBiosAttributes(ctx, service, &biosPlan)
+	diags.Append(tpmDiagnostics(biosDiags)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.SystemID = biosState.SystemID
+	if err := readTPM(service, plan); err != nil {
+		diags.AddError("Error reading the TPM attributes", err.Error())
+	}
+	return diags
+}
+
+// readTPM reads the TPM attributes from the BIOS attributes of the system
+func readTPM(service *gofish.Service, state *models.TPM) error {
+	system, err := getSystemResource(service, state.SystemID.ValueString())
+	if err != nil {
+		return err
+	}
+	bios, err := system.Bios()
+	if err != nil {
+		return err
+	}
+
+	state.ID = types.StringValue(bios.ODataID)
+	state.SystemID = types.StringValue(system.ID)
+	for _, attribute := range tpmAttributes {
+		value := attribute.value(state)
+		if current, ok := bios.Attributes[attribute.attribute]; ok {
+			*value = types.StringValue(biosAttributeValueString(current))
+		} else if value.IsUnknown() {
+			*value = types.StringNull()
+		}
+	}
+	return nil
+}
+
+// tpmBiosPlan returns the plan of the BIOS resource applying the known TPM attributes of the plan.
+// The TPM is cleared by setting Tpm2Hierarchy to Clear.
+func tpmBiosPlan(plan *models.TPM, clear bool) models.Bios {
+	attributes := make(map[string]attr.Value)
+	for _, attribute := range tpmAttributes {
+		// the attributes which are not configured are left as is
+		value := attribute.value(plan)
+		if !value.IsNull() && !value.IsUnknown() {
+			attributes[attribute.attribute] = *value
+		}
+	}
+	if clear {
+		attributes[tpm2HierarchyAttribute] = types.StringValue(tpm2HierarchyClear)
+	}
+
+	return models.Bios{
+		Attributes:        types.MapValueMust(types.StringType, attributes),
+		RedfishServer:     plan.RedfishServer,
+		SettingsApplyTime: types.StringValue(string(redfishcommon.OnResetApplyTime)),
+		StageOnly:         types.BoolValue(false),
+		ResetType:         plan.ResetType,
+		ResetTimeout:      plan.ResetTimeout,
+		JobTimeout:        plan.JobTimeout,
+		SystemID:          plan.SystemID,
+	}
+}
+
+// tpmDiagnostics maps the diagnostics of the BIOS attributes to the attributes of the resource
+func tpmDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	var mapped diag.Diagnostics
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			mapped.Append(d)
+			continue
+		}
+		for _, attribute := range tpmAttributes {
+			if withPath.Path().Equal(tfpath.Root("attributes").AtMapKey(attribute.attribute)) {
+				withPath = diag.WithPath(tfpath.Root(attribute.name), d)
+				break
+			}
+		}
+		mapped.Append(withPath)
+	}
+	return mapped
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to activate the TPM and to clear it - Positive
+func TestAccRedfishTPM_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceTPMConfig(creds, `
+				tpm_security   = "On"
+				tpm2_hierarchy = "Enabled"
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_tpm.tpm", "tpm_security", "On"),
+					resource.TestCheckResourceAttr("redfish_tpm.tpm", "tpm2_hierarchy", "Enabled"),
+					resource.TestCheckResourceAttrSet("redfish_tpm.tpm", "tpm_ppi_bypass_clear"),
+					resource.TestCheckResourceAttrSet("redfish_tpm.tpm", "system_id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceTPMConfig(creds, `
+				tpm_security         = "On"
+				tpm2_hierarchy       = "Enabled"
+				tpm_ppi_bypass_clear = "Enabled"
+				clear_version        = 1
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_tpm.tpm", "tpm2_hierarchy", "Enabled"),
+					resource.TestCheckResourceAttr("redfish_tpm.tpm", "clear_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Test the TPM resource with an invalid configuration - Negative
+func TestAccRedfishTPM_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceTPMConfig(creds, `
+				tpm_security = "Invalid"
+				`),
+				ExpectError: regexp.MustCompile("Invalid BIOS attribute"),
+			},
+			{
+				Config: testAccRedfishResourceTPMConfig(creds, `
+				tpm2_hierarchy = "Disabled"
+				clear_version  = 1
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: testAccRedfishResourceTPMConfig(creds, `
+				tpm2_hierarchy = "Clear"
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestTpmBiosPlan(t *testing.T) {
+	plan := &models.TPM{
+		TpmSecurity:       types.StringValue("On"),
+		Tpm2Hierarchy:     types.StringUnknown(),
+		TpmPpiBypassClear: types.StringNull(),
+		SystemID:          types.StringValue("System.Embedded.1"),
+	}
+
+	attributes := map[string]string{}
+	biosPlan := tpmBiosPlan(plan, false)
+	if diags := biosPlan.Attributes.ElementsAs(context.Background(), &attributes, true); diags.HasError() {
+		t.Fatalf("unable to read the BIOS attributes: %v", diags)
+	}
+	if len(attributes) != 1 || attributes[tpmSecurityAttribute] != "On" {
+		t.Errorf("Expected only the TPM security attribute, got %v", attributes)
+	}
+
+	biosPlan = tpmBiosPlan(plan, true)
+	attributes = map[string]string{}
+	if diags := biosPlan.Attributes.ElementsAs(context.Background(), &attributes, true); diags.HasError() {
+		t.Fatalf("unable to read the BIOS attributes: %v", diags)
+	}
+	if attributes[tpm2HierarchyAttribute] != tpm2HierarchyClear {
+		t.Errorf("Expected the hierarchy to be cleared, got %v", attributes)
+	}
+	if biosPlan.SettingsApplyTime.ValueString() != "OnReset" || biosPlan.SystemID.ValueString() != "System.Embedded.1" {
+		t.Errorf("Unexpected BIOS plan: %+v", biosPlan)
+	}
+}
+
+func TestTpmDiagnostics(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddAttributeError(tfpath.Root("attributes").AtMapKey(tpmSecurityAttribute), "Invalid BIOS attribute", "invalid value")
+	diags.AddError("error fetching bios resource", "not found")
+
+	mapped := tpmDiagnostics(diags)
+	if len(mapped) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got %d", len(mapped))
+	}
+	withPath, ok := mapped[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(tfpath.Root("tpm_security")) {
+		t.Errorf("Expected the diagnostic to be mapped to tpm_security, got %v", mapped[0])
+	}
+	if mapped[1].Summary() != "error fetching bios resource" {
+		t.Errorf("Expected the diagnostic without path to be kept, got %v", mapped[1])
+	}
+}
+
+func testAccRedfishResourceTPMConfig(testingInfo TestingServerCredentials, args string) string {
+	return fmt.Sprintf(`
+		resource "redfish_tpm" "tpm" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		args,
+	)
+}
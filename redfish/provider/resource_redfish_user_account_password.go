@@ -144,8 +144,12 @@ func (r *UserAccountPasswordResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(redfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(redfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, redfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	api, err := NewConfig(r.p, &redfishServer)
 	if err != nil {
@@ -174,8 +174,12 @@ func (r *virtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 		WriteProtected:       plan.WriteProtected.ValueBool(),
 	}
 
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	api, err := NewConfig(r.p, &plan.RedfishServer)
 	if err != nil {
@@ -382,8 +386,12 @@ func (r *virtualMediaResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	// Get service
 	api, err := NewConfig(r.p, &plan.RedfishServer)
@@ -466,8 +474,12 @@ func (r *virtualMediaResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+	unlock, err := lockRedfishServer(ctx, r.p, state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(lockServerErrorMsg, err.Error())
+		return
+	}
+	defer unlock()
 
 	// Get service
 	api, err := NewConfig(r.p, &state.RedfishServer)
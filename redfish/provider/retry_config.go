@@ -2,20 +2,38 @@ package provider
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 )
 
+const (
+	// maxRetryIntervalLimit is the upper bound for any wait between two attempts
+	maxRetryIntervalLimit = 300 * time.Second
+)
+
 // RetryConfig defines retry behavior configuration
 type RetryConfig struct {
 	// MaxRetries is the maximum number of retry attempts
 	MaxRetries int
 
-	// RetryInterval is the duration to wait between retries
+	// RetryInterval is the duration to wait between retries, or the base interval with exponential backoff
 	RetryInterval time.Duration
 
+	// MaxRetryInterval caps the wait between retries when exponential backoff is enabled, zero means no cap
+	MaxRetryInterval time.Duration
+
+	// ExponentialBackoff doubles the wait after every failed attempt
+	ExponentialBackoff bool
+
+	// Jitter randomizes the wait between half and the full computed interval
+	Jitter bool
+
 	// RetryableStatusCodes are HTTP status codes that trigger retry
 	RetryableStatusCodes []int
 
+	// RetryNonIdempotent allows non-idempotent requests (POST actions and PATCH updates) to be retried
+	RetryNonIdempotent bool
+
 	// EnableLogging enables detailed retry logging
 	EnableLogging bool
 
@@ -45,13 +63,66 @@ func (c *RetryConfig) Validate() error {
 	if c.RetryInterval < 0 {
 		return fmt.Errorf("retry_interval must be non-negative, got %v", c.RetryInterval)
 	}
-	if c.RetryInterval > 300*time.Second {
+	if c.RetryInterval > maxRetryIntervalLimit {
 		return fmt.Errorf("retry_interval must be <= 300 seconds, got %v", c.RetryInterval)
 	}
+	if c.MaxRetryInterval < 0 {
+		return fmt.Errorf("max_retry_interval must be non-negative, got %v", c.MaxRetryInterval)
+	}
+	if c.MaxRetryInterval > maxRetryIntervalLimit {
+		return fmt.Errorf("max_retry_interval must be <= 300 seconds, got %v", c.MaxRetryInterval)
+	}
+	if c.MaxRetryInterval > 0 && c.MaxRetryInterval < c.RetryInterval {
+		return fmt.Errorf("max_retry_interval (%v) must be greater than or equal to retry_interval (%v)", c.MaxRetryInterval, c.RetryInterval)
+	}
+	for _, code := range c.RetryableStatusCodes {
+		if code < 400 || code > 599 {
+			return fmt.Errorf("retryable_status_codes must be HTTP error codes between 400 and 599, got %d", code)
+		}
+	}
 	return nil
 }
 
+// Backoff returns the wait before the given retry attempt (starting at 1), without jitter
+func (c *RetryConfig) Backoff(attempt int) time.Duration {
+	interval := c.RetryInterval
+	if c.ExponentialBackoff {
+		for i := 1; i < attempt; i++ {
+			interval *= 2
+			if interval >= maxRetryIntervalLimit {
+				interval = maxRetryIntervalLimit
+				break
+			}
+		}
+	}
+	if c.MaxRetryInterval > 0 && interval > c.MaxRetryInterval {
+		interval = c.MaxRetryInterval
+	}
+	return interval
+}
+
+// waitInterval returns the wait before the given retry attempt, with jitter applied when enabled
+func (c *RetryConfig) waitInterval(attempt int) time.Duration {
+	interval := c.Backoff(attempt)
+	if c.Jitter && interval > 1 {
+		half := interval / 2
+		// #nosec G404 -- jitter does not need a cryptographically secure source
+		interval = half + time.Duration(rand.Int63n(int64(interval-half)+1))
+	}
+	return interval
+}
+
 // TotalTimeout returns the maximum time that retries could take
 func (c *RetryConfig) TotalTimeout() time.Duration {
-	return time.Duration(c.MaxRetries) * c.RetryInterval
+	var total time.Duration
+	for attempt := 1; attempt <= c.MaxRetries; attempt++ {
+		total += c.Backoff(attempt)
+	}
+	return total
+}
+
+// key returns a string identifying the configuration, used to share transports between endpoints
+func (c *RetryConfig) key() string {
+	return fmt.Sprintf("%d|%s|%s|%t|%t|%v|%t", c.MaxRetries, c.RetryInterval, c.MaxRetryInterval,
+		c.ExponentialBackoff, c.Jitter, c.RetryableStatusCodes, c.RetryNonIdempotent)
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -45,7 +47,14 @@ func (t *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, error
 		req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 	}
 
-	for attempt := 0; attempt <= t.config.MaxRetries; attempt++ {
+	// Non-idempotent requests might have been processed by the BMC even when they failed,
+	// so they are sent only once unless retrying them is explicitly allowed
+	maxRetries := t.config.MaxRetries
+	if !t.config.RetryNonIdempotent && !isIdempotent(req.Method) {
+		maxRetries = 0
+	}
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
 		// Clone request for retry (restore body)
 		reqClone := req.Clone(ctx)
 		if bodyBytes != nil {
@@ -56,10 +65,12 @@ func (t *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, error
 		resp, lastErr = t.transport.RoundTrip(reqClone)
 
 		// Check if successful
-		if lastErr == nil && !t.shouldRetry(resp.StatusCode) {
+		if lastErr == nil && (!t.shouldRetry(resp.StatusCode) || maxRetries == 0) {
 			return resp, nil
 		}
 
+		var retryAfter time.Duration
+
 		// Log the error
 		if lastErr != nil {
 			tflog.Warn(ctx, "HTTP request failed", map[string]any{
@@ -75,6 +86,7 @@ func (t *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, error
 				"method":      req.Method,
 				"url":         req.URL.String(),
 			})
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
 			// Drain and close response body to reuse connection
 			if resp.Body != nil {
 				io.Copy(io.Discard, resp.Body)
@@ -84,16 +96,22 @@ func (t *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, error
 		}
 
 		// Don't retry if we've exhausted attempts
-		if attempt >= t.config.MaxRetries {
+		if attempt >= maxRetries {
 			break
 		}
 
+		// Honor the Retry-After header when the BMC asks for a longer wait
+		wait := t.config.waitInterval(attempt + 1)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+
 		// Log retry attempt
-		t.logRetryAttempt(ctx, attempt+1, lastErr)
+		t.logRetryAttempt(ctx, attempt+1, wait, lastErr)
 
 		// Wait before retry
 		select {
-		case <-time.After(t.config.RetryInterval):
+		case <-time.After(wait):
 			// Continue to next retry
 		case <-ctx.Done():
 			return nil, ctx.Err()
@@ -102,7 +120,7 @@ func (t *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, error
 
 	// All retries exhausted
 	if lastErr != nil {
-		return nil, fmt.Errorf("request failed after %d retries: %w", t.config.MaxRetries, lastErr)
+		return nil, fmt.Errorf("request failed after %d retries: %w", maxRetries, lastErr)
 	}
 
 	return resp, nil
@@ -118,20 +136,54 @@ func (t *RetryableTransport) shouldRetry(statusCode int) bool {
 	return false
 }
 
+// isIdempotent reports whether a request with the given method can safely be sent more than once.
+// PATCH is not, since a partial update may be applied again or trigger a job on the BMC every time it is sent.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter returns the wait requested by a Retry-After header, given either
+// in seconds or as an HTTP date. Invalid or past values return zero.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = date.Sub(now)
+	}
+	if wait < 0 {
+		return 0
+	}
+	if wait > maxRetryIntervalLimit {
+		return maxRetryIntervalLimit
+	}
+	return wait
+}
+
 // logRetryAttempt logs information about a retry attempt
-func (t *RetryableTransport) logRetryAttempt(ctx context.Context, attempt int, err error) {
+func (t *RetryableTransport) logRetryAttempt(ctx context.Context, attempt int, wait time.Duration, err error) {
 	if !t.config.EnableLogging {
 		return
 	}
 
 	retriesRemaining := t.config.MaxRetries - attempt
-	timeRemaining := time.Duration(retriesRemaining) * t.config.RetryInterval
+	timeRemaining := wait
+	for next := attempt + 1; next <= t.config.MaxRetries; next++ {
+		timeRemaining += t.config.Backoff(next)
+	}
 
 	tflog.Info(ctx, "Retrying request", map[string]any{
 		"attempt":           attempt,
 		"max_retries":       t.config.MaxRetries,
 		"retries_remaining": retriesRemaining,
-		"retry_interval":    t.config.RetryInterval.String(),
+		"retry_interval":    wait.String(),
 		"time_remaining":    timeRemaining.String(),
 		"error":             err.Error(),
 	})
@@ -311,6 +311,33 @@ func TestRetryConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "max interval lower than interval",
+			config: RetryConfig{
+				MaxRetries:       15,
+				RetryInterval:    90 * time.Second,
+				MaxRetryInterval: 30 * time.Second,
+			},
+			wantErr: true,
+		},
+		{
+			name: "max interval too long",
+			config: RetryConfig{
+				MaxRetries:       15,
+				RetryInterval:    90 * time.Second,
+				MaxRetryInterval: 301 * time.Second,
+			},
+			wantErr: true,
+		},
+		{
+			name: "non error status code",
+			config: RetryConfig{
+				MaxRetries:           15,
+				RetryInterval:        90 * time.Second,
+				RetryableStatusCodes: []int{200},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -334,3 +361,177 @@ func TestRetryConfig_TotalTimeout(t *testing.T) {
 		t.Errorf("TotalTimeout() = %v, want %v", config.TotalTimeout(), expected)
 	}
 }
+
+func TestRetryableTransport_RetryAfter(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := RetryConfig{
+		MaxRetries:           3,
+		RetryInterval:        10 * time.Millisecond,
+		RetryableStatusCodes: []int{500, 503, 429},
+	}
+
+	client := &http.Client{Transport: NewRetryableTransport(http.DefaultTransport, config)}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected to wait for the Retry-After delay, waited %v", elapsed)
+	}
+}
+
+func TestRetryableTransport_NoRetryOnPost(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := RetryConfig{
+		MaxRetries:           3,
+		RetryInterval:        10 * time.Millisecond,
+		RetryableStatusCodes: []int{500, 503, 429},
+	}
+
+	client := &http.Client{Transport: NewRetryableTransport(http.DefaultTransport, config)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected 500, got %d", resp.StatusCode)
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt (no retry), got %d", attempts)
+	}
+}
+
+func TestRetryableTransport_NoRetryOnPatch(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := RetryConfig{
+		MaxRetries:           3,
+		RetryInterval:        10 * time.Millisecond,
+		RetryableStatusCodes: []int{500, 503, 429},
+	}
+
+	client := &http.Client{Transport: NewRetryableTransport(http.DefaultTransport, config)}
+	req, err := http.NewRequest(http.MethodPatch, server.URL, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected 500, got %d", resp.StatusCode)
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt (no retry), got %d", attempts)
+	}
+}
+
+func TestRetryableTransport_RetryNonIdempotent(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := RetryConfig{
+		MaxRetries:           3,
+		RetryInterval:        10 * time.Millisecond,
+		RetryableStatusCodes: []int{500, 503, 429},
+		RetryNonIdempotent:   true,
+	}
+
+	client := &http.Client{Transport: NewRetryableTransport(http.DefaultTransport, config)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	config := RetryConfig{
+		MaxRetries:         6,
+		RetryInterval:      10 * time.Second,
+		MaxRetryInterval:   60 * time.Second,
+		ExponentialBackoff: true,
+	}
+
+	expected := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, 60 * time.Second, 60 * time.Second}
+	for i, want := range expected {
+		if got := config.Backoff(i + 1); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", i+1, got, want)
+		}
+	}
+	if want := 250 * time.Second; config.TotalTimeout() != want {
+		t.Errorf("TotalTimeout() = %v, want %v", config.TotalTimeout(), want)
+	}
+
+	config.Jitter = true
+	for attempt := 1; attempt <= config.MaxRetries; attempt++ {
+		wait := config.waitInterval(attempt)
+		if wait < config.Backoff(attempt)/2 || wait > config.Backoff(attempt) {
+			t.Errorf("waitInterval(%d) = %v, want between %v and %v", attempt, wait, config.Backoff(attempt)/2, config.Backoff(attempt))
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"-5", 0},
+		{"invalid", 0},
+		{"3600", maxRetryIntervalLimit},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{now.Add(-30 * time.Second).Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
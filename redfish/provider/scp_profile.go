@@ -0,0 +1,340 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scpProfile holds the attribute values of a server configuration profile by the FQDD of their component
+type scpProfile map[string]map[string]string
+
+// scpXMLComponent is a component of a server configuration profile in the XML format,
+// the RAID controllers nest the components of their disks
+type scpXMLComponent struct {
+	FQDD       string            `xml:"FQDD,attr"`
+	Attributes []scpXMLAttribute `xml:"Attribute"`
+	Components []scpXMLComponent `xml:"Component"`
+}
+
+// scpXMLAttribute is an attribute of a server configuration profile in the XML format,
+// the read-only attributes are exported as comments and left out
+type scpXMLAttribute struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// scpJSONComponent is a component of a server configuration profile in the JSON format
+type scpJSONComponent struct {
+	FQDD       string
+	Attributes []struct {
+		Name  string
+		Value json.RawMessage
+	}
+	Components []scpJSONComponent
+}
+
+// parseScpProfile parses the content of a server configuration profile, in the XML or JSON format
+func parseScpProfile(content string) (scpProfile, error) {
+	content = strings.TrimSpace(content)
+	profile := scpProfile{}
+	switch {
+	case strings.HasPrefix(content, "{"):
+		var root struct {
+			SystemConfiguration struct {
+				Components []scpJSONComponent
+			}
+		}
+		if err := json.Unmarshal([]byte(content), &root); err != nil {
+			return nil, fmt.Errorf("invalid JSON profile: %w", err)
+		}
+		profile.addJSONComponents(root.SystemConfiguration.Components)
+	case strings.HasPrefix(content, "<"):
+		var root struct {
+			XMLName    xml.Name          `xml:"SystemConfiguration"`
+			Components []scpXMLComponent `xml:"Component"`
+		}
+		if err := xml.Unmarshal([]byte(content), &root); err != nil {
+			return nil, fmt.Errorf("invalid XML profile: %w", err)
+		}
+		profile.addXMLComponents(root.Components)
+	default:
+		return nil, fmt.Errorf("the profile is neither in the XML nor in the JSON format")
+	}
+	return profile, nil
+}
+
+func (p scpProfile) addXMLComponents(components []scpXMLComponent) {
+	for _, component := range components {
+		for _, attribute := range component.Attributes {
+			p.set(component.FQDD, attribute.Name, attribute.Value)
+		}
+		p.addXMLComponents(component.Components)
+	}
+}
+
+func (p scpProfile) addJSONComponents(components []scpJSONComponent) {
+	for _, component := range components {
+		for _, attribute := range component.Attributes {
+			// the values are strings, other JSON values are kept as they are written
+			var value string
+			if err := json.Unmarshal(attribute.Value, &value); err != nil {
+				value = string(attribute.Value)
+			}
+			p.set(component.FQDD, attribute.Name, value)
+		}
+		p.addJSONComponents(component.Components)
+	}
+}
+
+func (p scpProfile) set(fqdd, name, value string) {
+	if fqdd == "" || name == "" {
+		return
+	}
+	if p[fqdd] == nil {
+		p[fqdd] = map[string]string{}
+	}
+	p[fqdd][name] = value
+}
+
+// scpProfileFilter selects the components and the attributes of a profile by regular expressions,
+// everything is selected when it has no expressions
+type scpProfileFilter struct {
+	components []*regexp.Regexp
+	attributes []*regexp.Regexp
+}
+
+// newScpProfileFilter compiles the regular expressions of the FQDDs of the components and of the names of the attributes
+func newScpProfileFilter(components, attributes []string) (*scpProfileFilter, error) {
+	compile := func(expressions []string) ([]*regexp.Regexp, error) {
+		compiled := make([]*regexp.Regexp, 0, len(expressions))
+		for _, expression := range expressions {
+			re, err := regexp.Compile(expression)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %s: %w", expression, err)
+			}
+			compiled = append(compiled, re)
+		}
+		return compiled, nil
+	}
+	var f scpProfileFilter
+	var err error
+	if f.components, err = compile(components); err != nil {
+		return nil, err
+	}
+	if f.attributes, err = compile(attributes); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// newScpProfileFilterFromModel compiles the regular expressions of the filter of a server configuration profile
+func newScpProfileFilterFromModel(filter *models.ScpProfileFilter) (*scpProfileFilter, error) {
+	if filter == nil {
+		return newScpProfileFilter(nil, nil)
+	}
+	values := func(list []types.String) []string {
+		result := make([]string, 0, len(list))
+		for _, value := range list {
+			result = append(result, value.ValueString())
+		}
+		return result
+	}
+	return newScpProfileFilter(values(filter.Components), values(filter.Attributes))
+}
+
+func matchAny(expressions []*regexp.Regexp, value string) bool {
+	if len(expressions) == 0 {
+		return true
+	}
+	for _, re := range expressions {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns the components and attributes of the profile selected by the filter,
+// the components without any selected attribute are left out
+func (f *scpProfileFilter) apply(p scpProfile) scpProfile {
+	filtered := scpProfile{}
+	for fqdd, attributes := range p {
+		if !matchAny(f.components, fqdd) {
+			continue
+		}
+		for name, value := range attributes {
+			if matchAny(f.attributes, name) {
+				filtered.set(fqdd, name, value)
+			}
+		}
+	}
+	return filtered
+}
+
+// scpAttributeDiff is an attribute which differs between two profiles, the value is nil in the profile missing it
+type scpAttributeDiff struct {
+	FQDD          string
+	Name          string
+	BaselineValue *string
+	Value         *string
+}
+
+// diffScpProfiles returns the attributes of the profile which are not in the baseline, the attributes of the baseline
+// which are not in the profile and the attributes whose values differ, sorted by FQDD and name
+func diffScpProfiles(baseline, profile scpProfile) (added, removed, changed []scpAttributeDiff) {
+	added, removed, changed = []scpAttributeDiff{}, []scpAttributeDiff{}, []scpAttributeDiff{}
+	for fqdd, attributes := range profile {
+		for name, value := range attributes {
+			baselineValue, ok := baseline[fqdd][name]
+			switch {
+			case !ok:
+				added = append(added, scpAttributeDiff{FQDD: fqdd, Name: name, Value: &value})
+			case baselineValue != value:
+				changed = append(changed, scpAttributeDiff{FQDD: fqdd, Name: name, BaselineValue: &baselineValue, Value: &value})
+			}
+		}
+	}
+	for fqdd, attributes := range baseline {
+		for name, value := range attributes {
+			if _, ok := profile[fqdd][name]; !ok {
+				removed = append(removed, scpAttributeDiff{FQDD: fqdd, Name: name, BaselineValue: &value})
+			}
+		}
+	}
+	for _, diffs := range [][]scpAttributeDiff{added, removed, changed} {
+		sort.Slice(diffs, func(i, j int) bool {
+			if diffs[i].FQDD != diffs[j].FQDD {
+				return diffs[i].FQDD < diffs[j].FQDD
+			}
+			return diffs[i].Name < diffs[j].Name
+		})
+	}
+	return added, removed, changed
+}
+
+// renderScpProfile substitutes the variables of a server configuration profile written as a Go template,
+// such as {{ .hostname }}, the variables missing from vars are errors
+func renderScpProfile(content string, vars map[string]string) (string, error) {
+	tmpl, err := template.New("profile").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("invalid profile template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, vars); err != nil {
+		return "", fmt.Errorf("unable to render the profile template: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// scpXMLNode is an element of a server configuration profile in the XML format, kept as it is written
+type scpXMLNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",innerxml"`
+}
+
+// selectScpComponents returns the server configuration profile with only its components of the given FQDDs,
+// the nested components of the selected components are kept
+func selectScpComponents(content string, fqdds []string) (string, error) {
+	content = strings.TrimSpace(content)
+	switch {
+	case strings.HasPrefix(content, "{"):
+		return selectScpJSONComponents(content, fqdds)
+	case strings.HasPrefix(content, "<"):
+		return selectScpXMLComponents(content, fqdds)
+	default:
+		return "", fmt.Errorf("the profile is neither in the XML nor in the JSON format")
+	}
+}
+
+func selectScpXMLComponents(content string, fqdds []string) (string, error) {
+	var root struct {
+		XMLName    xml.Name     `xml:"SystemConfiguration"`
+		Attrs      []xml.Attr   `xml:",any,attr"`
+		Components []scpXMLNode `xml:"Component"`
+	}
+	if err := xml.Unmarshal([]byte(content), &root); err != nil {
+		return "", fmt.Errorf("invalid XML profile: %w", err)
+	}
+	selected := root.Components[:0]
+	for _, component := range root.Components {
+		for _, attr := range component.Attrs {
+			if attr.Name.Local == "FQDD" && slices.Contains(fqdds, attr.Value) {
+				selected = append(selected, component)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return "", fmt.Errorf("none of the components %s are in the profile", strings.Join(fqdds, ", "))
+	}
+	root.Components = selected
+	output, err := xml.Marshal(root)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+func selectScpJSONComponents(content string, fqdds []string) (string, error) {
+	var root map[string]map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &root); err != nil {
+		return "", fmt.Errorf("invalid JSON profile: %w", err)
+	}
+	systemConfiguration, ok := root["SystemConfiguration"]
+	if !ok {
+		return "", fmt.Errorf("invalid JSON profile: no SystemConfiguration")
+	}
+	var components []json.RawMessage
+	if err := json.Unmarshal(systemConfiguration["Components"], &components); err != nil {
+		return "", fmt.Errorf("invalid JSON profile: %w", err)
+	}
+	selected := []json.RawMessage{}
+	for _, component := range components {
+		var id struct{ FQDD string }
+		if err := json.Unmarshal(component, &id); err != nil {
+			return "", fmt.Errorf("invalid JSON profile: %w", err)
+		}
+		if slices.Contains(fqdds, id.FQDD) {
+			selected = append(selected, component)
+		}
+	}
+	if len(selected) == 0 {
+		return "", fmt.Errorf("none of the components %s are in the profile", strings.Join(fqdds, ", "))
+	}
+	var err error
+	if systemConfiguration["Components"], err = json.Marshal(selected); err != nil {
+		return "", err
+	}
+	output, err := json.Marshal(root)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const scpXMLProfile = `<SystemConfiguration Model="PowerEdge R750" ServiceTag="ABC1234">
+<Component FQDD="iDRAC.Embedded.1">
+<Attribute Name="SNMP.1#AgentCommunity">public</Attribute>
+<Attribute Name="SNMP.1#AgentEnable">Enabled</Attribute>
+<!-- <Attribute Name="Info.1#Product">Integrated Dell Remote Access Controller</Attribute> -->
+</Component>
+<Component FQDD="RAID.SL.3-1">
+<Attribute Name="RAIDrebuildRate">30</Attribute>
+<Component FQDD="Disk.Virtual.0:RAID.SL.3-1">
+<Attribute Name="RAIDaction">Update</Attribute>
+</Component>
+</Component>
+</SystemConfiguration>`
+
+const scpJSONProfile = `{
+	"SystemConfiguration": {
+		"Model": "PowerEdge R750",
+		"Components": [
+			{
+				"FQDD": "iDRAC.Embedded.1",
+				"Attributes": [
+					{"Name": "SNMP.1#AgentCommunity", "Value": "private"},
+					{"Name": "SNMP.1#TrapFormat", "Value": "SNMPv1"}
+				]
+			},
+			{
+				"FQDD": "RAID.SL.3-1",
+				"Attributes": [{"Name": "RAIDrebuildRate", "Value": 30}],
+				"Components": [
+					{"FQDD": "Disk.Virtual.0:RAID.SL.3-1", "Attributes": [{"Name": "RAIDaction", "Value": "Update"}]}
+				]
+			}
+		]
+	}
+}`
+
+func TestParseScpProfile(t *testing.T) {
+	xmlProfile, err := parseScpProfile(scpXMLProfile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := scpProfile{
+		"iDRAC.Embedded.1":           {"SNMP.1#AgentCommunity": "public", "SNMP.1#AgentEnable": "Enabled"},
+		"RAID.SL.3-1":                {"RAIDrebuildRate": "30"},
+		"Disk.Virtual.0:RAID.SL.3-1": {"RAIDaction": "Update"},
+	}
+	if !reflect.DeepEqual(xmlProfile, want) {
+		t.Fatalf("Expected the XML profile %v, got %v", want, xmlProfile)
+	}
+
+	jsonProfile, err := parseScpProfile(scpJSONProfile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want = scpProfile{
+		"iDRAC.Embedded.1":           {"SNMP.1#AgentCommunity": "private", "SNMP.1#TrapFormat": "SNMPv1"},
+		"RAID.SL.3-1":                {"RAIDrebuildRate": "30"},
+		"Disk.Virtual.0:RAID.SL.3-1": {"RAIDaction": "Update"},
+	}
+	if !reflect.DeepEqual(jsonProfile, want) {
+		t.Fatalf("Expected the JSON profile %v, got %v", want, jsonProfile)
+	}
+
+	for _, content := range []string{"", "SystemConfiguration", "<SystemConfiguration>", `{"SystemConfiguration": [`} {
+		if _, err := parseScpProfile(content); err == nil {
+			t.Errorf("Expected an error for the profile %q, got nil", content)
+		}
+	}
+}
+
+func TestScpProfileFilter(t *testing.T) {
+	profile, err := parseScpProfile(scpXMLProfile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	filter, err := newScpProfileFilter([]string{`^iDRAC\.`, `^Disk\.`}, []string{`^SNMP\.1#Agent`})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := scpProfile{
+		"iDRAC.Embedded.1": {"SNMP.1#AgentCommunity": "public", "SNMP.1#AgentEnable": "Enabled"},
+	}
+	if got := filter.apply(profile); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected the filtered profile %v, got %v", want, got)
+	}
+
+	filter, err = newScpProfileFilter(nil, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := filter.apply(profile); !reflect.DeepEqual(got, profile) {
+		t.Fatalf("Expected the whole profile %v, got %v", profile, got)
+	}
+
+	if _, err := newScpProfileFilter([]string{"("}, nil); err == nil {
+		t.Fatal("Expected an error for an invalid regular expression, got nil")
+	}
+}
+
+func TestDiffScpProfiles(t *testing.T) {
+	baseline, err := parseScpProfile(scpXMLProfile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	profile, err := parseScpProfile(scpJSONProfile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	added, removed, changed := diffScpProfiles(baseline, profile)
+	value := func(diffs []scpAttributeDiff) []string {
+		result := []string{}
+		for _, diff := range diffs {
+			baselineValue, profileValue := "<nil>", "<nil>"
+			if diff.BaselineValue != nil {
+				baselineValue = *diff.BaselineValue
+			}
+			if diff.Value != nil {
+				profileValue = *diff.Value
+			}
+			result = append(result, diff.FQDD+" "+diff.Name+" "+baselineValue+" "+profileValue)
+		}
+		return result
+	}
+	if got, want := value(added), []string{"iDRAC.Embedded.1 SNMP.1#TrapFormat <nil> SNMPv1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the added attributes %v, got %v", want, got)
+	}
+	if got, want := value(removed), []string{"iDRAC.Embedded.1 SNMP.1#AgentEnable Enabled <nil>"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the removed attributes %v, got %v", want, got)
+	}
+	if got, want := value(changed), []string{"iDRAC.Embedded.1 SNMP.1#AgentCommunity public private"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the changed attributes %v, got %v", want, got)
+	}
+
+	added, removed, changed = diffScpProfiles(baseline, baseline)
+	if len(added)+len(removed)+len(changed) != 0 {
+		t.Errorf("Expected no differences between the same profiles, got %v %v %v", added, removed, changed)
+	}
+}
+
+func TestRenderScpProfile(t *testing.T) {
+	content := `<Attribute Name="NIC.1#DNSRacName">{{ .hostname }}</Attribute>`
+	rendered, err := renderScpProfile(content, map[string]string{"hostname": "server-01"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := `<Attribute Name="NIC.1#DNSRacName">server-01</Attribute>`; rendered != want {
+		t.Fatalf("Expected the rendered profile %q, got %q", want, rendered)
+	}
+
+	if _, err := renderScpProfile(content, map[string]string{"ip_address": "10.0.0.1"}); err == nil {
+		t.Fatal("Expected an error for a missing variable, got nil")
+	}
+	if _, err := renderScpProfile("{{ .hostname", nil); err == nil {
+		t.Fatal("Expected an error for an invalid template, got nil")
+	}
+}
+
+func TestSelectScpComponents(t *testing.T) {
+	for name, content := range map[string]string{"XML": scpXMLProfile, "JSON": scpJSONProfile} {
+		selected, err := selectScpComponents(content, []string{"RAID.SL.3-1"})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", name, err)
+		}
+		profile, err := parseScpProfile(selected)
+		if err != nil {
+			t.Fatalf("%s: expected the selected profile to parse, got %v", name, err)
+		}
+		want := scpProfile{
+			"RAID.SL.3-1":                {"RAIDrebuildRate": "30"},
+			"Disk.Virtual.0:RAID.SL.3-1": {"RAIDaction": "Update"},
+		}
+		if !reflect.DeepEqual(profile, want) {
+			t.Fatalf("%s: expected the selected profile %v, got %v", name, want, profile)
+		}
+
+		if _, err := selectScpComponents(content, []string{"NIC.Integrated.1-1-1"}); err == nil {
+			t.Fatalf("%s: expected an error for components missing from the profile, got nil", name)
+		}
+	}
+
+	selected, err := selectScpComponents(scpXMLProfile, []string{"iDRAC.Embedded.1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(selected, `<SystemConfiguration Model="PowerEdge R750" ServiceTag="ABC1234">`) {
+		t.Fatalf("Expected the attributes of the profile to be kept, got %q", selected)
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sessionsURI is the collection used to create Redfish sessions, fixed by the Redfish specification
+	sessionsURI = "/redfish/v1/SessionService/Sessions"
+	// authTokenHeader carries the session token on every authenticated request
+	authTokenHeader = "X-Auth-Token"
+)
+
+// redfishSession holds one X-Auth-Token session against a BMC for a single user.
+type redfishSession struct {
+	lock     sync.Mutex
+	endpoint string
+	username string
+	password string
+	token    string
+	location string
+	client   *http.Client
+}
+
+// sessionCache stores the sessions created by the provider, keyed by endpoint and user,
+// so that every resource and data source working on the same BMC reuses one login.
+type sessionCache struct {
+	lock     sync.Mutex
+	sessions map[string]*redfishSession
+}
+
+// newSessionCache returns an empty sessionCache
+func newSessionCache() *sessionCache {
+	return &sessionCache{
+		sessions: make(map[string]*redfishSession),
+	}
+}
+
+// sessionKey builds the cache key for an endpoint and user
+func sessionKey(endpoint, username string) string {
+	return strings.TrimRight(endpoint, "/") + "|" + username
+}
+
+// get returns a logged in session for the endpoint and user, creating it when it does not exist yet.
+// A cached session created with a different password is logged out and replaced.
+func (c *sessionCache) get(ctx context.Context, client *http.Client, endpoint, username, password string) (*redfishSession, error) {
+	key := sessionKey(endpoint, username)
+
+	c.lock.Lock()
+	session, ok := c.sessions[key]
+	if !ok {
+		session = &redfishSession{
+			endpoint: strings.TrimRight(endpoint, "/"),
+			username: username,
+			client:   client,
+		}
+		c.sessions[key] = session
+	}
+	c.lock.Unlock()
+
+	session.lock.Lock()
+	defer session.lock.Unlock()
+
+	if session.token != "" && session.password == password {
+		tflog.Debug(ctx, "Reusing cached Redfish session", map[string]any{
+			"endpoint": session.endpoint,
+			"user":     username,
+		})
+		return session, nil
+	}
+
+	if session.token != "" {
+		// credentials changed (e.g. after a password update), drop the old session
+		session.logout(ctx)
+	}
+	session.password = password
+	if err := session.login(ctx); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// logoutAll deletes every cached session on its BMC and empties the cache
+func (c *sessionCache) logoutAll(ctx context.Context) {
+	c.lock.Lock()
+	sessions := c.sessions
+	c.sessions = make(map[string]*redfishSession)
+	c.lock.Unlock()
+
+	for _, session := range sessions {
+		session.lock.Lock()
+		session.logout(ctx)
+		session.lock.Unlock()
+	}
+}
+
+// login creates a new session through the SessionService. Caller must hold the session lock.
+func (s *redfishSession) login(ctx context.Context) error {
+	payload, err := json.Marshal(map[string]string{
+		"UserName": s.username,
+		"Password": s.password,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+sessionsURI, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create session request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to create session, status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	token := resp.Header.Get(authTokenHeader)
+	if token == "" {
+		return fmt.Errorf("session created but no %s header was returned", authTokenHeader)
+	}
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		var session struct {
+			ODataID string `json:"@odata.id"`
+		}
+		if err := json.Unmarshal(body, &session); err == nil {
+			location = session.ODataID
+		}
+	}
+
+	s.token = token
+	s.location = location
+	tflog.Info(ctx, "Created Redfish session", map[string]any{
+		"endpoint": s.endpoint,
+		"user":     s.username,
+	})
+	return nil
+}
+
+// logout deletes the session on the BMC. Errors are only logged since the session
+// will eventually expire on its own. Caller must hold the session lock.
+func (s *redfishSession) logout(ctx context.Context) {
+	if s.token == "" {
+		return
+	}
+	token, location := s.token, s.location
+	s.token, s.location = "", ""
+	if location == "" {
+		return
+	}
+
+	sessionURL := location
+	if parsed, err := url.Parse(location); err == nil && !parsed.IsAbs() {
+		sessionURL = s.endpoint + location
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, sessionURL, nil)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to create session logout request", map[string]any{"error": err.Error()})
+		return
+	}
+	req.Header.Set(authTokenHeader, token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to log out Redfish session", map[string]any{
+			"endpoint": s.endpoint,
+			"error":    err.Error(),
+		})
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	tflog.Info(ctx, "Logged out Redfish session", map[string]any{
+		"endpoint":    s.endpoint,
+		"user":        s.username,
+		"status_code": resp.StatusCode,
+	})
+}
+
+// currentToken returns the token in use
+func (s *redfishSession) currentToken() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.token
+}
+
+// refresh logs in again unless another request already replaced the stale token
+func (s *redfishSession) refresh(ctx context.Context, staleToken string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.token != "" && s.token != staleToken {
+		return s.token, nil
+	}
+	// the BMC already invalidated the stale session, no need to delete it
+	s.token, s.location = "", ""
+	if err := s.login(ctx); err != nil {
+		return "", err
+	}
+	return s.token, nil
+}
+
+// sessionTransport adds the session token to every request and transparently
+// re-authenticates once when the BMC answers 401 Unauthorized.
+type sessionTransport struct {
+	transport http.RoundTripper
+	session   *redfishSession
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// requests carrying their own credentials are passed as they are
+	if req.Header.Get(authTokenHeader) != "" || req.Header.Get("Authorization") != "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	var bodyBytes []byte
+	if req.Body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body.Close()
+	}
+
+	token := t.session.currentToken()
+	resp, err := t.transport.RoundTrip(withSessionToken(req, bodyBytes, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	tflog.Info(req.Context(), "Redfish session is no longer valid, re-authenticating", map[string]any{
+		"endpoint": t.session.endpoint,
+		"user":     t.session.username,
+	})
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	token, err = t.session.refresh(req.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-authenticate after 401 Unauthorized: %w", err)
+	}
+	return t.transport.RoundTrip(withSessionToken(req, bodyBytes, token))
+}
+
+// withSessionToken clones the request with a fresh body and the given session token
+func withSessionToken(req *http.Request, body []byte, token string) *http.Request {
+	clone := req.Clone(req.Context())
+	if body != nil {
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+		clone.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	clone.Header.Set(authTokenHeader, token)
+	return clone
+}
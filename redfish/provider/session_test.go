@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSessionService emulates the Redfish SessionService of a BMC
+type fakeSessionService struct {
+	lock    sync.Mutex
+	logins  int
+	logouts int
+	valid   map[string]bool
+}
+
+func newFakeSessionServer(t *testing.T, f *fakeSessionService) *httptest.Server {
+	t.Helper()
+	f.valid = make(map[string]bool)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == sessionsURI:
+			f.logins++
+			token := fmt.Sprintf("token-%d", f.logins)
+			f.valid[token] = true
+			w.Header().Set(authTokenHeader, token)
+			w.Header().Set("Location", fmt.Sprintf("%s/%d", sessionsURI, f.logins))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete:
+			f.logouts++
+			delete(f.valid, r.Header.Get(authTokenHeader))
+			w.WriteHeader(http.StatusNoContent)
+		case f.valid[r.Header.Get(authTokenHeader)]:
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+}
+
+func testSessionClient() *http.Client {
+	return &http.Client{Timeout: 5 * time.Second}
+}
+
+func TestSessionCache_ReusesSession(t *testing.T) {
+	fake := &fakeSessionService{}
+	server := newFakeSessionServer(t, fake)
+	defer server.Close()
+
+	cache := newSessionCache()
+	for i := 0; i < 5; i++ {
+		if _, err := cache.get(context.Background(), testSessionClient(), server.URL, "root", "calvin"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if fake.logins != 1 {
+		t.Fatalf("Expected 1 login, got %d", fake.logins)
+	}
+
+	// a different user gets its own session
+	if _, err := cache.get(context.Background(), testSessionClient(), server.URL+"/", "admin", "calvin"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fake.logins != 2 {
+		t.Fatalf("Expected 2 logins, got %d", fake.logins)
+	}
+}
+
+func TestSessionCache_PasswordChange(t *testing.T) {
+	fake := &fakeSessionService{}
+	server := newFakeSessionServer(t, fake)
+	defer server.Close()
+
+	cache := newSessionCache()
+	if _, err := cache.get(context.Background(), testSessionClient(), server.URL, "root", "calvin"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := cache.get(context.Background(), testSessionClient(), server.URL, "root", "new-password"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fake.logins != 2 || fake.logouts != 1 {
+		t.Fatalf("Expected 2 logins and 1 logout, got %d and %d", fake.logins, fake.logouts)
+	}
+}
+
+func TestSessionTransport_ReauthenticateOn401(t *testing.T) {
+	fake := &fakeSessionService{}
+	server := newFakeSessionServer(t, fake)
+	defer server.Close()
+
+	cache := newSessionCache()
+	session, err := cache.get(context.Background(), testSessionClient(), server.URL, "root", "calvin")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// the BMC expires the session behind our back
+	fake.lock.Lock()
+	fake.valid = make(map[string]bool)
+	fake.lock.Unlock()
+
+	client := &http.Client{Transport: &sessionTransport{transport: http.DefaultTransport, session: session}}
+	resp, err := client.Get(server.URL + "/redfish/v1/Systems")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	if fake.logins != 2 {
+		t.Fatalf("Expected 2 logins, got %d", fake.logins)
+	}
+}
+
+func TestSessionCache_LogoutAll(t *testing.T) {
+	fake := &fakeSessionService{}
+	server := newFakeSessionServer(t, fake)
+	defer server.Close()
+
+	cache := newSessionCache()
+	for _, user := range []string{"root", "admin", "operator"} {
+		if _, err := cache.get(context.Background(), testSessionClient(), server.URL, user, "calvin"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	cache.logoutAll(context.Background())
+	if fake.logouts != 3 {
+		t.Fatalf("Expected 3 logouts, got %d", fake.logouts)
+	}
+	if len(fake.valid) != 0 {
+		t.Fatalf("Expected no active sessions, got %d", len(fake.valid))
+	}
+}
+
+func TestSessionCache_LoginFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	cache := newSessionCache()
+	if _, err := cache.get(context.Background(), testSessionClient(), server.URL, "root", "wrong"); err == nil {
+		t.Fatal("Expected an error for invalid credentials, got nil")
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	pemBlockPrefix = "-----BEGIN"
+	// defaultTLSMinVersion is the minimum TLS version accepted when `tls_min_version` is not set
+	defaultTLSMinVersion = "1.2"
+)
+
+// tlsVersions maps the accepted `tls_min_version` values to their crypto/tls constants
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSSettings defines how the certificate of a BMC is verified
+type TLSSettings struct {
+	// Insecure skips the certificate verification
+	Insecure bool
+
+	// CABundle is a PEM encoded bundle, or the path to one, used instead of the system roots
+	CABundle string
+
+	// Fingerprints are SHA-256 fingerprints the BMC leaf certificate is pinned to
+	Fingerprints []string
+
+	// MinVersion is the minimum TLS version accepted, empty means defaultTLSMinVersion
+	MinVersion string
+}
+
+// key returns a string identifying the settings, used to share transports between endpoints
+func (s *TLSSettings) key() string {
+	fingerprints := make([]string, 0, len(s.Fingerprints))
+	for _, fingerprint := range s.Fingerprints {
+		fingerprints = append(fingerprints, normalizeFingerprint(fingerprint))
+	}
+	sort.Strings(fingerprints)
+	return fmt.Sprintf("%t|%s|%s|%s", s.Insecure, s.MinVersion, strings.Join(fingerprints, ","), s.CABundle)
+}
+
+// Build returns the tls.Config matching the settings.
+// When fingerprints are given, the chain is not validated against a CA and the BMC
+// certificate is accepted only if its SHA-256 fingerprint is one of the pinned values.
+func (s *TLSSettings) Build() (*tls.Config, error) {
+	minVersion := s.MinVersion
+	if minVersion == "" {
+		minVersion = defaultTLSMinVersion
+	}
+	version, ok := tlsVersions[minVersion]
+	if !ok {
+		return nil, fmt.Errorf("tls_min_version must be one of 1.0, 1.1, 1.2 or 1.3, got %q", s.MinVersion)
+	}
+	config := &tls.Config{MinVersion: version} //nolint:gosec // MinVersion is user configurable, 1.2 applies when unset
+
+	if len(s.Fingerprints) > 0 {
+		pins := make(map[string]bool)
+		for _, fingerprint := range s.Fingerprints {
+			normalized := normalizeFingerprint(fingerprint)
+			if len(normalized) != sha256.Size*2 {
+				return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint %q", fingerprint)
+			}
+			if _, err := hex.DecodeString(normalized); err != nil {
+				return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint %q", fingerprint)
+			}
+			pins[normalized] = true
+		}
+		// The chain is verified by the pinning callback below
+		config.InsecureSkipVerify = true //nolint:gosec
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyPinnedCertificate(state, pins)
+		}
+		return config, nil
+	}
+
+	if s.Insecure {
+		config.InsecureSkipVerify = true //nolint:gosec
+		return config, nil
+	}
+
+	if s.CABundle != "" {
+		pool, err := loadCABundle(s.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// loadCABundle parses an inline PEM bundle or the PEM file the value points to
+func loadCABundle(bundle string) (*x509.CertPool, error) {
+	content := []byte(bundle)
+	if !strings.Contains(bundle, pemBlockPrefix) {
+		var err error
+		content, err = os.ReadFile(bundle)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_bundle file: %w", err)
+		}
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, errors.New("ca_bundle does not contain any valid PEM encoded certificate")
+	}
+	return pool, nil
+}
+
+// verifyPinnedCertificate checks the leaf certificate presented by the BMC against the pinned fingerprints
+func verifyPinnedCertificate(state tls.ConnectionState, pins map[string]bool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("the BMC did not present any certificate")
+	}
+	sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+	if !pins[fingerprint] {
+		return fmt.Errorf("certificate fingerprint %s does not match any pinned fingerprint", fingerprint)
+	}
+	return nil
+}
+
+// normalizeFingerprint lower cases a fingerprint and removes its separators
+func normalizeFingerprint(fingerprint string) string {
+	replacer := strings.NewReplacer(":", "", " ", "", "-", "")
+	return strings.ToLower(replacer.Replace(strings.TrimSpace(fingerprint)))
+}
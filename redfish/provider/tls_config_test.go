@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	encodingpem "encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTLSTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func tlsTestGet(t *testing.T, settings TLSSettings, url string) error {
+	t.Helper()
+	config, err := settings.Build()
+	if err != nil {
+		t.Fatalf("Expected no error building the TLS config, got %v", err)
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+	resp, err := client.Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func serverFingerprint(server *httptest.Server) string {
+	sum := sha256.Sum256(server.Certificate().Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func serverPEM(server *httptest.Server) string {
+	return string(encodingpem.EncodeToMemory(&encodingpem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func TestTLSSettings_VerifyFailsWithoutCA(t *testing.T) {
+	server := newTLSTestServer(t)
+	defer server.Close()
+
+	if err := tlsTestGet(t, TLSSettings{}, server.URL); err == nil {
+		t.Fatal("Expected certificate verification error, got nil")
+	}
+}
+
+func TestTLSSettings_Insecure(t *testing.T) {
+	server := newTLSTestServer(t)
+	defer server.Close()
+
+	if err := tlsTestGet(t, TLSSettings{Insecure: true}, server.URL); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestTLSSettings_CABundle(t *testing.T) {
+	server := newTLSTestServer(t)
+	defer server.Close()
+
+	// inline PEM
+	if err := tlsTestGet(t, TLSSettings{CABundle: serverPEM(server)}, server.URL); err != nil {
+		t.Fatalf("Expected no error with inline bundle, got %v", err)
+	}
+
+	// PEM file
+	bundlePath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(bundlePath, []byte(serverPEM(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := tlsTestGet(t, TLSSettings{CABundle: bundlePath}, server.URL); err != nil {
+		t.Fatalf("Expected no error with bundle file, got %v", err)
+	}
+}
+
+func TestTLSSettings_InvalidCABundle(t *testing.T) {
+	cases := []string{
+		filepath.Join(t.TempDir(), "missing.pem"),
+		"-----BEGIN CERTIFICATE-----\nnot a certificate\n-----END CERTIFICATE-----",
+	}
+	for _, bundle := range cases {
+		settings := TLSSettings{CABundle: bundle}
+		if _, err := settings.Build(); err == nil {
+			t.Fatalf("Expected an error for bundle %q, got nil", bundle)
+		}
+	}
+}
+
+func TestTLSSettings_FingerprintPinning(t *testing.T) {
+	server := newTLSTestServer(t)
+	defer server.Close()
+
+	fingerprint := serverFingerprint(server)
+	// colon separated upper case fingerprints are accepted too
+	var pairs []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		pairs = append(pairs, strings.ToUpper(fingerprint[i:i+2]))
+	}
+
+	if err := tlsTestGet(t, TLSSettings{Fingerprints: []string{strings.Join(pairs, ":")}}, server.URL); err != nil {
+		t.Fatalf("Expected no error with matching pin, got %v", err)
+	}
+
+	wrong := strings.Repeat("ab", sha256.Size)
+	err := tlsTestGet(t, TLSSettings{Insecure: true, Fingerprints: []string{wrong}}, server.URL)
+	if err == nil || !strings.Contains(err.Error(), "does not match any pinned fingerprint") {
+		t.Fatalf("Expected pinning error, got %v", err)
+	}
+}
+
+func TestTLSSettings_MinVersion(t *testing.T) {
+	settings := TLSSettings{}
+	config, err := settings.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.MinVersion != tls.VersionTLS12 {
+		t.Fatalf("Expected TLS 1.2 when the minimum version is not set, got %x", config.MinVersion)
+	}
+
+	settings = TLSSettings{MinVersion: "1.3"}
+	config, err = settings.Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.MinVersion != tls.VersionTLS13 {
+		t.Fatalf("Expected TLS 1.3, got %x", config.MinVersion)
+	}
+
+	settings = TLSSettings{MinVersion: "2.0"}
+	if _, err := settings.Build(); err == nil {
+		t.Fatal("Expected an error for an unknown TLS version, got nil")
+	}
+}
+
+func TestTLSSettings_Key(t *testing.T) {
+	a := TLSSettings{Fingerprints: []string{"AA:BB", "cc"}}
+	b := TLSSettings{Fingerprints: []string{"cc", "aabb"}}
+	if a.key() != b.key() {
+		t.Fatalf("Expected equivalent settings to share a key, got %q and %q", a.key(), b.key())
+	}
+	c := TLSSettings{Insecure: true}
+	if a.key() == c.key() {
+		t.Fatal("Expected different settings to have different keys")
+	}
+}